@@ -0,0 +1,298 @@
+package sawchain
+
+import (
+	"context"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/options"
+)
+
+// Apply applies resources with a manifest or a Chainsaw template using server-side apply, and returns
+// an error if any client Apply operations fail.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template
+//     containing resource definitions to be sent as server-side apply patches. Template documents only
+//     need to contain type metadata, identifying metadata, and the fields owned by the field manager.
+//     If provided with an object, must contain exactly one resource definition matching the type of the
+//     object. If provided with a slice of objects, must contain resource definitions exactly matching the
+//     count, order, and types of the objects.
+//
+//   - Object (client.Object): Typed or unstructured object for writing the applied state of a single
+//     resource.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for writing the applied states of
+//     multiple resources.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - FieldManager (sawchain.FieldManager): Name of the field manager making the changes. Defaults to
+//     Sawchain's global field manager ("sawchain" unless configured otherwise).
+//
+//   - ForceConflicts (sawchain.Flag): If provided, ownership of fields managed by other field managers
+//     is forced instead of failing with a conflict. Enabled by default if Sawchain was initialized with
+//     ForceConflicts.
+//
+// An object and a slice of objects may not be provided together.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Server-side apply creates resources that do not exist and updates resources that do. Fields
+//     previously applied by the same field manager but omitted from the template are removed.
+//
+//   - Resources applied with Apply are not recorded for AutoCleanup.
+//
+//   - When running tests in parallel, ensure resource names or namespaces are unique per process to
+//     prevent collisions. See docs/parallel-tests.md for isolation strategies.
+//
+//   - Use ApplyAndWait instead of Apply if you need to ensure the apply is successful and the client
+//     cache is synced.
+//
+// # Examples
+//
+// Apply resources with a manifest file:
+//
+//	err := sc.Apply(ctx, "path/to/resources.yaml")
+//
+// Apply a single resource with a Chainsaw template and bindings:
+//
+//	err := sc.Apply(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  data:
+//	    key: value
+//	  `, map[string]any{"name": "test-cm", "namespace": "default"})
+//
+// Apply a single resource as a custom field manager, forcing conflicts, and save the applied state to an object:
+//
+//	configMap := &corev1.ConfigMap{}
+//	err := sc.Apply(ctx, configMap, sawchain.FieldManager("my-controller"), sawchain.ForceConflicts, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: default
+//	  data:
+//	    key: value
+//	`)
+//
+// Apply multiple resources with a Chainsaw template and save the applied states to objects:
+//
+//	configMap := &corev1.ConfigMap{}
+//	secret := &corev1.Secret{}
+//	err := sc.Apply(ctx, []client.Object{configMap, secret}, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: (concat($prefix, '-cm'))
+//	    namespace: ($namespace)
+//	  data:
+//	    key: value
+//	  ---
+//	  apiVersion: v1
+//	  kind: Secret
+//	  metadata:
+//	    name: (concat($prefix, '-secret'))
+//	    namespace: ($namespace)
+//	  stringData:
+//	    password: secret
+//	  `, map[string]any{"prefix": "test", "namespace": "default"})
+func (s *Sawchain) Apply(ctx context.Context, args ...any) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true, FieldManager: true, Flags: options.FlagForceConflicts}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Render template
+	unstructuredObjs := s.renderForObjects(ctx, opts)
+
+	// Apply resources
+	for i := range unstructuredObjs {
+		// Use index to update object in outer scope
+		if err := s.apply(ctx, &unstructuredObjs[i], opts); err != nil {
+			return err
+		}
+	}
+
+	// Save objects
+	s.saveToObjects(unstructuredObjs, opts)
+
+	return nil
+}
+
+// ApplyAndWait applies resources with a manifest or a Chainsaw template using server-side apply, and
+// ensures client Get operations for all resources reflect the changes within a configurable duration
+// before returning. If testing with a cached client, this ensures the client cache is synced and it is
+// safe to make assertions on the applied resources immediately after execution.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template
+//     containing resource definitions to be sent as server-side apply patches. Template documents only
+//     need to contain type metadata, identifying metadata, and the fields owned by the field manager.
+//     If provided with an object, must contain exactly one resource definition matching the type of the
+//     object. If provided with a slice of objects, must contain resource definitions exactly matching the
+//     count, order, and types of the objects.
+//
+//   - Object (client.Object): Typed or unstructured object for writing the applied state of a single
+//     resource.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for writing the applied states of
+//     multiple resources.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - FieldManager (sawchain.FieldManager): Name of the field manager making the changes. Defaults to
+//     Sawchain's global field manager ("sawchain" unless configured otherwise).
+//
+//   - ForceConflicts (sawchain.Flag): If provided, ownership of fields managed by other field managers
+//     is forced instead of failing with a conflict. Enabled by default if Sawchain was initialized with
+//     ForceConflicts.
+//
+//   - Timeout (string or time.Duration): Duration within which client Get operations for all resources
+//     should reflect the changes. If provided, must be before interval. Defaults to Sawchain's
+//     global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for checking the resources after applying.
+//     If provided, must be after timeout. Defaults to Sawchain's global interval value.
+//
+// An object and a slice of objects may not be provided together. All arguments except the template are
+// optional.
+//
+// # Notes
+//
+//   - Invalid input, client errors, and timeout errors will result in immediate test failure.
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Server-side apply creates resources that do not exist and updates resources that do. Fields
+//     previously applied by the same field manager but omitted from the template are removed.
+//
+//   - Resources applied with ApplyAndWait are not recorded for AutoCleanup.
+//
+//   - When running tests in parallel, ensure resource names or namespaces are unique per process to
+//     prevent collisions. See docs/parallel-tests.md for isolation strategies.
+//
+//   - Use Apply instead of ApplyAndWait if you need to apply resources without ensuring success.
+//
+// # Examples
+//
+// Apply resources with a manifest file and override duration settings:
+//
+//	sc.ApplyAndWait(ctx, "path/to/resources.yaml", "10s", "2s")
+//
+// Apply a single resource with a Chainsaw template and bindings:
+//
+//	sc.ApplyAndWait(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  data:
+//	    key: value
+//	  `, map[string]any{"name": "test-cm", "namespace": "default"})
+//
+// Apply a single resource as a custom field manager, forcing conflicts, and save the applied state to an object:
+//
+//	configMap := &corev1.ConfigMap{}
+//	sc.ApplyAndWait(ctx, configMap, sawchain.FieldManager("my-controller"), sawchain.ForceConflicts, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: default
+//	  data:
+//	    key: value
+//	`)
+//
+// Apply multiple resources with a Chainsaw template and save the applied states to objects:
+//
+//	configMap := &corev1.ConfigMap{}
+//	secret := &corev1.Secret{}
+//	sc.ApplyAndWait(ctx, []client.Object{configMap, secret}, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: (concat($prefix, '-cm'))
+//	    namespace: ($namespace)
+//	  data:
+//	    key: value
+//	  ---
+//	  apiVersion: v1
+//	  kind: Secret
+//	  metadata:
+//	    name: (concat($prefix, '-secret'))
+//	    namespace: ($namespace)
+//	  stringData:
+//	    password: secret
+//	  `, map[string]any{"prefix": "test", "namespace": "default"})
+func (s *Sawchain) ApplyAndWait(ctx context.Context, args ...any) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true, FieldManager: true, Flags: options.FlagForceConflicts}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Render template
+	unstructuredObjs := s.renderForObjects(ctx, opts)
+
+	// Apply resources
+	for i := range unstructuredObjs {
+		// Use index to update object in outer scope
+		s.g.Expect(s.apply(ctx, &unstructuredObjs[i], opts)).To(gomega.Succeed(), errFailedApplyWithTemplate)
+	}
+
+	// Wait for apply to be reflected
+	s.waitForResourceVersions(ctx, unstructuredRefs(unstructuredObjs), opts, errApplyNotReflected)
+
+	// Save objects
+	s.saveToObjects(unstructuredObjs, opts)
+}
+
+// HELPERS
+
+// apply sends obj as a server-side apply patch using the field manager and flags in opts,
+// writing the applied state back to obj.
+func (s *Sawchain) apply(ctx context.Context, obj *unstructured.Unstructured, opts *options.Options) error {
+	applyOpts := []client.ApplyOption{client.FieldOwner(opts.FieldManager)}
+	if opts.Flags.Has(options.FlagForceConflicts) {
+		applyOpts = append(applyOpts, client.ForceOwnership)
+	}
+	return s.c.Apply(ctx, client.ApplyConfigurationFromUnstructured(obj), applyOpts...)
+}
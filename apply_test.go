@@ -0,0 +1,608 @@
+package sawchain_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/guidewire-oss/sawchain"
+	"github.com/guidewire-oss/sawchain/internal/testutil"
+)
+
+var _ = Describe("Apply", func() {
+	type testCase struct {
+		client              client.Client
+		globalBindings      map[string]any
+		methodArgs          []any
+		expectedReturnErrs  []string
+		expectedFailureLogs []string
+		expectedObj         client.Object
+		expectedObjs        []client.Object
+	}
+	DescribeTable("applying test resources",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval, tc.globalBindings)
+
+			// Test Apply
+			var err error
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				err = sc.Apply(ctx, tc.methodArgs...)
+			}()
+			<-done
+
+			// Verify error
+			if len(tc.expectedReturnErrs) > 0 {
+				Expect(err).To(HaveOccurred(), "expected error")
+				for _, expectedErr := range tc.expectedReturnErrs {
+					Expect(err.Error()).To(ContainSubstring(expectedErr))
+				}
+			} else {
+				Expect(err).NotTo(HaveOccurred(), "expected no error")
+			}
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+
+			if tc.expectedObj != nil {
+				// Verify successful apply of single resource
+				key := client.ObjectKeyFromObject(tc.expectedObj)
+				actual := copy(tc.expectedObj)
+				Expect(tc.client.Get(ctx, key, actual)).To(Succeed(), "resource not found")
+				Expect(intent(tc.client, actual)).To(Equal(intent(tc.client, tc.expectedObj)), "resource not applied")
+
+				// Verify resource state
+				for _, arg := range tc.methodArgs {
+					if obj, ok := arg.(client.Object); ok {
+						Expect(intent(tc.client, obj)).To(Equal(intent(tc.client, tc.expectedObj)), "resource state not saved to provided object")
+						break
+					}
+				}
+			}
+
+			if len(tc.expectedObjs) > 0 {
+				// Verify successful apply of multiple resources
+				for _, expectedObj := range tc.expectedObjs {
+					key := client.ObjectKeyFromObject(expectedObj)
+					actual := copy(expectedObj)
+					Expect(tc.client.Get(ctx, key, actual)).To(Succeed(), "resource not found: %s", key)
+					Expect(intent(tc.client, actual)).To(Equal(intent(tc.client, expectedObj)), "resource not applied: %s", key)
+				}
+
+				// Verify resource states
+				for _, arg := range tc.methodArgs {
+					if objs, ok := arg.([]client.Object); ok {
+						Expect(objs).To(HaveLen(len(tc.expectedObjs)), "unexpected objects length")
+						for i, obj := range objs {
+							Expect(intent(tc.client, obj)).To(Equal(intent(tc.client, tc.expectedObjs[i])), "resource state not saved to provided object")
+						}
+						break
+					}
+				}
+			}
+		},
+
+		// Success cases - single resource
+		Entry("should apply ConfigMap with static template string", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: bar
+				`,
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+		}),
+
+		Entry("should apply ConfigMap with template string and bindings", testCase{
+			client:         &MockClient{Client: testutil.NewStandardFakeClient()},
+			globalBindings: map[string]any{"namespace": "default"},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: ($name)
+				  namespace: ($namespace)
+				data:
+				  foo: bar
+				`,
+				map[string]any{"name": "test-cm"},
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+		}),
+
+		Entry("should apply ConfigMap with template string and save to typed object", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				&corev1.ConfigMap{},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: bar
+				`,
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+		}),
+
+		Entry("should apply ConfigMap with template string and save to unstructured object", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				&unstructured.Unstructured{},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: bar
+				`,
+			},
+			expectedObj: testutil.NewUnstructuredConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+		}),
+
+		Entry("should apply ConfigMap with custom field manager and force conflicts", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				sawchain.FieldManager("test-manager"),
+				sawchain.ForceConflicts,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: bar
+				`,
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+		}),
+
+		// Success cases - multiple resources
+		Entry("should apply multiple resources with template string and save to typed objects", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				[]client.Object{&corev1.ConfigMap{}, &corev1.ConfigMap{}},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				data:
+				  key1: value1
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm2
+				  namespace: default
+				data:
+				  key2: value2
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", map[string]string{"key1": "value1"}),
+				testutil.NewConfigMap("test-cm2", "default", map[string]string{"key2": "value2"}),
+			},
+		}),
+
+		// Error cases
+		Entry("should return apply error", testCase{
+			client: &MockClient{
+				Client:          testutil.NewStandardFakeClient(),
+				applyFailFirstN: 1,
+			},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				`,
+			},
+			expectedReturnErrs: []string{"simulated apply failure"},
+		}),
+
+		// Failure cases
+		Entry("should fail with no arguments", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string)",
+			},
+		}),
+
+		Entry("should fail with object and no template", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string)",
+			},
+		}),
+
+		Entry("should fail with empty field manager", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				sawchain.FieldManager(""),
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"provided field manager is empty",
+			},
+		}),
+
+		Entry("should fail with unsupported flag", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				sawchain.AutoCleanup,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unsupported flag argument: AutoCleanup",
+			},
+		}),
+
+		Entry("should fail with multi-resource template and single object", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				&corev1.ConfigMap{},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm2
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] single object insufficient for multi-resource template",
+			},
+		}),
+
+		Entry("should fail with missing binding", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: ($missing)
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid template",
+				"variable not defined: $missing",
+			},
+		}),
+	)
+
+	Describe("field ownership", func() {
+		template := func(value string) string {
+			return `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: ` + value
+		}
+
+		It("updates fields owned by the same field manager", func() {
+			c := testutil.NewStandardFakeClient()
+			sc := sawchain.New(GinkgoTB(), c, fastTimeout, fastInterval)
+			Expect(sc.Apply(ctx, template("original"))).To(Succeed())
+
+			cm := &corev1.ConfigMap{}
+			Expect(sc.Apply(ctx, cm, template("updated"))).To(Succeed())
+			Expect(cm.Data).To(Equal(map[string]string{"foo": "updated"}))
+		})
+
+		It("returns conflicts with other field managers unless forced", func() {
+			c := testutil.NewStandardFakeClient()
+			sc := sawchain.New(GinkgoTB(), c, fastTimeout, fastInterval)
+			Expect(sc.Apply(ctx, sawchain.FieldManager("other-manager"), template("original"))).To(Succeed())
+
+			err := sc.Apply(ctx, template("updated"))
+			Expect(err).To(HaveOccurred(), "expected conflict")
+			Expect(apierrors.IsConflict(err)).To(BeTrue(), "expected conflict, got: %v", err)
+
+			cm := &corev1.ConfigMap{}
+			Expect(sc.Apply(ctx, cm, sawchain.ForceConflicts, template("updated"))).To(Succeed())
+			Expect(cm.Data).To(Equal(map[string]string{"foo": "updated"}))
+		})
+
+		It("uses global field manager and force conflicts settings", func() {
+			c := fake.NewClientBuilder().
+				WithScheme(testutil.NewStandardScheme()).
+				WithReturnManagedFields().
+				Build()
+			other := sawchain.New(GinkgoTB(), c, fastTimeout, fastInterval)
+			Expect(other.Apply(ctx, template("original"))).To(Succeed())
+
+			sc := sawchain.New(GinkgoTB(), c, fastTimeout, fastInterval,
+				sawchain.FieldManager("test-manager"), sawchain.ForceConflicts)
+			cm := &corev1.ConfigMap{}
+			Expect(sc.Apply(ctx, cm, template("updated"))).To(Succeed())
+			Expect(cm.Data).To(Equal(map[string]string{"foo": "updated"}))
+
+			var managers []string
+			for _, entry := range cm.ManagedFields {
+				managers = append(managers, entry.Manager)
+			}
+			Expect(managers).To(ContainElement("test-manager"))
+		})
+	})
+})
+
+var _ = Describe("ApplyAndWait", func() {
+	type testCase struct {
+		client              client.Client
+		globalBindings      map[string]any
+		methodArgs          []any
+		expectedFailureLogs []string
+		expectedObj         client.Object
+		expectedObjs        []client.Object
+		expectedDuration    time.Duration
+	}
+	DescribeTable("applying test resources and waiting",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval, tc.globalBindings)
+
+			// Test ApplyAndWait
+			done := make(chan struct{})
+			start := time.Now()
+			go func() {
+				defer close(done)
+				sc.ApplyAndWait(ctx, tc.methodArgs...)
+			}()
+			<-done
+			executionTime := time.Since(start)
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+
+			if tc.expectedObj != nil {
+				// Verify successful apply of single resource
+				key := client.ObjectKeyFromObject(tc.expectedObj)
+				actual := copy(tc.expectedObj)
+				Expect(tc.client.Get(ctx, key, actual)).To(Succeed(), "resource not found")
+				Expect(intent(tc.client, actual)).To(Equal(intent(tc.client, tc.expectedObj)), "resource not applied")
+
+				// Verify resource state
+				for _, arg := range tc.methodArgs {
+					if obj, ok := arg.(client.Object); ok {
+						Expect(intent(tc.client, obj)).To(Equal(intent(tc.client, tc.expectedObj)), "resource state not saved to provided object")
+						break
+					}
+				}
+			}
+
+			if len(tc.expectedObjs) > 0 {
+				// Verify successful apply of multiple resources
+				for _, expectedObj := range tc.expectedObjs {
+					key := client.ObjectKeyFromObject(expectedObj)
+					actual := copy(expectedObj)
+					Expect(tc.client.Get(ctx, key, actual)).To(Succeed(), "resource not found: %s", key)
+					Expect(intent(tc.client, actual)).To(Equal(intent(tc.client, expectedObj)), "resource not applied: %s", key)
+				}
+
+				// Verify resource states
+				for _, arg := range tc.methodArgs {
+					if objs, ok := arg.([]client.Object); ok {
+						Expect(objs).To(HaveLen(len(tc.expectedObjs)), "unexpected objects length")
+						for i, obj := range objs {
+							Expect(intent(tc.client, obj)).To(Equal(intent(tc.client, tc.expectedObjs[i])), "resource state not saved to provided object")
+						}
+						break
+					}
+				}
+			}
+
+			// Verify execution time
+			if tc.expectedDuration > 0 {
+				maxAllowedDuration := time.Duration(float64(tc.expectedDuration) * 1.2)
+				Expect(executionTime).To(BeNumerically("<", maxAllowedDuration),
+					"expected execution time %v to be less than %v",
+					executionTime, maxAllowedDuration)
+			}
+		},
+
+		// Success cases
+		Entry("should apply ConfigMap with template string and bindings", testCase{
+			client:         &MockClient{Client: testutil.NewStandardFakeClient()},
+			globalBindings: map[string]any{"namespace": "default"},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: ($name)
+				  namespace: ($namespace)
+				data:
+				  foo: bar
+				`,
+				map[string]any{"name": "test-cm"},
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+		}),
+
+		Entry("should apply ConfigMap with template string and save to typed object", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				&corev1.ConfigMap{},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: bar
+				`,
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+		}),
+
+		Entry("should apply multiple resources with template string and save to unstructured objects", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				[]client.Object{&unstructured.Unstructured{}, &unstructured.Unstructured{}},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				data:
+				  key1: value1
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm2
+				  namespace: default
+				data:
+				  key2: value2
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewUnstructuredConfigMap("test-cm1", "default", map[string]string{"key1": "value1"}),
+				testutil.NewUnstructuredConfigMap("test-cm2", "default", map[string]string{"key2": "value2"}),
+			},
+		}),
+
+		Entry("should handle transient get failures", testCase{
+			client: &MockClient{
+				Client:        testutil.NewStandardFakeClient(),
+				getFailFirstN: 2, // Fail the first 2 get attempts
+			},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: bar
+				`,
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+		}),
+
+		// Failure cases
+		Entry("should fail when apply fails", testCase{
+			client: &MockClient{
+				Client:          testutil.NewStandardFakeClient(),
+				applyFailFirstN: -1,
+			},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to apply with template",
+				"simulated apply failure",
+			},
+		}),
+
+		Entry("should fail when apply is not reflected within timeout", testCase{
+			client: &MockClient{
+				Client:        testutil.NewStandardFakeClient(),
+				getFailFirstN: -1,
+			},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] apply not reflected within timeout (client cache sync delay)",
+				"simulated get failure",
+			},
+			expectedDuration: fastTimeout,
+		}),
+
+		Entry("should fail with invalid duration order", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				fastInterval, fastTimeout,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"provided interval is greater than timeout",
+			},
+		}),
+	)
+})
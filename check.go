@@ -6,20 +6,29 @@ import (
 
 	"github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/guidewire-oss/sawchain/internal/chainsaw"
 	"github.com/guidewire-oss/sawchain/internal/options"
 	"github.com/guidewire-oss/sawchain/internal/util"
 )
 
-// formatMatchError renders a check error: if it is a *chainsaw.MatchError, it is rendered at
-// the given verbosity while remaining unwrappable to *chainsaw.MatchError via errors.As;
-// otherwise it is returned unchanged.
+// formatMatchError renders a check error: if it is a *chainsaw.MatchError,
+// *chainsaw.UnexpectedMatchError, or *chainsaw.CountError, it is rendered at the given verbosity while remaining
+// unwrappable to its structured type via errors.As; otherwise it is returned unchanged.
 func formatMatchError(err error, verbosity options.Verbosity, template string, bindings chainsaw.Bindings) error {
 	var me *chainsaw.MatchError
 	if errors.As(err, &me) {
 		return me.FormatError(verbosity, template, bindings)
 	}
+	var ume *chainsaw.UnexpectedMatchError
+	if errors.As(err, &ume) {
+		return ume.FormatError(verbosity, template, bindings)
+	}
+	var ce *chainsaw.CountError
+	if errors.As(err, &ce) {
+		return ce.FormatError(verbosity, template, bindings)
+	}
 	return err
 }
 
@@ -46,6 +55,16 @@ func formatMatchError(err error, verbosity options.Verbosity, template string, b
 //   - Objects ([]client.Object): Slice of typed or unstructured objects to populate with the states of the
 //     first matches (if found) for each expected resource defined in the template.
 //
+//   - Strict (sawchain.Flag): If provided, resources with fields absent in the expectation do not match.
+//     Enabled by default if Sawchain was initialized with Strict.
+//
+//   - IgnorePaths (sawchain.IgnorePaths): Field paths exempt from strict matching, in addition to
+//     Sawchain's global ignore paths. If multiple are provided, they will be combined.
+//
+//   - DecodeSecrets (sawchain.Flag): If provided, the data of Secrets is compared in decoded form, like
+//     stringData, and redacted in failure output below VerbosityVerbose. Enabled by default if Sawchain
+//     was initialized with DecodeSecrets.
+//
 // # Notes
 //
 //   - Invalid input will result in immediate test failure.
@@ -62,6 +81,15 @@ func formatMatchError(err error, verbosity options.Verbosity, template string, b
 //     extras are allowed), template expectations only have to include fields of interest, not necessarily
 //     complete resource definitions.
 //
+//   - In strict mode, a resource passing the Chainsaw check is also rejected if it has fields absent in
+//     the expectation, each reported as a field error. Null values and empty maps are treated as absent,
+//     and maps with JMESPath keys in the expectation (e.g. "(length(data))") are not checked for extra
+//     fields. Server-populated fields (e.g. metadata.uid, metadata.resourceVersion, status) count as
+//     extras unless covered by the expectation or IgnorePaths.
+//
+//   - With DecodeSecrets, Secret data is base64-decoded before the check, so expectations (including
+//     JMESPath expressions) use plain values. Saved matches keep the data as stored.
+//
 //   - When no match is found, the returned error unwraps to a *MatchError via errors.As for
 //     programmatic inspection, and its detail level follows the Sawchain instance's configured
 //     Verbosity.
@@ -70,7 +98,9 @@ func formatMatchError(err error, verbosity options.Verbosity, template string, b
 //     clearest failure output; other error matchers fall back to Gomega's struct formatting,
 //     which is noisier.
 //
-//   - Use CheckFunc if you need to create a Check function for polling.
+//   - Use CheckFunc if you need to create a Check function for polling, CheckAndWait if you need to wait
+//     for matches with Sawchain's durations, or CheckConsistently if you need to ensure resources stay in
+//     the expected state over time.
 //
 // # Examples
 //
@@ -121,68 +151,755 @@ func formatMatchError(err error, verbosity options.Verbosity, template string, b
 //	      bar: baz
 //	  `, map[string]any{"namespace": "default"})
 //
+// Check for a ConfigMap with exactly the given data and no other fields, except server-populated metadata:
+//
+//	err := sc.Check(ctx, sawchain.Strict, sawchain.IgnorePaths{"metadata.uid", "metadata.resourceVersion",
+//	  "metadata.creationTimestamp", "metadata.managedFields"}, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: default
+//	  data:
+//	    key: value
+//	`)
+//
+// Check for a Secret with a decoded password:
+//
+//	err := sc.Check(ctx, sawchain.DecodeSecrets, `
+//	  apiVersion: v1
+//	  kind: Secret
+//	  metadata:
+//	    name: test-secret
+//	    namespace: default
+//	  data:
+//	    username: admin
+//	    (length(password) >= `16`): true
+//	`)
+//
 // For more Chainsaw examples, see https://github.com/guidewire-oss/sawchain/blob/main/docs/chainsaw-cheatsheet.md.
 func (s *Sawchain) Check(ctx context.Context, args ...any) error {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, true, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true, Flags: options.FlagStrict | options.FlagDecodeSecrets}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
-	// Check required options
-	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+	return s.checkFunc(ctx, opts)()
+}
 
-	// Split documents
-	documents, err := util.SplitYAML(opts.Template)
-	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedSplitYAML)
+// CheckFunc returns a function that searches the cluster for resources matching YAML expectations defined
+// in a template and optionally saves found matches to objects for type-safe access.
+//
+// The returned function performs the same operations as Check, but is particularly useful for
+// polling scenarios where resources might not be immediately available.
+//
+// For details on arguments, examples, and behavior, see the documentation for Check.
+func (s *Sawchain) CheckFunc(ctx context.Context, args ...any) func() error {
+	s.t.Helper()
 
-	// Validate objects length
-	if opts.Object != nil {
-		s.g.Expect(documents).To(gomega.HaveLen(1), errObjectInsufficient)
-	} else if opts.Objects != nil {
-		s.g.Expect(opts.Objects).To(gomega.HaveLen(len(documents)), errObjectsWrongLength)
-	}
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true, Flags: options.FlagStrict | options.FlagDecodeSecrets}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	return s.checkFunc(ctx, opts)
+}
+
+// CheckNone searches the cluster for resources matching YAML expectations defined in a template and
+// returns an error if any are found. It is the inverse of Check, equivalent to a Chainsaw error
+// operation without polling.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template containing
+//     type metadata and expectations of resources that must not exist. If the template contains multiple
+//     documents, none of them may have a match.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - DecodeSecrets (sawchain.Flag): If provided, the data of Secrets is compared in decoded form, like
+//     stringData. Enabled by default if Sawchain was initialized with DecodeSecrets.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Candidates are selected and matched the same way as in Check, including full support for Chainsaw
+//     JMESPath expressions, except that Strict is never applied (a partial template would otherwise
+//     never match, making the check pass vacuously). A document succeeds if no candidate exists at all.
+//
+//   - When matches are found, the returned error lists them and unwraps to an *UnexpectedMatchError via
+//     errors.As for programmatic inspection. Its detail level follows the Sawchain instance's configured
+//     Verbosity.
+//
+//   - Use CheckNoneFunc if you need to create a CheckNone function for polling, or CheckNoneConsistently
+//     if you need to ensure resources stay absent over time.
+//
+// # Examples
+//
+// Check that no ConfigMap with specific labels exists in a namespace:
+//
+//	err := sc.CheckNone(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    namespace: ($namespace)
+//	    labels:
+//	      app: myapp
+//	  `, map[string]any{"namespace": "default"})
+//
+// Wait until no Pod in a namespace is failing:
+//
+//	Eventually(sc.CheckNoneFunc(ctx, `
+//	  apiVersion: v1
+//	  kind: Pod
+//	  metadata:
+//	    namespace: default
+//	  status:
+//	    phase: Failed
+//	`)).Should(Succeed())
+func (s *Sawchain) CheckNone(ctx context.Context, args ...any) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Template: true, Flags: options.FlagDecodeSecrets}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	return s.checkNoneFunc(ctx, opts)()
+}
+
+// CheckNoneFunc returns a function that searches the cluster for resources matching YAML expectations
+// defined in a template and returns an error if any are found.
+//
+// The returned function performs the same operations as CheckNone, but is particularly useful for
+// polling scenarios where resources might take time to disappear.
+//
+// For details on arguments, examples, and behavior, see the documentation for CheckNone.
+func (s *Sawchain) CheckNoneFunc(ctx context.Context, args ...any) func() error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Template: true, Flags: options.FlagDecodeSecrets}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	return s.checkNoneFunc(ctx, opts)
+}
+
+// CheckCount searches the cluster for resources matching YAML expectations defined in a template and returns
+// an error if the number of matches does not satisfy the count constraint.
+//
+// # Arguments
+//
+// The count constraint is required and must be provided before any other arguments. Create it with Exactly,
+// AtLeast, AtMost, or Between.
+//
+// The following arguments may be provided in any order after the count:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template containing
+//     type metadata and expectations of the resources to count. Must contain exactly one resource expectation
+//     document.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - DecodeSecrets (sawchain.Flag): If provided, the data of Secrets is compared in decoded form, like
+//     stringData, and redacted in failure output below VerbosityVerbose. Enabled by default if Sawchain
+//     was initialized with DecodeSecrets.
+//
+// # Notes
+//
+//   - Invalid input (including an unsatisfiable count constraint) will result in immediate test failure.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Candidates are selected and matched the same way as in Check, including full support for Chainsaw
+//     JMESPath expressions, except that Strict is never applied. Finding no candidates counts as zero
+//     matches.
+//
+//   - When the constraint is not satisfied, the returned error reports how many candidates were considered
+//     and how many matched, lists the matches, and (if too few matched) details the best non-matching
+//     attempts. It unwraps to a *CountError via errors.As for programmatic inspection, and its detail level
+//     follows the Sawchain instance's configured Verbosity.
+//
+//   - Use CheckCountFunc if you need to create a CheckCount function for polling.
+//
+// # Examples
+//
+// Check that exactly 3 Pods with specific labels are Ready:
+//
+//	err := sc.CheckCount(ctx, sawchain.Exactly(3), `
+//	  apiVersion: v1
+//	  kind: Pod
+//	  metadata:
+//	    namespace: ($namespace)
+//	    labels:
+//	      app: myapp
+//	  status:
+//	    (conditions[?type == 'Ready']):
+//	    - status: 'True'
+//	  `, map[string]any{"namespace": "default"})
+//
+// Wait for at least one Event with a specific reason:
+//
+//	Eventually(sc.CheckCountFunc(ctx, sawchain.AtLeast(1), `
+//	  apiVersion: events.k8s.io/v1
+//	  kind: Event
+//	  metadata:
+//	    namespace: default
+//	  reason: BackOff
+//	`)).Should(Succeed())
+func (s *Sawchain) CheckCount(ctx context.Context, count Count, args ...any) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Template: true, Flags: options.FlagDecodeSecrets}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(count.Validate()).To(gomega.Succeed(), errInvalidArgs)
 
-	// Execute checks
+	// Execute check
 	bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-	matches := make([]unstructured.Unstructured, len(documents))
-	for i, document := range documents {
-		match, err := chainsaw.Check(s.c, ctx, document, bindings)
-		if err != nil {
-			return formatMatchError(err, s.opts.Verbosity, document, bindings)
-		}
-		matches[i] = match
+	if _, err := chainsaw.CheckCount(s.c, ctx, opts.Template, bindings, s.funcs, count, s.selectOptions(opts)); err != nil {
+		return formatMatchError(err, s.opts.Verbosity, opts.Template, bindings)
 	}
 
-	// Save matches
-	if opts.Object != nil {
-		s.g.Expect(util.CopyUnstructuredToObject(s.c, matches[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
-	} else if opts.Objects != nil {
-		for i, match := range matches {
-			s.g.Expect(util.CopyUnstructuredToObject(s.c, match, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
+	return nil
+}
+
+// CheckCountFunc returns a function that searches the cluster for resources matching YAML expectations
+// defined in a template and returns an error if the number of matches does not satisfy the count
+// constraint.
+//
+// The returned function performs the same operations as CheckCount, but is particularly useful for
+// polling scenarios where the number of matches might take time to settle.
+//
+// For details on arguments, examples, and behavior, see the documentation for CheckCount.
+func (s *Sawchain) CheckCountFunc(ctx context.Context, count Count, args ...any) func() error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Template: true, Flags: options.FlagDecodeSecrets}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(count.Validate()).To(gomega.Succeed(), errInvalidArgs)
+
+	return func() error {
+		s.t.Helper()
+
+		// Execute check
+		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+		if _, err := chainsaw.CheckCount(s.c, ctx, opts.Template, bindings, s.funcs, count, s.selectOptions(opts)); err != nil {
+			return formatMatchError(err, s.opts.Verbosity, opts.Template, bindings)
 		}
+
+		return nil
 	}
+}
 
-	return nil
+// CheckOwnedBy searches the cluster for resources owned by an owner that match YAML expectations defined in
+// a template, and optionally saves found matches to objects for type-safe access. A resource is owned if one
+// of its ownerReferences points at the owner. If no match is found, a detailed error will be returned.
+//
+// # Arguments
+//
+// The owner is required and must be provided before any other arguments:
+//
+//   - Owner (client.Object): Typed or unstructured object identifying the owner by type, name, and (if set)
+//     UID. Typed owners without type metadata are resolved through the client scheme.
+//
+// The following arguments may be provided in any order after the owner:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template containing
+//     type metadata and expectations of owned resources to check. If provided with an object, must contain
+//     exactly one resource expectation document matching the type of the object. If provided with a slice of
+//     objects, must contain resource expectation documents exactly matching the count, order, and types of the
+//     objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Object (client.Object): Typed or unstructured object to populate with the state of the first match (if
+//     found) for the expected resource defined in the template. Only valid with a single-document template.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects to populate with the states of the
+//     first matches (if found) for each expected resource defined in the template.
+//
+//   - Strict (sawchain.Flag): If provided, resources with fields absent in the expectation do not match.
+//     Enabled by default if Sawchain was initialized with Strict.
+//
+//   - IgnorePaths (sawchain.IgnorePaths): Field paths exempt from strict matching, in addition to
+//     Sawchain's global ignore paths. If multiple are provided, they will be combined.
+//
+//   - DecodeSecrets (sawchain.Flag): If provided, the data of Secrets is compared in decoded form, like
+//     stringData, and redacted in failure output below VerbosityVerbose. Enabled by default if Sawchain
+//     was initialized with DecodeSecrets.
+//
+// # Notes
+//
+//   - Invalid input (including an owner whose type cannot be resolved) will result in immediate test failure.
+//
+//   - Expectations without a namespace are looked up in the owner's namespace, so templates for children
+//     of namespaced owners typically only need type metadata and fields of interest.
+//
+//   - Owner references are compared by API group, kind, and name, plus UID if both the reference and the
+//     owner have one. The API version is ignored, since references may record any served version.
+//
+//   - Candidates are otherwise selected and matched the same way as in Check. If candidates exist but none
+//     is owned by the owner, the returned error says so; otherwise it unwraps to a *MatchError like Check.
+//
+//   - Use CheckOwnedByFunc if you need to create a CheckOwnedBy function for polling. To also require the
+//     owner to be the controller, combine with BeControlledBy on the saved object.
+//
+// # Examples
+//
+// Check that a PodSet owns a ConfigMap with specific data:
+//
+//	err := sc.CheckOwnedBy(ctx, podSet, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  data:
+//	    replicas: "3"
+//	`)
+//
+// Wait for a Deployment to own a ReplicaSet and assert it is the controller:
+//
+//	replicaSet := &appsv1.ReplicaSet{}
+//	Eventually(sc.CheckOwnedByFunc(ctx, deployment, replicaSet, `
+//	  apiVersion: apps/v1
+//	  kind: ReplicaSet
+//	`)).Should(Succeed())
+//	Expect(replicaSet).To(sc.BeControlledBy(deployment))
+func (s *Sawchain) CheckOwnedBy(ctx context.Context, owner client.Object, args ...any) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true, Flags: options.FlagStrict | options.FlagDecodeSecrets}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	return s.checkOwnedByFunc(ctx, owner, opts)()
 }
 
-// CheckFunc returns a function that searches the cluster for resources matching YAML expectations defined
-// in a template and optionally saves found matches to objects for type-safe access.
+// CheckOwnedByFunc returns a function that searches the cluster for resources owned by an owner that match
+// YAML expectations defined in a template, and optionally saves found matches to objects for type-safe access.
 //
-// The returned function performs the same operations as Check, but is particularly useful for
-// polling scenarios where resources might not be immediately available.
+// The returned function performs the same operations as CheckOwnedBy, but is particularly useful for
+// polling scenarios where a controller might not have created the owned resources yet.
 //
-// For details on arguments, examples, and behavior, see the documentation for Check.
-func (s *Sawchain) CheckFunc(ctx context.Context, args ...any) func() error {
+// For details on arguments, examples, and behavior, see the documentation for CheckOwnedBy.
+func (s *Sawchain) CheckOwnedByFunc(ctx context.Context, owner client.Object, args ...any) func() error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true, Flags: options.FlagStrict | options.FlagDecodeSecrets}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	return s.checkOwnedByFunc(ctx, owner, opts)
+}
+
+// CheckEvent searches the cluster for Events about an object that match YAML expectations defined in a
+// template, and optionally saves found matches to objects for type-safe access. Both core/v1 and
+// events.k8s.io/v1 Events are supported. If no match is found, a detailed error will be returned.
+//
+// # Arguments
+//
+// The involved object is required and must be provided before any other arguments:
+//
+//   - Object (client.Object): Typed or unstructured object the Events are about, identified by UID if set,
+//     or else by type, namespace, and name. Typed objects without type metadata are resolved through the
+//     client scheme.
+//
+// The following arguments may be provided in any order after the involved object:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template containing
+//     type metadata (apiVersion "v1" or "events.k8s.io/v1" and kind "Event") and expectations of Events to
+//     check. If provided with an object, must contain exactly one Event expectation document. If provided
+//     with a slice of objects, must contain Event expectation documents exactly matching the count and order
+//     of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Object (client.Object): Typed or unstructured object to populate with the state of the first matching
+//     Event (if found), e.g. a *corev1.Event. Only valid with a single-document template.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects to populate with the states of the
+//     first matching Events (if found) for each expected Event defined in the template.
+//
+//   - Strict (sawchain.Flag): If provided, Events with fields absent in the expectation do not match.
+//     Unlike in Check, not enabled by default if Sawchain was initialized with Strict.
+//
+//   - IgnorePaths (sawchain.IgnorePaths): Field paths exempt from strict matching, in addition to
+//     Sawchain's global ignore paths. If multiple are provided, they will be combined.
+//
+// # Notes
+//
+//   - Invalid input (including an involved object whose type cannot be resolved) will result in immediate
+//     test failure.
+//
+//   - Candidates are Events of the template's API whose involvedObject (core/v1) or regarding
+//     (events.k8s.io/v1) reference points at the involved object, so templates typically only need type
+//     metadata and fields of interest such as reason, type, and message (core/v1) or note
+//     (events.k8s.io/v1). Chainsaw expressions work as usual, e.g. (contains(message, 'scaled')): true.
+//
+//   - Expectations without a namespace are looked up in the involved object's namespace. Events about
+//     cluster-scoped objects are looked up in all namespaces.
+//
+//   - References are compared by UID if both the reference and the involved object have one, and otherwise
+//     by API group, kind, namespace, and name.
+//
+//   - If Events exist but none is about the involved object, the returned error says so; otherwise it
+//     unwraps to a *MatchError like Check.
+//
+//   - Use CheckEventFunc if you need to create a CheckEvent function for polling, or HaveEmittedEvent to
+//     assert on Events with Gomega.
+//
+// # Examples
+//
+// Check that a warning was recorded for a PodSet:
+//
+//	err := sc.CheckEvent(ctx, podSet, `
+//	  apiVersion: v1
+//	  kind: Event
+//	  type: Warning
+//	  reason: ReconcileFailed
+//	  (contains(message, 'quota exceeded')): true
+//	`)
+//
+// Wait for an events.k8s.io/v1 Event and save it:
+//
+//	event := &eventsv1.Event{}
+//	Eventually(sc.CheckEventFunc(ctx, deployment, event, `
+//	  apiVersion: events.k8s.io/v1
+//	  kind: Event
+//	  reason: ScalingReplicaSet
+//	`)).Should(Succeed())
+func (s *Sawchain) CheckEvent(ctx context.Context, obj client.Object, args ...any) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(s.eventDefaults(), options.Include{Object: true, Objects: true, Template: true, Flags: options.FlagStrict}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	return s.checkEventFunc(ctx, obj, opts)()
+}
+
+// CheckEventFunc returns a function that searches the cluster for Events about an object that match YAML
+// expectations defined in a template, and optionally saves found matches to objects for type-safe access.
+//
+// The returned function performs the same operations as CheckEvent, but is particularly useful for
+// polling scenarios where a controller might not have recorded the Events yet.
+//
+// For details on arguments, examples, and behavior, see the documentation for CheckEvent.
+func (s *Sawchain) CheckEventFunc(ctx context.Context, obj client.Object, args ...any) func() error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(s.eventDefaults(), options.Include{Object: true, Objects: true, Template: true, Flags: options.FlagStrict}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	return s.checkEventFunc(ctx, obj, opts)
+}
+
+// CheckAndWait searches the cluster for resources matching YAML expectations defined in a template until
+// matches are found within a configurable duration, and optionally saves found matches to objects for
+// type-safe access. This is equivalent to polling CheckFunc with Eventually using Sawchain's durations.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template containing
+//     type metadata and expectations of resources to check. If provided with an object, must contain exactly
+//     one resource expectation document matching the type of the object. If provided with a slice of objects,
+//     must contain resource expectation documents exactly matching the count, order, and types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Object (client.Object): Typed or unstructured object to populate with the state of the first match
+//     found for the expected resource defined in the template. Only valid with a single-document template.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects to populate with the states of the
+//     first matches found for each expected resource defined in the template.
+//
+//   - Strict (sawchain.Flag): If provided, resources with fields absent in the expectation do not match.
+//     Enabled by default if Sawchain was initialized with Strict.
+//
+//   - IgnorePaths (sawchain.IgnorePaths): Field paths exempt from strict matching, in addition to
+//     Sawchain's global ignore paths. If multiple are provided, they will be combined.
+//
+//   - DecodeSecrets (sawchain.Flag): If provided, the data of Secrets is compared in decoded form, like
+//     stringData, and redacted in failure output below VerbosityVerbose. Enabled by default if Sawchain
+//     was initialized with DecodeSecrets.
+//
+//   - Timeout (string or time.Duration): Duration within which matches should be found. If provided, must
+//     be before interval. Defaults to Sawchain's global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for checking the resources. If provided, must
+//     be after timeout. Defaults to Sawchain's global interval value.
+//
+// # Notes
+//
+//   - Invalid input and timeout errors will result in immediate test failure.
+//
+//   - Checks are performed the same way as in Check. On timeout, the failure message includes the
+//     match error from the last check, whose detail level follows the Sawchain instance's configured
+//     Verbosity.
+//
+// # Examples
+//
+// Wait for a Deployment to become available:
+//
+//	sc.CheckAndWait(ctx, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: test-deployment
+//	    namespace: ($namespace)
+//	  status:
+//	    (conditions[?type == 'Available']):
+//	    - status: 'True'
+//	  `, map[string]any{"namespace": "default"})
+//
+// Wait up to a minute for a ConfigMap with specific data and save the match to an object:
+//
+//	configMap := &corev1.ConfigMap{}
+//	sc.CheckAndWait(ctx, configMap, "1m", `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: default
+//	  data:
+//	    key: value
+//	`)
+func (s *Sawchain) CheckAndWait(ctx context.Context, args ...any) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true, Flags: options.FlagStrict | options.FlagDecodeSecrets}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Wait for checks to succeed
+	s.g.Eventually(s.checkFunc(ctx, opts), opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCheckNotSatisfied)
+}
+
+// CheckConsistently searches the cluster for resources matching YAML expectations defined in a template
+// repeatedly over a configurable duration, and fails the test as soon as a check fails. This ensures
+// resources stay in the expected state, e.g. that a controller does not revert or disturb them.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template containing
+//     type metadata and expectations of resources to check. If provided with an object, must contain exactly
+//     one resource expectation document matching the type of the object. If provided with a slice of objects,
+//     must contain resource expectation documents exactly matching the count, order, and types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Object (client.Object): Typed or unstructured object to populate with the state of the first match
+//     found by the last check. Only valid with a single-document template.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects to populate with the states of the
+//     first matches found by the last check for each expected resource defined in the template.
+//
+//   - Strict (sawchain.Flag): If provided, resources with fields absent in the expectation do not match.
+//     Enabled by default if Sawchain was initialized with Strict.
+//
+//   - IgnorePaths (sawchain.IgnorePaths): Field paths exempt from strict matching, in addition to
+//     Sawchain's global ignore paths. If multiple are provided, they will be combined.
+//
+//   - DecodeSecrets (sawchain.Flag): If provided, the data of Secrets is compared in decoded form, like
+//     stringData, and redacted in failure output below VerbosityVerbose. Enabled by default if Sawchain
+//     was initialized with DecodeSecrets.
+//
+//   - Timeout (string or time.Duration): Duration for which the checks must keep succeeding. If provided,
+//     must be before interval. Defaults to Sawchain's global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval between checks. If provided, must be after
+//     timeout. Defaults to Sawchain's global interval value.
+//
+// # Notes
+//
+//   - Invalid input and check failures will result in immediate test failure.
+//
+//   - Checks are performed the same way as in Check, starting immediately and repeating until the
+//     timeout has elapsed.
+//
+//   - The failure message reports the elapsed time at which the check first failed, together with
+//     the formatted match error (whose detail level follows the Sawchain instance's configured
+//     Verbosity).
+//
+// # Examples
+//
+// Ensure a ConfigMap keeps specific data for Sawchain's global timeout:
+//
+//	sc.CheckConsistently(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: ($namespace)
+//	  data:
+//	    key: value
+//	  `, map[string]any{"namespace": "default"})
+//
+// Ensure a Deployment stays scaled down for 10 seconds, checking every second:
+//
+//	sc.CheckConsistently(ctx, "10s", "1s", `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: test-deployment
+//	    namespace: default
+//	  spec:
+//	    replicas: 0
+//	`)
+func (s *Sawchain) CheckConsistently(ctx context.Context, args ...any) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true, Flags: options.FlagStrict | options.FlagDecodeSecrets}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Hold checks
+	s.holdConsistently(ctx, s.checkFunc(ctx, opts), opts, errCheckNotConsistent)
+}
+
+// CheckNoneConsistently searches the cluster for resources matching YAML expectations defined in a template
+// repeatedly over a configurable duration, and fails the test as soon as any are found. This ensures
+// resources stay absent, e.g. that a controller does not create or recreate them.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template containing
+//     type metadata and expectations of resources that must not exist. If the template contains multiple
+//     documents, none of them may have a match.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - DecodeSecrets (sawchain.Flag): If provided, the data of Secrets is compared in decoded form, like
+//     stringData. Enabled by default if Sawchain was initialized with DecodeSecrets.
+//
+//   - Timeout (string or time.Duration): Duration for which no match may be found. If provided, must be
+//     before interval. Defaults to Sawchain's global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval between checks. If provided, must be after
+//     timeout. Defaults to Sawchain's global interval value.
+//
+// # Notes
+//
+//   - Invalid input and check failures will result in immediate test failure.
+//
+//   - Checks are performed the same way as in CheckNone, starting immediately and repeating until the
+//     timeout has elapsed.
+//
+//   - The failure message reports the elapsed time at which a match was first found, together with
+//     the formatted unexpected match error (whose detail level follows the Sawchain instance's
+//     configured Verbosity).
+//
+// # Examples
+//
+// Ensure no Pod in a namespace fails for 30 seconds:
+//
+//	sc.CheckNoneConsistently(ctx, "30s", `
+//	  apiVersion: v1
+//	  kind: Pod
+//	  metadata:
+//	    namespace: ($namespace)
+//	  status:
+//	    phase: Failed
+//	  `, map[string]any{"namespace": "default"})
+func (s *Sawchain) CheckNoneConsistently(ctx context.Context, args ...any) {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, true, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Template: true, Flags: options.FlagDecodeSecrets}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Hold checks
+	s.holdConsistently(ctx, s.checkNoneFunc(ctx, opts), opts, errCheckNoneNotConsistent)
+}
+
+// HELPERS
+
+// checkFunc validates opts and returns a function executing the checks they describe.
+func (s *Sawchain) checkFunc(ctx context.Context, opts *options.Options) func() error {
+	s.t.Helper()
+	return s.checkDocumentsFunc(opts, func(document string, bindings chainsaw.Bindings) (unstructured.Unstructured, error) {
+		return chainsaw.Check(s.c, ctx, document, bindings, s.funcs, s.matchOptions(opts))
+	})
+}
+
+// checkOwnedByFunc validates the owner and opts and returns a function executing the checks
+// they describe among resources owned by the owner.
+func (s *Sawchain) checkOwnedByFunc(ctx context.Context, owner client.Object, opts *options.Options) func() error {
+	s.t.Helper()
+
+	// Resolve owner
+	ownerGVK := s.ownerGVK(owner)
+
+	return s.checkDocumentsFunc(opts, func(document string, bindings chainsaw.Bindings) (unstructured.Unstructured, error) {
+		return chainsaw.CheckOwnedBy(s.c, ctx, document, bindings, s.funcs, owner, ownerGVK, s.matchOptions(opts))
+	})
+}
+
+// checkDocumentsFunc validates opts and returns a function executing check for each document of
+// the template they describe, then saving the matches to the objects they describe.
+func (s *Sawchain) checkDocumentsFunc(
+	opts *options.Options,
+	check func(document string, bindings chainsaw.Bindings) (unstructured.Unstructured, error),
+) func() error {
+	s.t.Helper()
+
 	// Check required options
 	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
 
@@ -205,7 +922,7 @@ func (s *Sawchain) CheckFunc(ctx context.Context, args ...any) func() error {
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
 		matches := make([]unstructured.Unstructured, len(documents))
 		for i, document := range documents {
-			match, err := chainsaw.Check(s.c, ctx, document, bindings)
+			match, err := check(document, bindings)
 			if err != nil {
 				return formatMatchError(err, s.opts.Verbosity, document, bindings)
 			}
@@ -224,3 +941,42 @@ func (s *Sawchain) CheckFunc(ctx context.Context, args ...any) func() error {
 		return nil
 	}
 }
+
+// checkEventFunc validates opts and returns a function executing the Event checks they describe for obj.
+func (s *Sawchain) checkEventFunc(ctx context.Context, obj client.Object, opts *options.Options) func() error {
+	s.t.Helper()
+
+	// Resolve involved object
+	objGVK := s.involvedObjectGVK(obj)
+
+	return s.checkDocumentsFunc(opts, func(document string, bindings chainsaw.Bindings) (unstructured.Unstructured, error) {
+		return chainsaw.CheckEvent(s.c, ctx, document, bindings, s.funcs, obj, objGVK, s.matchOptions(opts))
+	})
+}
+
+// checkNoneFunc validates opts and returns a function executing the absence checks they describe.
+func (s *Sawchain) checkNoneFunc(ctx context.Context, opts *options.Options) func() error {
+	s.t.Helper()
+
+	// Check required options
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Split documents
+	documents, err := util.SplitYAML(opts.Template)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedSplitYAML)
+
+	return func() error {
+		s.t.Helper()
+
+		// Execute checks
+		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+		for _, document := range documents {
+			if err := chainsaw.CheckNone(s.c, ctx, document, bindings, s.funcs, s.selectOptions(opts)); err != nil {
+				return formatMatchError(err, s.opts.Verbosity, document, bindings)
+			}
+		}
+
+		return nil
+	}
+}
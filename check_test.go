@@ -2,10 +2,12 @@ package sawchain_test
 
 import (
 	"errors"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -560,6 +562,62 @@ var _ = Describe("Check and CheckFunc", func() {
 			},
 		}),
 
+		Entry("single resource strict match with ignore paths", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: value
+				`,
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				sawchain.Strict,
+				sawchain.IgnorePaths{"metadata.resourceVersion", "metadata.creationTimestamp", "metadata.managedFields"},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: value
+				`,
+			},
+		}),
+
+		Entry("single resource match with decoded Secret data", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: czNjcjN0
+				`,
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				sawchain.DecodeSecrets,
+				&corev1.Secret{},
+				`
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: s3cr3t
+				`,
+			},
+			expectedMatchObj: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "default"},
+				Data:       map[string][]byte{"password": []byte("s3cr3t")},
+			},
+		}),
+
 		// Error cases (no match)
 
 		// Single resource no match
@@ -688,6 +746,71 @@ var _ = Describe("Check and CheckFunc", func() {
 			},
 		}),
 
+		Entry("single resource no match - unexpected fields in strict mode", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				  labels:
+				    app: test
+				data:
+				  key1: value1
+				  key2: value2
+				`,
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				sawchain.Strict,
+				sawchain.IgnorePaths{"metadata.resourceVersion", "metadata.creationTimestamp", "metadata.managedFields"},
+				sawchain.IgnorePaths{"metadata.labels"},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key1: value1
+				`,
+			},
+			expectedReturnErrs: []string{
+				"data.key2: Forbidden: field is not present in expectation",
+			},
+		}),
+
+		Entry("single resource no match - decoded Secret data is redacted", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: czNjcjN0
+				`,
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				sawchain.DecodeSecrets,
+				`
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: wrong
+				`,
+			},
+			expectedReturnErrs: []string{
+				"data.password: Invalid value: \"<redacted>\": Expected value: <redacted>",
+			},
+			inspectReturnErr: func(err error) {
+				Expect(err.Error()).NotTo(ContainSubstring("s3cr3t"))
+				Expect(err.Error()).NotTo(ContainSubstring("czNjcjN0"))
+			},
+		}),
+
 		Entry("single resource no match - JMESPath expression fails", testCase{
 			resourcesYaml: `
 				apiVersion: v1
@@ -920,6 +1043,25 @@ var _ = Describe("Check and CheckFunc", func() {
 			},
 		}),
 
+		Entry("failure - invalid ignore path", testCase{
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				sawchain.Strict,
+				sawchain.IgnorePaths{"metadata[labels"},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"invalid ignore path: field path \"metadata[labels\" has an unclosed bracket",
+			},
+		}),
+
 		Entry("failure - template missing binding", testCase{
 			client: testutil.NewStandardFakeClient(),
 			methodArgs: []any{
@@ -1155,3 +1297,1724 @@ var _ = Describe("Check and CheckFunc verbosity", func() {
 		}),
 	)
 })
+
+var _ = Describe("CheckNone and CheckNoneFunc", func() {
+	type testCase struct {
+		resourcesYaml       string
+		client              client.Client
+		globalBindings      map[string]any
+		globalArgs          []any
+		methodArgs          []any
+		expectedReturnErrs  []string
+		expectedFailureLogs []string
+		inspectReturnErr    func(err error)
+	}
+
+	const resourcesYaml = `
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  name: test-cm1
+		  namespace: default
+		  labels:
+		    app: test
+		data:
+		  key: value1
+		---
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  name: test-cm2
+		  namespace: default
+		  labels:
+		    app: test
+		data:
+		  key: value2
+	`
+
+	DescribeTableSubtree("checking the cluster for absent resources",
+		func(tc testCase) {
+			var (
+				t  *MockT
+				sc *sawchain.Sawchain
+			)
+
+			BeforeEach(func() {
+				// Initialize Sawchain
+				t = &MockT{TB: GinkgoTB()}
+				sc = sawchain.New(t, tc.client, append([]any{tc.globalBindings}, tc.globalArgs...)...)
+
+				// Create resources
+				if tc.resourcesYaml != "" {
+					sc.CreateAndWait(ctx, tc.resourcesYaml)
+				}
+			})
+
+			AfterEach(func() {
+				// Delete resources
+				if tc.resourcesYaml != "" {
+					sc.DeleteAndWait(ctx, tc.resourcesYaml)
+				}
+			})
+
+			verify := func(err error) {
+				GinkgoT().Helper()
+
+				// Verify error
+				if len(tc.expectedReturnErrs) > 0 {
+					Expect(err).To(HaveOccurred(), "expected error")
+					for _, expectedErr := range tc.expectedReturnErrs {
+						Expect(err.Error()).To(ContainSubstring(expectedErr))
+					}
+				} else {
+					Expect(err).NotTo(HaveOccurred(), "expected no error")
+				}
+
+				// Verify structured error (e.g. recoverable via errors.As)
+				if tc.inspectReturnErr != nil {
+					tc.inspectReturnErr(err)
+				}
+
+				// Verify failure
+				if len(tc.expectedFailureLogs) > 0 {
+					Expect(t.Failed()).To(BeTrue(), "expected failure")
+					for _, expectedLog := range tc.expectedFailureLogs {
+						Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+					}
+				} else {
+					Expect(t.Failed()).To(BeFalse(), "expected no failure")
+				}
+			}
+
+			It("checks resources correctly (CheckNone)", func() {
+				// Test CheckNone
+				var err error
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					err = sc.CheckNone(ctx, tc.methodArgs...)
+				}()
+				<-done
+
+				// Verify results
+				verify(err)
+			})
+
+			It("checks resources correctly (CheckNoneFunc)", func() {
+				// Test CheckNoneFunc
+				var err error
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					err = sc.CheckNoneFunc(ctx, tc.methodArgs...)()
+				}()
+				<-done
+
+				// Verify results
+				verify(err)
+			})
+		},
+
+		// Success cases (no match)
+		Entry("no candidates", testCase{
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				`,
+			},
+		}),
+
+		Entry("named resource not found", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm3
+				  namespace: default
+				`,
+			},
+		}),
+
+		Entry("no candidate matches data", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  labels:
+				    app: test
+				data:
+				  key: ($value)
+				`,
+				map[string]any{"value": "value3"},
+			},
+		}),
+
+		Entry("no candidate matches multiple documents with global bindings", testCase{
+			resourcesYaml:  resourcesYaml,
+			client:         testutil.NewStandardFakeClient(),
+			globalBindings: map[string]any{"namespace": "other"},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: ($namespace)
+				---
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  namespace: default
+				`,
+			},
+		}),
+
+		Entry("decoded Secret data without DecodeSecrets", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: czNjcjN0
+				`,
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: s3cr3t
+				`,
+			},
+		}),
+
+		// Error cases (unexpected match)
+		Entry("single candidate matches", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  labels:
+				    app: test
+				data:
+				  (ends_with(key, '2')): true
+				`,
+			},
+			expectedReturnErrs: []string{
+				"expected no matches, but 1 of 2 candidates matched expectation; first match: v1/ConfigMap/default/test-cm2",
+				"[MATCH #1]",
+			},
+			inspectReturnErr: func(err error) {
+				var ume *sawchain.UnexpectedMatchError
+				Expect(errors.As(err, &ume)).To(BeTrue(), "expected *UnexpectedMatchError")
+				Expect(ume.Candidates).To(Equal(2))
+				Expect(ume.Matches).To(HaveLen(1))
+				Expect(ume.Matches[0].GetName()).To(Equal("test-cm2"))
+			},
+		}),
+
+		Entry("Secret values are redacted", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: czNjcjN0
+				`,
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				sawchain.DecodeSecrets,
+				`
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: s3cr3t
+				`,
+			},
+			expectedReturnErrs: []string{
+				"first match: v1/Secret/default/test-secret",
+				"password: <redacted>",
+			},
+			inspectReturnErr: func(err error) {
+				Expect(err.Error()).NotTo(ContainSubstring("s3cr3t"))
+				Expect(err.Error()).NotTo(ContainSubstring("czNjcjN0"))
+			},
+		}),
+
+		Entry("candidate with extra fields matches with strict instance", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			globalArgs:    []any{sawchain.Strict},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				`,
+			},
+			expectedReturnErrs: []string{
+				"first match: v1/ConfigMap/default/test-cm1",
+				"[MATCH #1]",
+			},
+		}),
+
+		Entry("multiple candidates match", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				  labels:
+				    app: test
+				`,
+			},
+			expectedReturnErrs: []string{
+				"expected no matches, but 2 of 2 candidates matched expectation; first match: v1/ConfigMap/default/test-cm1",
+				"[OTHER MATCHES]",
+				"Match #2: v1/ConfigMap/default/test-cm2",
+			},
+		}),
+
+		Entry("second document matches", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  namespace: default
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				`,
+			},
+			expectedReturnErrs: []string{
+				"expected no matches, but 1 of 1 candidates matched expectation; first match: v1/ConfigMap/default/test-cm1",
+			},
+		}),
+
+		Entry("decoded Secret data matches with global DecodeSecrets", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: czNjcjN0
+				`,
+			client:     testutil.NewStandardFakeClient(),
+			globalArgs: []any{sawchain.DecodeSecrets},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: s3cr3t
+				`,
+			},
+			expectedReturnErrs: []string{
+				"expected no matches, but 1 of 1 candidates matched expectation; first match: v1/Secret/default/test-secret",
+			},
+		}),
+
+		Entry("decoded Secret data matches with DecodeSecrets argument", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: czNjcjN0
+				`,
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				sawchain.DecodeSecrets,
+				`
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: s3cr3t
+				`,
+			},
+			expectedReturnErrs: []string{
+				"expected no matches, but 1 of 1 candidates matched expectation; first match: v1/Secret/default/test-secret",
+			},
+		}),
+
+		Entry("list failure", testCase{
+			client: &MockClient{
+				Client:         testutil.NewStandardFakeClient(),
+				listFailFirstN: -1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				`,
+			},
+			expectedReturnErrs: []string{
+				"failed to list candidates; ensure template contains required fields",
+				"simulated list failure",
+			},
+		}),
+
+		// Failure cases
+		Entry("strict argument", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				sawchain.Strict,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unsupported flag argument: Strict",
+			},
+		}),
+
+		Entry("no template", testCase{
+			client: testutil.NewStandardFakeClient(),
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string)",
+			},
+		}),
+
+		Entry("object argument", testCase{
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				&corev1.ConfigMap{},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unexpected argument type: *v1.ConfigMap",
+			},
+		}),
+
+		Entry("invalid bindings", testCase{
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: ($name)
+				`,
+				map[string]any{"name": make(chan int)},
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid bindings",
+				"ensure binding values are JSON-serializable",
+			},
+		}),
+	)
+})
+
+var _ = Describe("CheckNone verbosity", func() {
+	type verbosityTestCase struct {
+		verbosity    sawchain.Verbosity
+		containsErrs []string
+		excludesErrs []string
+	}
+
+	const template = `
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  name: test-verbosity-check-none-cm
+		  namespace: default
+	`
+
+	DescribeTable("unexpected match output in return error",
+		func(tc verbosityTestCase) {
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, testutil.NewStandardFakeClient(), tc.verbosity)
+			sc.CreateAndWait(ctx, `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-verbosity-check-none-cm
+				  namespace: default
+				data:
+				  key1: actual-value
+			`)
+
+			err := sc.CheckNone(ctx, template)
+			Expect(err).To(HaveOccurred())
+			for _, s := range tc.containsErrs {
+				Expect(err.Error()).To(ContainSubstring(s))
+			}
+			for _, s := range tc.excludesErrs {
+				Expect(err.Error()).NotTo(ContainSubstring(s))
+			}
+		},
+		Entry("VerbosityMinimal lists matches only", verbosityTestCase{
+			verbosity:    sawchain.VerbosityMinimal,
+			containsErrs: []string{"* v1/ConfigMap/default/test-verbosity-check-none-cm"},
+			excludesErrs: []string{"[MATCH #1]", "key1: actual-value", "[EXPECTED]", "[TEMPLATE]", "[BINDINGS]"},
+		}),
+		Entry("VerbosityNormal includes match YAML but omits verbose context", verbosityTestCase{
+			verbosity:    sawchain.VerbosityNormal,
+			containsErrs: []string{"[MATCH #1]", "key1: actual-value"},
+			excludesErrs: []string{"[EXPECTED]", "[TEMPLATE]", "[BINDINGS]"},
+		}),
+		Entry("VerbosityVerbose includes match YAML, expectation, template, and bindings", verbosityTestCase{
+			verbosity:    sawchain.VerbosityVerbose,
+			containsErrs: []string{"[MATCH #1]", "key1: actual-value", "[EXPECTED]", "[TEMPLATE]", "[BINDINGS]"},
+		}),
+	)
+})
+
+var _ = Describe("CheckCount and CheckCountFunc", func() {
+	type testCase struct {
+		resourcesYaml       string
+		client              client.Client
+		globalBindings      map[string]any
+		globalArgs          []any
+		count               sawchain.Count
+		methodArgs          []any
+		expectedReturnErrs  []string
+		expectedFailureLogs []string
+		inspectReturnErr    func(err error)
+	}
+
+	const resourcesYaml = `
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  name: test-cm1
+		  namespace: default
+		  labels:
+		    app: test
+		data:
+		  key: value1
+		---
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  name: test-cm2
+		  namespace: default
+		  labels:
+		    app: test
+		data:
+		  key: value2
+		---
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  name: test-cm3
+		  namespace: default
+		  labels:
+		    app: test
+		data:
+		  key: value2
+	`
+
+	const value2Template = `
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  labels:
+		    app: test
+		data:
+		  key: ($value)
+	`
+
+	DescribeTableSubtree("counting matching resources",
+		func(tc testCase) {
+			var (
+				t  *MockT
+				sc *sawchain.Sawchain
+			)
+
+			BeforeEach(func() {
+				// Initialize Sawchain
+				t = &MockT{TB: GinkgoTB()}
+				sc = sawchain.New(t, tc.client, append([]any{tc.globalBindings}, tc.globalArgs...)...)
+
+				// Create resources
+				if tc.resourcesYaml != "" {
+					sc.CreateAndWait(ctx, tc.resourcesYaml)
+				}
+			})
+
+			AfterEach(func() {
+				// Delete resources
+				if tc.resourcesYaml != "" {
+					sc.DeleteAndWait(ctx, tc.resourcesYaml)
+				}
+			})
+
+			verify := func(err error) {
+				GinkgoT().Helper()
+
+				// Verify error
+				if len(tc.expectedReturnErrs) > 0 {
+					Expect(err).To(HaveOccurred(), "expected error")
+					for _, expectedErr := range tc.expectedReturnErrs {
+						Expect(err.Error()).To(ContainSubstring(expectedErr))
+					}
+				} else {
+					Expect(err).NotTo(HaveOccurred(), "expected no error")
+				}
+
+				// Verify structured error (e.g. recoverable via errors.As)
+				if tc.inspectReturnErr != nil {
+					tc.inspectReturnErr(err)
+				}
+
+				// Verify failure
+				if len(tc.expectedFailureLogs) > 0 {
+					Expect(t.Failed()).To(BeTrue(), "expected failure")
+					for _, expectedLog := range tc.expectedFailureLogs {
+						Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+					}
+				} else {
+					Expect(t.Failed()).To(BeFalse(), "expected no failure")
+				}
+			}
+
+			It("counts resources correctly (CheckCount)", func() {
+				// Test CheckCount
+				var err error
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					err = sc.CheckCount(ctx, tc.count, tc.methodArgs...)
+				}()
+				<-done
+
+				// Verify results
+				verify(err)
+			})
+
+			It("counts resources correctly (CheckCountFunc)", func() {
+				// Test CheckCountFunc
+				var err error
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					err = sc.CheckCountFunc(ctx, tc.count, tc.methodArgs...)()
+				}()
+				<-done
+
+				// Verify results
+				verify(err)
+			})
+		},
+
+		// Success cases
+		Entry("exact count", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			count:         sawchain.Exactly(2),
+			methodArgs:    []any{value2Template, map[string]any{"value": "value2"}},
+		}),
+
+		Entry("minimum count", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			count:         sawchain.AtLeast(1),
+			methodArgs:    []any{value2Template, map[string]any{"value": "value2"}},
+		}),
+
+		Entry("maximum count with global bindings", testCase{
+			resourcesYaml:  resourcesYaml,
+			client:         testutil.NewStandardFakeClient(),
+			globalBindings: map[string]any{"value": "value1"},
+			count:          sawchain.AtMost(1),
+			methodArgs:     []any{value2Template},
+		}),
+
+		Entry("count range", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			count:         sawchain.Between(2, 3),
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				  labels:
+				    app: test
+			`},
+		}),
+
+		Entry("partial template with strict instance", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			globalArgs:    []any{sawchain.Strict},
+			count:         sawchain.Exactly(3),
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				  labels:
+				    app: test
+				`,
+			},
+		}),
+
+		Entry("zero matches with no candidates", testCase{
+			client: testutil.NewStandardFakeClient(),
+			count:  sawchain.Exactly(0),
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+			`},
+		}),
+
+		// Error cases
+		Entry("too few matches", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			count:         sawchain.AtLeast(3),
+			methodArgs:    []any{value2Template, map[string]any{"value": "value2"}},
+			expectedReturnErrs: []string{
+				"expected at least 3 matching resource(s), but 2 of 3 candidates matched expectation",
+				"* v1/ConfigMap/default/test-cm2",
+				"* v1/ConfigMap/default/test-cm3",
+				"best non-matching attempt: v1/ConfigMap/default/test-cm1 (1 field error)",
+				"data.key: Invalid value: \"value1\"",
+			},
+			inspectReturnErr: func(err error) {
+				var ce *sawchain.CountError
+				Expect(errors.As(err, &ce)).To(BeTrue(), "expected *CountError")
+				Expect(ce.Count).To(Equal(sawchain.AtLeast(3)))
+				Expect(ce.Candidates).To(Equal(3))
+				Expect(ce.Matches).To(HaveLen(2))
+				Expect(ce.Attempts).To(HaveLen(1))
+			},
+		}),
+
+		Entry("too many matches", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			count:         sawchain.Exactly(1),
+			methodArgs:    []any{value2Template, map[string]any{"value": "value2"}},
+			expectedReturnErrs: []string{
+				"expected exactly 1 matching resource(s), but 2 of 3 candidates matched expectation",
+				"[MATCHES]",
+				"* v1/ConfigMap/default/test-cm2",
+				"* v1/ConfigMap/default/test-cm3",
+			},
+		}),
+
+		Entry("named resource not found", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			count:         sawchain.Exactly(1),
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm4
+				  namespace: default
+			`},
+			expectedReturnErrs: []string{
+				"expected exactly 1 matching resource(s), but 0 of 0 candidates matched expectation",
+			},
+		}),
+
+		Entry("list failure", testCase{
+			client: &MockClient{
+				Client:         testutil.NewStandardFakeClient(),
+				listFailFirstN: -1,
+			},
+			count: sawchain.AtLeast(1),
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+			`},
+			expectedReturnErrs: []string{
+				"failed to list candidates; ensure template contains required fields",
+				"simulated list failure",
+			},
+		}),
+
+		// Failure cases
+		Entry("no template", testCase{
+			client: testutil.NewStandardFakeClient(),
+			count:  sawchain.Exactly(1),
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string)",
+			},
+		}),
+
+		Entry("negative minimum", testCase{
+			client: testutil.NewStandardFakeClient(),
+			count:  sawchain.AtLeast(-1),
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+			`},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"count minimum is negative",
+			},
+		}),
+
+		Entry("maximum less than minimum", testCase{
+			client: testutil.NewStandardFakeClient(),
+			count:  sawchain.Between(3, 2),
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+			`},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"count maximum (2) is less than minimum (3)",
+			},
+		}),
+
+		Entry("multiple documents", testCase{
+			client: testutil.NewStandardFakeClient(),
+			count:  sawchain.Exactly(1),
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				---
+				apiVersion: v1
+				kind: Secret
+			`},
+			expectedReturnErrs: []string{"expected template to contain a single resource; found 2"},
+		}),
+	)
+})
+
+var _ = Describe("CheckOwnedBy and CheckOwnedByFunc", func() {
+	type testCase struct {
+		resourcesYaml       string
+		owner               client.Object
+		methodArgs          []any
+		expectedReturnErrs  []string
+		expectedFailureLogs []string
+		expectedObj         client.Object
+	}
+
+	const resourcesYaml = `
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  name: test-owned
+		  namespace: default
+		  labels:
+		    app: test
+		  ownerReferences:
+		  - apiVersion: v1
+		    kind: ConfigMap
+		    name: test-owner
+		    uid: owner-uid
+		    controller: true
+		data:
+		  key: owned
+		---
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  name: test-orphan
+		  namespace: default
+		  labels:
+		    app: test
+		data:
+		  key: orphan
+	`
+
+	owner := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-owner", Namespace: "default", UID: "owner-uid"}}
+
+	DescribeTableSubtree("checking owned resources",
+		func(tc testCase) {
+			var (
+				t  *MockT
+				sc *sawchain.Sawchain
+			)
+
+			BeforeEach(func() {
+				// Initialize Sawchain
+				t = &MockT{TB: GinkgoTB()}
+				sc = sawchain.New(t, testutil.NewStandardFakeClient())
+
+				// Create resources
+				if tc.resourcesYaml != "" {
+					sc.CreateAndWait(ctx, tc.resourcesYaml)
+				}
+			})
+
+			AfterEach(func() {
+				// Delete resources
+				if tc.resourcesYaml != "" {
+					sc.DeleteAndWait(ctx, tc.resourcesYaml)
+				}
+			})
+
+			verify := func(err error) {
+				GinkgoT().Helper()
+
+				// Verify error
+				if len(tc.expectedReturnErrs) > 0 {
+					Expect(err).To(HaveOccurred(), "expected error")
+					for _, expectedErr := range tc.expectedReturnErrs {
+						Expect(err.Error()).To(ContainSubstring(expectedErr))
+					}
+				} else {
+					Expect(err).NotTo(HaveOccurred(), "expected no error")
+				}
+
+				// Verify failure
+				if len(tc.expectedFailureLogs) > 0 {
+					Expect(t.Failed()).To(BeTrue(), "expected failure")
+					for _, expectedLog := range tc.expectedFailureLogs {
+						Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+					}
+				} else {
+					Expect(t.Failed()).To(BeFalse(), "expected no failure")
+				}
+
+				// Verify saved match
+				if tc.expectedObj != nil {
+					for _, arg := range tc.methodArgs {
+						if obj, ok := arg.(client.Object); ok {
+							Expect(obj.GetName()).To(Equal(tc.expectedObj.GetName()), "match not saved to provided object")
+						}
+					}
+				}
+			}
+
+			It("checks owned resources correctly (CheckOwnedBy)", func() {
+				// Test CheckOwnedBy
+				var err error
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					err = sc.CheckOwnedBy(ctx, tc.owner, tc.methodArgs...)
+				}()
+				<-done
+
+				// Verify results
+				verify(err)
+			})
+
+			It("checks owned resources correctly (CheckOwnedByFunc)", func() {
+				// Test CheckOwnedByFunc
+				var err error
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					err = sc.CheckOwnedByFunc(ctx, tc.owner, tc.methodArgs...)()
+				}()
+				<-done
+
+				// Verify results
+				verify(err)
+			})
+		},
+
+		// Success cases
+		Entry("owned resource in owner namespace", testCase{
+			resourcesYaml: resourcesYaml,
+			owner:         owner,
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  labels:
+				    app: test
+			`},
+		}),
+
+		Entry("owned resource saved to object", testCase{
+			resourcesYaml: resourcesYaml,
+			owner:         owner,
+			methodArgs: []any{&corev1.ConfigMap{}, `
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key: ($value)
+			`, map[string]any{"value": "owned"}},
+			expectedObj: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-owned"}},
+		}),
+
+		Entry("owner without UID", testCase{
+			resourcesYaml: resourcesYaml,
+			owner:         &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-owner", Namespace: "default"}},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-owned
+			`},
+		}),
+
+		// Failure cases
+		Entry("matching resource not owned", testCase{
+			resourcesYaml: resourcesYaml,
+			owner:         owner,
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key: orphan
+			`},
+			expectedReturnErrs: []string{
+				`data.key: Invalid value: "owned": Expected value: "orphan"`,
+			},
+		}),
+
+		Entry("named resource not owned", testCase{
+			resourcesYaml: resourcesYaml,
+			owner:         owner,
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-orphan
+			`},
+			expectedReturnErrs: []string{
+				"no actual resource owned by v1/ConfigMap default/test-owner found (1 candidate(s) not owned)",
+			},
+		}),
+
+		Entry("owner with different UID", testCase{
+			resourcesYaml: resourcesYaml,
+			owner:         &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-owner", Namespace: "default", UID: "other-uid"}},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-owned
+			`},
+			expectedReturnErrs: []string{"no actual resource owned by v1/ConfigMap default/test-owner found"},
+		}),
+
+		// Error cases
+		Entry("nil owner", testCase{
+			owner:               nil,
+			methodArgs:          []any{"apiVersion: v1\nkind: ConfigMap\n"},
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] owner must not be nil"},
+		}),
+
+		Entry("owner without name", testCase{
+			owner:               &corev1.ConfigMap{},
+			methodArgs:          []any{"apiVersion: v1\nkind: ConfigMap\n"},
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] owner name must not be empty"},
+		}),
+
+		Entry("owner type not registered", testCase{
+			owner:               &testutil.TestResource{ObjectMeta: metav1.ObjectMeta{Name: "test-owner"}},
+			methodArgs:          []any{"apiVersion: v1\nkind: ConfigMap\n"},
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] failed to determine owner GroupVersionKind"},
+		}),
+
+		Entry("missing template", testCase{
+			owner:               owner,
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] invalid arguments", "required argument(s) not provided: Template (string)"},
+		}),
+	)
+})
+
+var _ = Describe("CheckEvent and CheckEventFunc", func() {
+	type testCase struct {
+		resourcesYaml       string
+		obj                 client.Object
+		globalArgs          []any
+		methodArgs          []any
+		expectedReturnErrs  []string
+		expectedFailureLogs []string
+		expectedObj         client.Object
+	}
+
+	const resourcesYaml = `
+		apiVersion: v1
+		kind: Event
+		metadata:
+		  name: test-cm.failed
+		  namespace: default
+		involvedObject:
+		  apiVersion: v1
+		  kind: ConfigMap
+		  name: test-cm
+		  namespace: default
+		  uid: test-uid
+		reason: SyncFailed
+		type: Warning
+		message: failed to sync ConfigMap test-cm
+		---
+		apiVersion: v1
+		kind: Event
+		metadata:
+		  name: test-other.created
+		  namespace: default
+		involvedObject:
+		  apiVersion: v1
+		  kind: ConfigMap
+		  name: test-other
+		  namespace: default
+		reason: Created
+		type: Normal
+		message: ConfigMap test-other created
+		---
+		apiVersion: events.k8s.io/v1
+		kind: Event
+		metadata:
+		  name: test-cm.synced
+		  namespace: default
+		eventTime: "2025-01-01T00:00:00.000000Z"
+		reportingController: example.com/controller
+		reportingInstance: controller-0
+		action: Sync
+		regarding:
+		  apiVersion: v1
+		  kind: ConfigMap
+		  name: test-cm
+		  namespace: default
+		reason: Synced
+		type: Normal
+		note: ConfigMap test-cm synced
+	`
+
+	obj := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-cm", Namespace: "default", UID: "test-uid"}}
+
+	DescribeTableSubtree("checking Events about an object",
+		func(tc testCase) {
+			var (
+				t  *MockT
+				sc *sawchain.Sawchain
+			)
+
+			BeforeEach(func() {
+				// Initialize Sawchain
+				t = &MockT{TB: GinkgoTB()}
+				sc = sawchain.New(t, testutil.NewStandardFakeClient(), tc.globalArgs...)
+
+				// Create resources
+				if tc.resourcesYaml != "" {
+					sc.CreateAndWait(ctx, tc.resourcesYaml)
+				}
+			})
+
+			AfterEach(func() {
+				// Delete resources
+				if tc.resourcesYaml != "" {
+					sc.DeleteAndWait(ctx, tc.resourcesYaml)
+				}
+			})
+
+			verify := func(err error) {
+				GinkgoT().Helper()
+
+				// Verify error
+				if len(tc.expectedReturnErrs) > 0 {
+					Expect(err).To(HaveOccurred(), "expected error")
+					for _, expectedErr := range tc.expectedReturnErrs {
+						Expect(err.Error()).To(ContainSubstring(expectedErr))
+					}
+				} else {
+					Expect(err).NotTo(HaveOccurred(), "expected no error")
+				}
+
+				// Verify failure
+				if len(tc.expectedFailureLogs) > 0 {
+					Expect(t.Failed()).To(BeTrue(), "expected failure")
+					for _, expectedLog := range tc.expectedFailureLogs {
+						Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+					}
+				} else {
+					Expect(t.Failed()).To(BeFalse(), "expected no failure")
+				}
+
+				// Verify saved match
+				if tc.expectedObj != nil {
+					for _, arg := range tc.methodArgs {
+						if obj, ok := arg.(client.Object); ok {
+							Expect(obj.GetName()).To(Equal(tc.expectedObj.GetName()), "match not saved to provided object")
+						}
+					}
+				}
+			}
+
+			It("checks Events correctly (CheckEvent)", func() {
+				// Test CheckEvent
+				var err error
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					err = sc.CheckEvent(ctx, tc.obj, tc.methodArgs...)
+				}()
+				<-done
+
+				// Verify results
+				verify(err)
+			})
+
+			It("checks Events correctly (CheckEventFunc)", func() {
+				// Test CheckEventFunc
+				var err error
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					err = sc.CheckEventFunc(ctx, tc.obj, tc.methodArgs...)()
+				}()
+				<-done
+
+				// Verify results
+				verify(err)
+			})
+		},
+
+		// Success cases
+		Entry("core Event by reason, type, and message expression", testCase{
+			resourcesYaml: resourcesYaml,
+			obj:           obj,
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: Event
+				reason: SyncFailed
+				type: Warning
+				(contains(message, ($name))): true
+			`, map[string]any{"name": "test-cm"}},
+		}),
+
+		Entry("core Event saved to object", testCase{
+			resourcesYaml: resourcesYaml,
+			obj:           obj,
+			methodArgs: []any{&corev1.Event{}, `
+				apiVersion: v1
+				kind: Event
+				type: Warning
+			`},
+			expectedObj: &corev1.Event{ObjectMeta: metav1.ObjectMeta{Name: "test-cm.failed"}},
+		}),
+
+		Entry("events.k8s.io Event by note", testCase{
+			resourcesYaml: resourcesYaml,
+			obj:           obj,
+			methodArgs: []any{`
+				apiVersion: events.k8s.io/v1
+				kind: Event
+				reason: Synced
+				note: ConfigMap test-cm synced
+			`},
+		}),
+
+		Entry("object without UID", testCase{
+			resourcesYaml: resourcesYaml,
+			obj:           &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-other", Namespace: "default"}},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: Event
+				reason: Created
+			`},
+		}),
+
+		Entry("partial template with strict instance", testCase{
+			resourcesYaml: resourcesYaml,
+			obj:           obj,
+			globalArgs:    []any{sawchain.Strict},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: Event
+				reason: SyncFailed
+			`},
+		}),
+
+		// Failure cases
+		Entry("partial template with strict argument", testCase{
+			resourcesYaml: resourcesYaml,
+			obj:           obj,
+			methodArgs: []any{sawchain.Strict, `
+				apiVersion: v1
+				kind: Event
+				reason: SyncFailed
+			`},
+			expectedReturnErrs: []string{
+				"Forbidden: field is not present in expectation",
+			},
+		}),
+
+		Entry("matching Event about another object", testCase{
+			resourcesYaml: resourcesYaml,
+			obj:           obj,
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: Event
+				reason: Created
+			`},
+			expectedReturnErrs: []string{
+				`reason: Invalid value: "SyncFailed": Expected value: "Created"`,
+			},
+		}),
+
+		Entry("no Events about the object", testCase{
+			resourcesYaml: resourcesYaml,
+			obj:           &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-missing", Namespace: "default"}},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: Event
+			`},
+			expectedReturnErrs: []string{
+				"no actual v1 Event found for v1/ConfigMap default/test-missing (2 candidate(s) about other objects)",
+			},
+		}),
+
+		Entry("template without Event type metadata", testCase{
+			obj: obj,
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+			`},
+			expectedReturnErrs: []string{
+				"expected template to define a v1 or events.k8s.io/v1 Event; found v1/ConfigMap",
+			},
+		}),
+
+		// Error cases
+		Entry("nil object", testCase{
+			obj:                 nil,
+			methodArgs:          []any{"apiVersion: v1\nkind: Event\n"},
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] involved object must not be nil"},
+		}),
+
+		Entry("object without name", testCase{
+			obj:                 &corev1.ConfigMap{},
+			methodArgs:          []any{"apiVersion: v1\nkind: Event\n"},
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] involved object name must not be empty"},
+		}),
+
+		Entry("object type not registered", testCase{
+			obj:                 &testutil.TestResource{ObjectMeta: metav1.ObjectMeta{Name: "test-resource"}},
+			methodArgs:          []any{"apiVersion: v1\nkind: Event\n"},
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] failed to determine involved object GroupVersionKind"},
+		}),
+
+		Entry("missing template", testCase{
+			obj:                 obj,
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] invalid arguments", "required argument(s) not provided: Template (string)"},
+		}),
+	)
+})
+
+var _ = Describe("CheckConsistently and CheckNoneConsistently", func() {
+	type testCase struct {
+		resourcesYaml       string
+		client              client.Client
+		disturb             func(c client.Client)
+		none                bool
+		globalArgs          []any
+		methodArgs          []any
+		expectedFailureLogs []string
+	}
+
+	const resourcesYaml = `
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  name: test-cm
+		  namespace: default
+		data:
+		  key: value
+	`
+
+	DescribeTable("holding checks over time",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, append([]any{fastTimeout, fastInterval}, tc.globalArgs...)...)
+
+			// Create resources
+			if tc.resourcesYaml != "" {
+				sc.CreateAndWait(ctx, tc.resourcesYaml)
+			}
+
+			// Disturb resources while holding
+			if tc.disturb != nil {
+				go func() {
+					defer GinkgoRecover()
+					time.Sleep(fastTimeout / 4)
+					tc.disturb(tc.client)
+				}()
+			}
+
+			// Test CheckConsistently or CheckNoneConsistently
+			done := make(chan struct{})
+			start := time.Now()
+			go func() {
+				defer close(done)
+				if tc.none {
+					sc.CheckNoneConsistently(ctx, tc.methodArgs...)
+				} else {
+					sc.CheckConsistently(ctx, tc.methodArgs...)
+				}
+			}()
+			<-done
+			executionTime := time.Since(start)
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+				Expect(executionTime).To(BeNumerically("<", fastTimeout), "expected failure before timeout")
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+				Expect(executionTime).To(BeNumerically(">=", fastTimeout), "expected checks to hold for timeout")
+			}
+		},
+
+		// Success cases
+		Entry("should hold a matching resource", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: value
+			`},
+		}),
+
+		Entry("should hold an absent resource", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			none:          true,
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: other
+			`},
+		}),
+
+		// Failure cases
+		Entry("should fail immediately when check fails", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: other
+			`},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] check did not hold consistently: invariant broke after",
+				"data.key: Invalid value: \"value\": Expected value: \"other\"",
+			},
+		}),
+
+		Entry("should fail when resource is changed", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			disturb: func(c client.Client) {
+				cm := &corev1.ConfigMap{}
+				Expect(c.Get(ctx, client.ObjectKey{Name: "test-cm", Namespace: "default"}, cm)).To(Succeed())
+				cm.Data["key"] = "changed"
+				Expect(c.Update(ctx, cm)).To(Succeed())
+			},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: value
+			`},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] check did not hold consistently: invariant broke after",
+				"data.key: Invalid value: \"changed\": Expected value: \"value\"",
+			},
+		}),
+
+		Entry("should fail when absent resource appears", testCase{
+			client: testutil.NewStandardFakeClient(),
+			none:   true,
+			disturb: func(c client.Client) {
+				cm := &corev1.ConfigMap{}
+				cm.SetName("test-cm")
+				cm.SetNamespace("default")
+				Expect(c.Create(ctx, cm)).To(Succeed())
+			},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+			`},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] absence check did not hold consistently: invariant broke after",
+				"expected no matches, but 1 of 1 candidates matched expectation",
+			},
+		}),
+
+		Entry("should fail when partial template matches with strict instance", testCase{
+			resourcesYaml: resourcesYaml,
+			client:        testutil.NewStandardFakeClient(),
+			none:          true,
+			globalArgs:    []any{sawchain.Strict},
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+			`},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] absence check did not hold consistently: invariant broke after",
+				"expected no matches, but 1 of 1 candidates matched expectation",
+			},
+		}),
+
+		Entry("should fail with no template", testCase{
+			client: testutil.NewStandardFakeClient(),
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string)",
+			},
+		}),
+
+		Entry("should fail with invalid duration order", testCase{
+			client: testutil.NewStandardFakeClient(),
+			none:   true,
+			methodArgs: []any{
+				fastInterval, fastTimeout,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"provided interval is greater than timeout",
+			},
+		}),
+	)
+})
+
+var _ = Describe("CheckAndWait", func() {
+	type testCase struct {
+		resourcesYaml       string
+		client              client.Client
+		delayedYaml         string
+		methodArgs          []any
+		expectedFailureLogs []string
+		expectedObj         client.Object
+	}
+
+	DescribeTable("waiting for matching resources",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval)
+
+			// Create resources
+			if tc.resourcesYaml != "" {
+				sc.CreateAndWait(ctx, tc.resourcesYaml)
+			}
+
+			// Create delayed resources while waiting
+			if tc.delayedYaml != "" {
+				go func() {
+					defer GinkgoRecover()
+					time.Sleep(fastTimeout / 4)
+					sawchain.New(GinkgoTB(), tc.client).CreateAndWait(ctx, tc.delayedYaml)
+				}()
+			}
+
+			// Test CheckAndWait
+			done := make(chan struct{})
+			start := time.Now()
+			go func() {
+				defer close(done)
+				sc.CheckAndWait(ctx, tc.methodArgs...)
+			}()
+			<-done
+			executionTime := time.Since(start)
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+				return
+			}
+			Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			Expect(executionTime).To(BeNumerically("<", fastTimeout), "expected match before timeout")
+
+			// Verify saved state
+			if tc.expectedObj != nil {
+				for _, arg := range tc.methodArgs {
+					if obj, ok := arg.(client.Object); ok {
+						Expect(intent(tc.client, obj)).To(Equal(intent(tc.client, tc.expectedObj)), "match not saved to provided object")
+						break
+					}
+				}
+			}
+		},
+
+		// Success cases
+		Entry("should succeed immediately when resource matches", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: value
+			`,
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				&corev1.ConfigMap{},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: value
+				`,
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+		}),
+
+		Entry("should succeed when resource appears while waiting", testCase{
+			client: testutil.NewStandardFakeClient(),
+			delayedYaml: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: value
+			`,
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: ($value)
+				`,
+				map[string]any{"value": "value"},
+			},
+		}),
+
+		// Failure cases
+		Entry("should fail with last match error when resource does not match within timeout", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: value
+			`,
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: other
+			`},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] check not satisfied within timeout",
+				"data.key: Invalid value: \"value\": Expected value: \"other\"",
+			},
+		}),
+
+		Entry("should fail with no template", testCase{
+			client: testutil.NewStandardFakeClient(),
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string)",
+			},
+		}),
+
+		Entry("should fail with invalid duration order", testCase{
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: []any{
+				fastInterval, fastTimeout,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"provided interval is greater than timeout",
+			},
+		}),
+	)
+})
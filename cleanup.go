@@ -0,0 +1,113 @@
+package sawchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// cleanupRegistry records resources created through a Sawchain instance so they can be
+// deleted on test cleanup. It is safe for concurrent use.
+type cleanupRegistry struct {
+	mu   sync.Mutex
+	objs []client.Object
+}
+
+// add records deep copies of the given objects in creation order.
+func (r *cleanupRegistry) add(objs ...client.Object) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, obj := range objs {
+		if cp, ok := obj.DeepCopyObject().(client.Object); ok {
+			r.objs = append(r.objs, cp)
+		}
+	}
+}
+
+// drain returns the recorded objects in reverse creation order and resets the registry.
+func (r *cleanupRegistry) drain() []client.Object {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	objs := r.objs
+	r.objs = nil
+	slices.Reverse(objs)
+	return objs
+}
+
+// track records the given objects for automatic cleanup if AutoCleanup is enabled.
+func (s *Sawchain) track(objs ...client.Object) {
+	if s.cleanup != nil {
+		s.cleanup.add(objs...)
+	}
+}
+
+// runCleanup deletes all tracked resources in reverse creation order using the instance's
+// delete options and waits for them to disappear using the instance's timeout and interval.
+// Resources that are already gone are ignored; resources stuck on finalizers have them removed
+// if the instance has a finalizer removal window, and are otherwise reported in the failure
+// message.
+func (s *Sawchain) runCleanup() {
+	s.t.Helper()
+
+	objs := s.cleanup.drain()
+	if len(objs) == 0 {
+		return
+	}
+
+	// Use a fresh context since the test context may already be canceled
+	ctx := context.Background()
+
+	// Delete resources
+	deleteOpts := deleteOptions(&s.opts)
+	var errs []error
+	for _, obj := range objs {
+		if err := s.c.Delete(ctx, obj, deleteOpts...); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("%s: %w", s.id(obj), err))
+		}
+	}
+	s.g.Expect(errors.Join(errs...)).NotTo(gomega.HaveOccurred(), errFailedCleanup)
+
+	// Wait for delete to be reflected
+	removeFinalizers := s.finalizerRemover(ctx, objs, &s.opts)
+	checkAll := func() error {
+		if err := removeFinalizers(); err != nil {
+			return err
+		}
+		var errs []error
+		for _, obj := range objs {
+			if err := s.checkGone(ctx, obj); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+	s.g.Eventually(checkAll, s.opts.Timeout, s.opts.Interval).Should(gomega.Succeed(), errCleanupNotReflected)
+}
+
+// checkGone is like checkNotFound, but reports the pending finalizers of resources
+// that are terminating.
+func (s *Sawchain) checkGone(ctx context.Context, obj client.Object) error {
+	err := s.get(ctx, obj)
+	if err == nil {
+		return s.stillPresentError(obj)
+	}
+	if !apierrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// stillPresentError describes a resource that should have been deleted, including its
+// pending finalizers if it is terminating.
+func (s *Sawchain) stillPresentError(obj client.Object) error {
+	if obj.GetDeletionTimestamp() != nil && len(obj.GetFinalizers()) > 0 {
+		return fmt.Errorf("%s: stuck on finalizers %v", s.id(obj), obj.GetFinalizers())
+	}
+	return fmt.Errorf("%s: expected resource not to be found", s.id(obj))
+}
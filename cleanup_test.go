@@ -0,0 +1,224 @@
+package sawchain_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/guidewire-oss/sawchain"
+	"github.com/guidewire-oss/sawchain/internal/testutil"
+)
+
+var _ = Describe("AutoCleanup", func() {
+	var (
+		t           *MockT
+		c           client.Client
+		deleteOrder []string
+	)
+
+	BeforeEach(func() {
+		t = &MockT{TB: GinkgoTB()}
+		deleteOrder = nil
+		c = fake.NewClientBuilder().
+			WithScheme(testutil.NewStandardSchemeWithTestResource()).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+					deleteOrder = append(deleteOrder, obj.GetName())
+					return c.Delete(ctx, obj, opts...)
+				},
+			}).
+			Build()
+	})
+
+	// exists reports whether the resource identified by obj exists in the cluster.
+	exists := func(obj client.Object) bool {
+		GinkgoT().Helper()
+		return c.Get(ctx, client.ObjectKeyFromObject(obj), copy(obj)) == nil
+	}
+
+	It("deletes created resources in reverse creation order on cleanup", func() {
+		sc := sawchain.New(t, c, fastTimeout, fastInterval, sawchain.AutoCleanup)
+		Expect(t.cleanups).To(HaveLen(1), "expected cleanup to be registered")
+
+		cm1 := testutil.NewConfigMap("test-cm1", "default", nil)
+		cm2 := testutil.NewUnstructuredConfigMap("test-cm2", "default", nil)
+		cr := &testutil.TestResource{}
+		Expect(sc.Create(ctx, cm1)).To(Succeed())
+		sc.CreateAndWait(ctx, cr, `
+			apiVersion: example.com/v1
+			kind: TestResource
+			metadata:
+			  name: test-cr
+			  namespace: default
+		`)
+		sc.CreateAndWait(ctx, []client.Object{cm2})
+		Expect(sc.Create(ctx, `
+			apiVersion: v1
+			kind: Secret
+			metadata:
+			  name: test-secret
+			  namespace: default
+		`)).To(Succeed())
+
+		t.RunCleanups()
+
+		Expect(t.Failed()).To(BeFalse(), "expected no failure")
+		Expect(deleteOrder).To(Equal([]string{"test-secret", "test-cm2", "test-cr", "test-cm1"}))
+		Expect(exists(cm1)).To(BeFalse())
+		Expect(exists(cm2)).To(BeFalse())
+		Expect(exists(cr)).To(BeFalse())
+		Expect(exists(&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "default"}})).To(BeFalse())
+	})
+
+	It("ignores resources that were already deleted", func() {
+		sc := sawchain.New(t, c, fastTimeout, fastInterval, sawchain.AutoCleanup)
+		cm := testutil.NewConfigMap("test-cm", "default", nil)
+		sc.CreateAndWait(ctx, cm)
+		sc.DeleteAndWait(ctx, cm)
+
+		t.RunCleanups()
+
+		Expect(t.Failed()).To(BeFalse(), "expected no failure")
+	})
+
+	It("does not record resources that failed to be created", func() {
+		sc := sawchain.New(t, c, fastTimeout, fastInterval, sawchain.AutoCleanup)
+		cm := testutil.NewConfigMap("test-cm", "default", nil)
+		Expect(c.Create(ctx, copy(cm))).To(Succeed())
+		Expect(sc.Create(ctx, cm)).NotTo(Succeed())
+
+		t.RunCleanups()
+
+		Expect(t.Failed()).To(BeFalse(), "expected no failure")
+		Expect(deleteOrder).To(BeEmpty())
+		Expect(exists(cm)).To(BeTrue())
+	})
+
+	It("does not register cleanup without AutoCleanup", func() {
+		sc := sawchain.New(t, c, fastTimeout, fastInterval)
+		cm := testutil.NewConfigMap("test-cm", "default", nil)
+		sc.CreateAndWait(ctx, cm)
+
+		Expect(t.cleanups).To(BeEmpty())
+		Expect(exists(cm)).To(BeTrue())
+	})
+
+	It("shares cleanup with derived instances", func() {
+		sc := sawchain.New(t, c, fastTimeout, fastInterval, sawchain.AutoCleanup)
+		derived := sc.With(map[string]any{"name": "test-cm2"})
+		Expect(t.cleanups).To(HaveLen(1), "expected derived instance not to register cleanup")
+
+		cm1 := testutil.NewConfigMap("test-cm1", "default", nil)
+		cm2 := testutil.NewConfigMap("test-cm2", "default", nil)
+		sc.CreateAndWait(ctx, cm1)
+		derived.CreateAndWait(ctx, `
+			apiVersion: v1
+			kind: ConfigMap
+			metadata:
+			  name: ($name)
+			  namespace: default
+		`)
+
+		t.RunCleanups()
+
+		Expect(t.Failed()).To(BeFalse(), "expected no failure")
+		Expect(deleteOrder).To(Equal([]string{"test-cm2", "test-cm1"}))
+		Expect(exists(cm1)).To(BeFalse())
+		Expect(exists(cm2)).To(BeFalse())
+	})
+
+	It("registers cleanup with NewWithGomega", func() {
+		sc := sawchain.NewWithGomega(t, NewWithT(t), c, fastTimeout, fastInterval, sawchain.AutoCleanup)
+		cm := testutil.NewConfigMap("test-cm", "default", nil)
+		sc.CreateAndWait(ctx, cm)
+
+		t.RunCleanups()
+
+		Expect(t.Failed()).To(BeFalse(), "expected no failure")
+		Expect(exists(cm)).To(BeFalse())
+	})
+
+	It("reports resources stuck on finalizers", func() {
+		sc := sawchain.New(t, c, fastTimeout, fastInterval, sawchain.AutoCleanup)
+		sc.CreateAndWait(ctx, `
+			apiVersion: v1
+			kind: ConfigMap
+			metadata:
+			  name: test-cm
+			  namespace: default
+			  finalizers:
+			  - example.com/finalizer
+		`)
+
+		t.RunCleanups()
+
+		Expect(t.Failed()).To(BeTrue(), "expected failure")
+		Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+			"[SAWCHAIN][ERROR] cleanup not reflected within timeout")))
+		Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+			"ConfigMap (default/test-cm): stuck on finalizers [example.com/finalizer]")))
+	})
+
+	It("removes finalizers of stuck resources after the removal window", func() {
+		sc := sawchain.New(t, c, fastTimeout, fastInterval, sawchain.AutoCleanup,
+			sawchain.RemoveFinalizersAfter(fastTimeout/4))
+		cm := &corev1.ConfigMap{}
+		sc.CreateAndWait(ctx, cm, `
+			apiVersion: v1
+			kind: ConfigMap
+			metadata:
+			  name: test-cm
+			  namespace: default
+			  finalizers:
+			  - example.com/finalizer
+		`)
+
+		t.RunCleanups()
+
+		Expect(t.Failed()).To(BeFalse(), "expected no failure")
+		Expect(exists(cm)).To(BeFalse())
+	})
+
+	It("deletes resources with the configured propagation policy", func() {
+		var policies []metav1.DeletionPropagation
+		c = fake.NewClientBuilder().
+			WithScheme(testutil.NewStandardScheme()).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+					deleteOpts := (&client.DeleteOptions{}).ApplyOptions(opts)
+					if deleteOpts.PropagationPolicy != nil {
+						policies = append(policies, *deleteOpts.PropagationPolicy)
+					}
+					return c.Delete(ctx, obj, opts...)
+				},
+			}).
+			Build()
+		sc := sawchain.New(t, c, fastTimeout, fastInterval, sawchain.AutoCleanup, sawchain.PropagationForeground)
+		sc.CreateAndWait(ctx, testutil.NewConfigMap("test-cm", "default", nil))
+
+		t.RunCleanups()
+
+		Expect(t.Failed()).To(BeFalse(), "expected no failure")
+		Expect(policies).To(Equal([]metav1.DeletionPropagation{metav1.DeletePropagationForeground}))
+	})
+
+	It("fails when AutoCleanup is passed to an operation", func() {
+		sc := sawchain.New(t, c, fastTimeout, fastInterval)
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			_ = sc.Create(ctx, sawchain.AutoCleanup, testutil.NewConfigMap("test-cm", "default", nil))
+		}()
+		<-done
+
+		Expect(t.Failed()).To(BeTrue(), "expected failure")
+		Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("[SAWCHAIN][ERROR] invalid arguments")))
+		Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("unsupported flag argument: AutoCleanup")))
+	})
+})
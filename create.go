@@ -50,6 +50,9 @@ import (
 //   - When running tests in parallel, ensure resource names or namespaces are unique per process to
 //     prevent collisions. See docs/parallel-tests.md for isolation strategies.
 //
+//   - If Sawchain was initialized with AutoCleanup, successfully created resources are recorded and
+//     deleted on test cleanup.
+//
 //   - Use CreateAndWait instead of Create if you need to ensure creation is successful and the client
 //     cache is synced.
 //
@@ -141,7 +144,7 @@ func (s *Sawchain) Create(ctx context.Context, args ...any) error {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, true, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
@@ -152,7 +155,7 @@ func (s *Sawchain) Create(ctx context.Context, args ...any) error {
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings)
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		// Validate objects length
@@ -167,6 +170,7 @@ func (s *Sawchain) Create(ctx context.Context, args ...any) error {
 			if err := s.c.Create(ctx, &unstructuredObj); err != nil {
 				return err
 			}
+			s.track(&unstructuredObj)
 		}
 
 		// Save objects
@@ -182,12 +186,14 @@ func (s *Sawchain) Create(ctx context.Context, args ...any) error {
 		if err := s.c.Create(ctx, opts.Object); err != nil {
 			return err
 		}
+		s.track(opts.Object)
 	} else {
 		// Create resources
 		for _, obj := range opts.Objects {
 			if err := s.c.Create(ctx, obj); err != nil {
 				return err
 			}
+			s.track(obj)
 		}
 	}
 
@@ -243,6 +249,9 @@ func (s *Sawchain) Create(ctx context.Context, args ...any) error {
 //   - When running tests in parallel, ensure resource names or namespaces are unique per process to
 //     prevent collisions. See docs/parallel-tests.md for isolation strategies.
 //
+//   - If Sawchain was initialized with AutoCleanup, created resources are recorded and deleted on
+//     test cleanup.
+//
 //   - Use Create instead of CreateAndWait if you need to create resources without ensuring success.
 //
 // # Examples
@@ -333,7 +342,7 @@ func (s *Sawchain) CreateAndWait(ctx context.Context, args ...any) {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, true, true, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
@@ -345,7 +354,7 @@ func (s *Sawchain) CreateAndWait(ctx context.Context, args ...any) {
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings)
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		// Validate objects length
@@ -358,6 +367,7 @@ func (s *Sawchain) CreateAndWait(ctx context.Context, args ...any) {
 		// Create resources
 		for _, unstructuredObj := range unstructuredObjs {
 			s.g.Expect(s.c.Create(ctx, &unstructuredObj)).To(gomega.Succeed(), errFailedCreateWithTemplate)
+			s.track(&unstructuredObj)
 		}
 
 		// Wait for create to be reflected
@@ -383,6 +393,7 @@ func (s *Sawchain) CreateAndWait(ctx context.Context, args ...any) {
 	} else if opts.Object != nil {
 		// Create resource
 		s.g.Expect(s.c.Create(ctx, opts.Object)).To(gomega.Succeed(), errFailedCreateWithObject)
+		s.track(opts.Object)
 
 		// Wait for create to be reflected
 		s.g.Eventually(s.getF(ctx, opts.Object), opts.Timeout, opts.Interval).Should(gomega.Succeed(), errCreateNotReflected)
@@ -390,6 +401,7 @@ func (s *Sawchain) CreateAndWait(ctx context.Context, args ...any) {
 		// Create resources
 		for _, obj := range opts.Objects {
 			s.g.Expect(s.c.Create(ctx, obj)).To(gomega.Succeed(), errFailedCreateWithObject)
+			s.track(obj)
 		}
 
 		// Wait for create to be reflected
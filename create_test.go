@@ -218,6 +218,26 @@ var _ = Describe("Create", func() {
 			expectedObj: testutil.NewConfigMap("test-cm", "test-ns", map[string]string{"key": "configured-value"}),
 		}),
 
+		Entry("should create ConfigMap with typed options", testCase{
+			client:         &MockClient{Client: testutil.NewStandardFakeClient()},
+			globalBindings: map[string]any{"namespace": "test-ns"},
+			methodArgs: []any{
+				sawchain.WithObject(&corev1.ConfigMap{}),
+				sawchain.WithTemplate(`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: ($name)
+				  namespace: ($namespace)
+				data:
+				  key: ($value)
+				`),
+				sawchain.WithBindings(map[string]any{"name": "test-cm"}),
+				map[string]any{"value": "configured-value"},
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "test-ns", map[string]string{"key": "configured-value"}),
+		}),
+
 		Entry("should create ConfigMap with typed map bindings and save to typed object", testCase{
 			client: &MockClient{Client: testutil.NewStandardFakeClient()},
 			methodArgs: []any{
@@ -614,6 +634,18 @@ var _ = Describe("Create", func() {
 			},
 		}),
 
+		Entry("should fail with unsupported typed option", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.WithTimeout(time.Second),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unsupported option argument: WithTimeout",
+			},
+		}),
+
 		Entry("should fail with non-existent template file", testCase{
 			client: &MockClient{Client: testutil.NewStandardFakeClient()},
 			methodArgs: []any{
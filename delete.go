@@ -2,11 +2,19 @@ package sawchain
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/guidewire-oss/sawchain/internal/chainsaw"
 	"github.com/guidewire-oss/sawchain/internal/options"
+	"github.com/guidewire-oss/sawchain/internal/util"
 )
 
 // Delete deletes resources with objects, a manifest, or a Chainsaw template, and returns an error
@@ -30,6 +38,14 @@ import (
 //     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
 //     natural order.
 //
+//   - PropagationPolicy (sawchain.PropagationPolicy): Whether and how dependents are garbage collected
+//     (PropagationForeground, PropagationBackground, or PropagationOrphan). Defaults to Sawchain's global
+//     propagation policy if configured, otherwise to the server-side default for the resource type.
+//
+//   - GracePeriod (sawchain.GracePeriod): Duration the resources are given to terminate gracefully. Must
+//     be a whole number of seconds. Defaults to Sawchain's global grace period if configured, otherwise
+//     to the server-side default for the resource type.
+//
 // A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
 // may not be provided together.
 //
@@ -60,6 +76,10 @@ import (
 //
 //	err := sc.Delete(ctx, "path/to/resources.yaml")
 //
+// Delete a single resource with foreground propagation and no grace period:
+//
+//	err := sc.Delete(ctx, obj, sawchain.PropagationForeground, sawchain.GracePeriod(0))
+//
 // Delete a single resource with a Chainsaw template and bindings:
 //
 //	err := sc.Delete(ctx, `
@@ -89,35 +109,38 @@ func (s *Sawchain) Delete(ctx context.Context, args ...any) error {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, true, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true, DeleteOptions: true}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
 	// Check required options
 	s.g.Expect(options.RequireTemplateObjectObjects(opts)).To(gomega.Succeed(), errInvalidArgs)
 
+	// Get delete options
+	deleteOpts := deleteOptions(opts)
+
 	if len(opts.Template) > 0 {
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings)
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		// Delete resources
 		for _, unstructuredObj := range unstructuredObjs {
-			if err := s.c.Delete(ctx, &unstructuredObj); err != nil {
+			if err := s.c.Delete(ctx, &unstructuredObj, deleteOpts...); err != nil {
 				return err
 			}
 		}
 	} else if opts.Object != nil {
 		// Delete resource
-		if err := s.c.Delete(ctx, opts.Object); err != nil {
+		if err := s.c.Delete(ctx, opts.Object, deleteOpts...); err != nil {
 			return err
 		}
 	} else {
 		// Delete resources
 		for _, obj := range opts.Objects {
-			if err := s.c.Delete(ctx, obj); err != nil {
+			if err := s.c.Delete(ctx, obj, deleteOpts...); err != nil {
 				return err
 			}
 		}
@@ -156,6 +179,19 @@ func (s *Sawchain) Delete(ctx context.Context, args ...any) error {
 //   - Interval (string or time.Duration): Polling interval for checking the resources after deletion.
 //     If provided, must be after timeout. Defaults to Sawchain's global interval value.
 //
+//   - PropagationPolicy (sawchain.PropagationPolicy): Whether and how dependents are garbage collected
+//     (PropagationForeground, PropagationBackground, or PropagationOrphan). Defaults to Sawchain's global
+//     propagation policy if configured, otherwise to the server-side default for the resource type.
+//
+//   - GracePeriod (sawchain.GracePeriod): Duration the resources are given to terminate gracefully. Must
+//     be a whole number of seconds. Defaults to Sawchain's global grace period if configured, otherwise
+//     to the server-side default for the resource type.
+//
+//   - RemoveFinalizersAfter (sawchain.RemoveFinalizersAfter): Window after which the finalizers of
+//     resources that are still terminating are removed, forcing their deletion. Must be less than the
+//     timeout. Defaults to Sawchain's global finalizer removal window if configured, otherwise finalizers
+//     are never removed.
+//
 // A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
 // may not be provided together. All other arguments are optional.
 //
@@ -169,6 +205,9 @@ func (s *Sawchain) Delete(ctx context.Context, args ...any) error {
 //   - When running tests in parallel, ensure resource names or namespaces are unique per process to
 //     prevent collisions. See docs/parallel-tests.md for isolation strategies.
 //
+//   - Removing finalizers skips whatever cleanup they guard (e.g. external resources owned by a
+//     controller), so only use RemoveFinalizersAfter when that cleanup does not matter to the test.
+//
 //   - Use Delete instead of DeleteAndWait if you need to delete resources without ensuring success.
 //
 // # Examples
@@ -185,6 +224,14 @@ func (s *Sawchain) Delete(ctx context.Context, args ...any) error {
 //
 //	sc.DeleteAndWait(ctx, "path/to/resources.yaml", "10s", "2s")
 //
+// Delete a single resource with foreground propagation, waiting for its dependents to be deleted first:
+//
+//	sc.DeleteAndWait(ctx, obj, sawchain.PropagationForeground)
+//
+// Delete a single resource, removing its finalizers if it is still terminating after 5 seconds:
+//
+//	sc.DeleteAndWait(ctx, obj, "15s", sawchain.RemoveFinalizersAfter(5*time.Second))
+//
 // Delete a single resource with a Chainsaw template and bindings:
 //
 //	sc.DeleteAndWait(ctx, `
@@ -214,7 +261,7 @@ func (s *Sawchain) DeleteAndWait(ctx context.Context, args ...any) {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, true, true, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true, DeleteOptions: true}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
@@ -222,50 +269,386 @@ func (s *Sawchain) DeleteAndWait(ctx context.Context, args ...any) {
 	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
 	s.g.Expect(options.RequireTemplateObjectObjects(opts)).To(gomega.Succeed(), errInvalidArgs)
 
+	// Get delete options
+	deleteOpts := deleteOptions(opts)
+
+	var objs []client.Object
 	if len(opts.Template) > 0 {
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings)
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		// Delete resources
-		for _, unstructuredObj := range unstructuredObjs {
-			s.g.Expect(s.c.Delete(ctx, &unstructuredObj)).To(gomega.Succeed(), errFailedDeleteWithTemplate)
-		}
-
-		// Wait for delete to be reflected
-		checkAll := func() error {
-			for i := range unstructuredObjs {
-				// Use index to update object in outer scope
-				if err := s.checkNotFound(ctx, &unstructuredObjs[i]); err != nil {
-					return err
-				}
-			}
-			return nil
+		for i := range unstructuredObjs {
+			// Use index to update object in outer scope
+			s.g.Expect(s.c.Delete(ctx, &unstructuredObjs[i], deleteOpts...)).To(gomega.Succeed(), errFailedDeleteWithTemplate)
+			objs = append(objs, &unstructuredObjs[i])
 		}
-		s.g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errDeleteNotReflected)
 	} else if opts.Object != nil {
 		// Delete resource
-		s.g.Expect(s.c.Delete(ctx, opts.Object)).To(gomega.Succeed(), errFailedDeleteWithObject)
-
-		// Wait for delete to be reflected
-		s.g.Eventually(s.checkNotFoundF(ctx, opts.Object), opts.Timeout, opts.Interval).Should(gomega.Succeed(), errDeleteNotReflected)
+		s.g.Expect(s.c.Delete(ctx, opts.Object, deleteOpts...)).To(gomega.Succeed(), errFailedDeleteWithObject)
+		objs = []client.Object{opts.Object}
 	} else {
 		// Delete resources
 		for _, obj := range opts.Objects {
-			s.g.Expect(s.c.Delete(ctx, obj)).To(gomega.Succeed(), errFailedDeleteWithObject)
+			s.g.Expect(s.c.Delete(ctx, obj, deleteOpts...)).To(gomega.Succeed(), errFailedDeleteWithObject)
 		}
+		objs = opts.Objects
+	}
 
-		// Wait for delete to be reflected
-		checkAll := func() error {
-			for _, obj := range opts.Objects {
-				if err := s.checkNotFound(ctx, obj); err != nil {
-					return err
-				}
+	// Wait for delete to be reflected
+	removeFinalizers := s.finalizerRemover(ctx, objs, opts)
+	checkAll := func() error {
+		if err := removeFinalizers(); err != nil {
+			return err
+		}
+		for _, obj := range objs {
+			if err := s.checkNotFound(ctx, obj); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	s.g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errDeleteNotReflected)
+}
+
+// DeleteAll deletes every resource in the cluster matching YAML expectations defined in a template, and
+// returns an error if any client List or Delete operations fail. Unlike Delete, template documents do not
+// need to identify resources by name; candidates are selected and matched the same way as in List.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template
+//     containing type metadata and expectations of the resources to be deleted. If the template contains
+//     multiple documents, the matches of each document are deleted in order.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - PropagationPolicy (sawchain.PropagationPolicy): Whether and how dependents are garbage collected
+//     (PropagationForeground, PropagationBackground, or PropagationOrphan). Defaults to Sawchain's global
+//     propagation policy if configured, otherwise to the server-side default for the resource type.
+//
+//   - GracePeriod (sawchain.GracePeriod): Duration the resources are given to terminate gracefully. Must
+//     be a whole number of seconds. Defaults to Sawchain's global grace period if configured, otherwise
+//     to the server-side default for the resource type.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Candidates are listed by type, namespace (if set), and labels (if set), then matched against the
+//     full expectation, including Chainsaw JMESPath expressions. Documents with a name only select that
+//     resource.
+//
+//   - Documents for namespaced resource types must set metadata.name, metadata.namespace, or
+//     metadata.labels. Unscoped documents would delete matches in every namespace (including those
+//     owned by other tests), so they result in immediate test failure. To intentionally delete across
+//     namespaces, select the resources by labels.
+//
+//   - Finding no matches is not an error. Resources that disappear between listing and deletion are
+//     ignored.
+//
+//   - Use DeleteAllAndWait instead of DeleteAll if you need to ensure no matches remain and the client
+//     cache is synced.
+//
+// # Examples
+//
+// Delete all Pods with specific labels in a namespace:
+//
+//	err := sc.DeleteAll(ctx, `
+//	  apiVersion: v1
+//	  kind: Pod
+//	  metadata:
+//	    namespace: ($namespace)
+//	    labels:
+//	      app: myapp
+//	  `, map[string]any{"namespace": "default"})
+//
+// Delete all completed Jobs in a namespace in the background:
+//
+//	err := sc.DeleteAll(ctx, sawchain.PropagationBackground, `
+//	  apiVersion: batch/v1
+//	  kind: Job
+//	  metadata:
+//	    namespace: default
+//	  status:
+//	    (succeeded > `0`): true
+//	`)
+func (s *Sawchain) DeleteAll(ctx context.Context, args ...any) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Template: true, DeleteOptions: true}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Render expectations
+	bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+	expectations := s.renderExpectations(ctx, opts.Template, bindings)
+
+	// Delete matches
+	_, err = s.deleteMatches(ctx, expectations, bindings, deleteOptions(opts))
+	return err
+}
+
+// DeleteAllAndWait deletes every resource in the cluster matching YAML expectations defined in a template,
+// and ensures no matches remain within a configurable duration before returning. If testing with a cached
+// client, this ensures the client cache is synced and it is safe to make assertions on the resources'
+// absence immediately after execution.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template
+//     containing type metadata and expectations of the resources to be deleted. If the template contains
+//     multiple documents, the matches of each document are deleted in order.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Timeout (string or time.Duration): Duration within which no matches should remain. If provided,
+//     must be before interval. Defaults to Sawchain's global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for checking for remaining matches. If
+//     provided, must be after timeout. Defaults to Sawchain's global interval value.
+//
+//   - PropagationPolicy (sawchain.PropagationPolicy): Whether and how dependents are garbage collected
+//     (PropagationForeground, PropagationBackground, or PropagationOrphan). Defaults to Sawchain's global
+//     propagation policy if configured, otherwise to the server-side default for the resource type.
+//
+//   - GracePeriod (sawchain.GracePeriod): Duration the resources are given to terminate gracefully. Must
+//     be a whole number of seconds. Defaults to Sawchain's global grace period if configured, otherwise
+//     to the server-side default for the resource type.
+//
+//   - RemoveFinalizersAfter (sawchain.RemoveFinalizersAfter): Window after which the finalizers of
+//     deleted resources that are still terminating are removed, forcing their deletion. Must be less than
+//     the timeout. Defaults to Sawchain's global finalizer removal window if configured, otherwise
+//     finalizers are never removed.
+//
+// # Notes
+//
+//   - Invalid input, client errors, and timeout errors will result in immediate test failure.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Candidates are listed by type, namespace (if set), and labels (if set), then matched against the
+//     full expectation, including Chainsaw JMESPath expressions. Documents with a name only select that
+//     resource.
+//
+//   - Documents for namespaced resource types must set metadata.name, metadata.namespace, or
+//     metadata.labels, as in DeleteAll.
+//
+//   - Matches are listed again while waiting, so resources recreated by a controller (e.g. Pods of a
+//     ReplicaSet) cause a timeout. Delete the owner instead, or scale it down first.
+//
+//   - Use DeleteAll instead of DeleteAllAndWait if you need to delete resources without ensuring success.
+//
+// # Examples
+//
+// Delete all Pods with specific labels in a namespace and wait for them to disappear:
+//
+//	sc.DeleteAllAndWait(ctx, `
+//	  apiVersion: v1
+//	  kind: Pod
+//	  metadata:
+//	    namespace: ($namespace)
+//	    labels:
+//	      app: myapp
+//	  `, map[string]any{"namespace": "default"})
+//
+// Delete all Jobs and ReplicaSets generated in a namespace, removing stuck finalizers after 5 seconds:
+//
+//	sc.DeleteAllAndWait(ctx, "15s", sawchain.RemoveFinalizersAfter(5*time.Second), `
+//	  apiVersion: batch/v1
+//	  kind: Job
+//	  metadata:
+//	    namespace: default
+//	  ---
+//	  apiVersion: apps/v1
+//	  kind: ReplicaSet
+//	  metadata:
+//	    namespace: default
+//	`)
+func (s *Sawchain) DeleteAllAndWait(ctx context.Context, args ...any) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Template: true, DeleteOptions: true}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Render expectations
+	bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+	expectations := s.renderExpectations(ctx, opts.Template, bindings)
+
+	// Delete matches
+	deleted, err := s.deleteMatches(ctx, expectations, bindings, deleteOptions(opts))
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedDeleteAllWithTemplate)
+
+	// Wait for delete to be reflected
+	removeFinalizers := s.finalizerRemover(ctx, deleted, opts)
+	checkNone := func() error {
+		if err := removeFinalizers(); err != nil {
+			return err
+		}
+		var errs []error
+		for _, expected := range expectations {
+			matches, err := s.listMatches(ctx, expected, bindings)
+			if err != nil {
+				return err
 			}
+			for i := range matches {
+				errs = append(errs, s.stillPresentError(&matches[i]))
+			}
+		}
+		return errors.Join(errs...)
+	}
+	s.g.Eventually(checkNone, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errDeleteNotReflected)
+}
+
+// HELPERS
+
+// deleteOptions converts the propagation policy and grace period in opts to client delete options.
+func deleteOptions(opts *options.Options) []client.DeleteOption {
+	var deleteOpts []client.DeleteOption
+	if opts.PropagationPolicy != "" {
+		deleteOpts = append(deleteOpts, client.PropagationPolicy(opts.PropagationPolicy))
+	}
+	if opts.GracePeriod != nil {
+		deleteOpts = append(deleteOpts, client.GracePeriodSeconds(int64(opts.GracePeriod.Seconds())))
+	}
+	return deleteOpts
+}
+
+// finalizerRemover returns a function to be called on every poll while waiting for objs to be
+// deleted. Once the RemoveFinalizersAfter window in opts has elapsed, it removes the finalizers
+// of any resources that are still terminating. It does nothing if the window is not set.
+func (s *Sawchain) finalizerRemover(ctx context.Context, objs []client.Object, opts *options.Options) func() error {
+	start := time.Now()
+	return func() error {
+		if opts.RemoveFinalizersAfter == 0 || time.Since(start) < opts.RemoveFinalizersAfter {
 			return nil
 		}
-		s.g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errDeleteNotReflected)
+		var errs []error
+		for _, obj := range objs {
+			if err := s.removeFinalizers(ctx, obj); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	}
+}
+
+// removeFinalizers clears the finalizers of obj if it is terminating. Resources that are
+// already gone or not yet terminating are left alone.
+func (s *Sawchain) removeFinalizers(ctx context.Context, obj client.Object) error {
+	if err := s.get(ctx, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	finalizers := obj.GetFinalizers()
+	if obj.GetDeletionTimestamp() == nil || len(finalizers) == 0 {
+		return nil
+	}
+	patch := client.RawPatch(types.MergePatchType, []byte(`{"metadata":{"finalizers":null}}`))
+	if err := s.c.Patch(ctx, obj, patch); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("%s: failed to remove finalizers %v: %w", s.id(obj), finalizers, err)
+	}
+	s.logInfo("%s: %s %v", infoRemovedFinalizers, s.id(obj), finalizers)
+	return nil
+}
+
+// renderExpectations renders each document of the template as a single resource expectation.
+func (s *Sawchain) renderExpectations(ctx context.Context, template string, bindings chainsaw.Bindings) []unstructured.Unstructured {
+	s.t.Helper()
+
+	// Split documents
+	documents, err := util.SplitYAML(template)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedSplitYAML)
+
+	// Render documents
+	expectations := make([]unstructured.Unstructured, len(documents))
+	for i, document := range documents {
+		expectations[i], err = chainsaw.RenderTemplateSingle(ctx, document, bindings, s.funcs)
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+		s.g.Expect(s.isScoped(&expectations[i])).To(gomega.BeTrue(), errUnscopedDeleteAll)
+	}
+	return expectations
+}
+
+// isScoped returns true if the expectation selects resources by name, namespace, or labels, or
+// if its type is cluster-scoped. Types of unknown scope are treated as namespaced.
+func (s *Sawchain) isScoped(expected *unstructured.Unstructured) bool {
+	if expected.GetName() != "" || expected.GetNamespace() != "" || len(expected.GetLabels()) != 0 {
+		return true
+	}
+	namespaced, err := s.c.IsObjectNamespaced(expected)
+	return err == nil && !namespaced
+}
+
+// listMatches lists the cluster resources matching the expectation. A named resource that
+// does not exist yields no matches rather than an error.
+func (s *Sawchain) listMatches(
+	ctx context.Context,
+	expected unstructured.Unstructured,
+	bindings chainsaw.Bindings,
+) ([]unstructured.Unstructured, error) {
+	candidates, err := chainsaw.ListCandidates(s.c, ctx, &expected)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return chainsaw.MatchAll(ctx, candidates, expected, bindings, s.funcs, s.selectOptions(&s.opts))
+}
+
+// deleteMatches deletes the cluster resources matching each expectation in order and returns
+// the deleted resources. Resources that are already gone are ignored.
+func (s *Sawchain) deleteMatches(
+	ctx context.Context,
+	expectations []unstructured.Unstructured,
+	bindings chainsaw.Bindings,
+	deleteOpts []client.DeleteOption,
+) ([]client.Object, error) {
+	var deleted []client.Object
+	for _, expected := range expectations {
+		matches, err := s.listMatches(ctx, expected, bindings)
+		if err != nil {
+			return deleted, err
+		}
+		for i := range matches {
+			if err := s.c.Delete(ctx, &matches[i], deleteOpts...); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return deleted, err
+			}
+			deleted = append(deleted, &matches[i])
+		}
 	}
+	return deleted, nil
 }
@@ -1,17 +1,37 @@
 package sawchain_test
 
 import (
+	"context"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	"github.com/guidewire-oss/sawchain"
 	"github.com/guidewire-oss/sawchain/internal/testutil"
 )
 
+// newFakeClientWithRESTMapper returns a standard fake client that can resolve resource scopes.
+func newFakeClientWithRESTMapper() client.Client {
+	scheme := testutil.NewStandardScheme()
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme)).
+		Build()
+}
+
+// withFinalizers sets the given finalizers on obj and returns it.
+func withFinalizers(obj client.Object, finalizers ...string) client.Object {
+	obj.SetFinalizers(finalizers)
+	return obj
+}
+
 var _ = Describe("Delete", func() {
 	type testCase struct {
 		objs                []client.Object
@@ -329,6 +349,42 @@ var _ = Describe("Delete", func() {
 			},
 		}),
 
+		Entry("should delete ConfigMap with propagation policy and grace period", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.PropagationForeground,
+				sawchain.GracePeriod(0),
+			},
+		}),
+
+		Entry("should delete multiple resources with propagation policy and template", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", map[string]string{"key1": "value1"}),
+				testutil.NewConfigMap("test-cm2", "default", map[string]string{"key2": "value2"}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				metav1.DeletePropagationOrphan,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm2
+				  namespace: default
+				`,
+			},
+		}),
+
 		// Error cases
 		Entry("should return delete error for object", testCase{
 			objs: []client.Object{
@@ -476,7 +532,64 @@ var _ = Describe("Delete", func() {
 				"client.Object and []client.Object arguments both provided",
 			},
 		}),
+
+		Entry("should fail with unsupported propagation policy", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.PropagationPolicy("Eventually"),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				`unsupported propagation policy: "Eventually"`,
+			},
+		}),
+
+		Entry("should fail with fractional grace period", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.GracePeriod(500 * time.Millisecond),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"provided grace period is not a whole number of seconds",
+			},
+		}),
+
+		Entry("should fail with finalizer removal window", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.RemoveFinalizersAfter(fastTimeout / 2),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unexpected argument type: options.RemoveFinalizersAfter",
+			},
+		}),
 	)
+
+	It("should pass delete options to the client", func() {
+		var deleteOpts client.DeleteOptions
+		c := fake.NewClientBuilder().
+			WithScheme(testutil.NewStandardScheme()).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Delete: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+					deleteOpts.ApplyOptions(opts)
+					return c.Delete(ctx, obj, opts...)
+				},
+			}).
+			Build()
+		Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", nil))).To(Succeed())
+
+		t := &MockT{TB: GinkgoTB()}
+		sc := sawchain.New(t, c, fastTimeout, fastInterval, sawchain.GracePeriod(5*time.Second))
+		Expect(sc.Delete(ctx, testutil.NewConfigMap("test-cm", "default", nil), sawchain.PropagationBackground)).To(Succeed())
+
+		Expect(deleteOpts.PropagationPolicy).To(HaveValue(Equal(metav1.DeletePropagationBackground)))
+		Expect(deleteOpts.GracePeriodSeconds).To(HaveValue(BeEquivalentTo(5)))
+	})
 })
 
 var _ = Describe("DeleteAndWait", func() {
@@ -484,8 +597,10 @@ var _ = Describe("DeleteAndWait", func() {
 		objs                []client.Object
 		client              client.Client
 		globalBindings      map[string]any
+		globalArgs          []any
 		methodArgs          []any
 		expectedFailureLogs []string
+		expectedInfoLogs    []string
 		expectedDuration    time.Duration
 	}
 	DescribeTable("deleting test resources and waiting",
@@ -497,7 +612,7 @@ var _ = Describe("DeleteAndWait", func() {
 
 			// Initialize Sawchain
 			t := &MockT{TB: GinkgoTB()}
-			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval, tc.globalBindings)
+			sc := sawchain.New(t, tc.client, append([]any{fastTimeout, fastInterval, tc.globalBindings}, tc.globalArgs...)...)
 
 			// Test DeleteAndWait
 			done := make(chan struct{})
@@ -522,6 +637,11 @@ var _ = Describe("DeleteAndWait", func() {
 				}
 			}
 
+			// Verify finalizer removal
+			for _, expectedLog := range tc.expectedInfoLogs {
+				Expect(t.InfoLogs).To(ContainElement(ContainSubstring(expectedLog)))
+			}
+
 			// Verify execution time
 			if tc.expectedDuration > 0 {
 				maxAllowedDuration := time.Duration(float64(tc.expectedDuration) * 1.2)
@@ -677,6 +797,69 @@ var _ = Describe("DeleteAndWait", func() {
 			expectedDuration: fastTimeout,
 		}),
 
+		Entry("should delete ConfigMap with propagation policy and grace period", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.PropagationForeground,
+				sawchain.GracePeriod(0),
+			},
+			expectedDuration: fastTimeout,
+		}),
+
+		Entry("should remove finalizers after window (single object)", testCase{
+			objs: []client.Object{
+				withFinalizers(testutil.NewConfigMap("test-cm", "default", nil), "example.com/finalizer"),
+			},
+			client:     &MockClient{Client: testutil.NewStandardFakeClient()},
+			globalArgs: []any{sawchain.VerbosityVerbose},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.RemoveFinalizersAfter(fastTimeout / 4),
+			},
+			expectedInfoLogs: []string{
+				"[SAWCHAIN][INFO] removed finalizers from resource pending deletion: ConfigMap (default/test-cm) [example.com/finalizer]",
+			},
+		}),
+
+		Entry("should remove finalizers after global window (single object)", testCase{
+			objs: []client.Object{
+				withFinalizers(testutil.NewConfigMap("test-cm", "default", nil), "example.com/finalizer"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			globalArgs: []any{
+				sawchain.VerbosityVerbose,
+				sawchain.RemoveFinalizersAfter(fastTimeout / 4),
+			},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			expectedInfoLogs: []string{
+				"[SAWCHAIN][INFO] removed finalizers from resource pending deletion: ConfigMap (default/test-cm) [example.com/finalizer]",
+			},
+		}),
+
+		Entry("should handle transient patch failures when removing finalizers", testCase{
+			objs: []client.Object{
+				withFinalizers(testutil.NewConfigMap("test-cm", "default", nil), "example.com/finalizer"),
+			},
+			client: &MockClient{
+				Client:          testutil.NewStandardFakeClient(),
+				patchFailFirstN: 2, // Fail the first 2 patch attempts
+			},
+			globalArgs: []any{sawchain.VerbosityVerbose},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.RemoveFinalizersAfter(fastTimeout / 4),
+			},
+			expectedInfoLogs: []string{
+				"[SAWCHAIN][INFO] removed finalizers from resource pending deletion: ConfigMap (default/test-cm) [example.com/finalizer]",
+			},
+		}),
+
 		// Success cases - multiple resources
 		Entry("should delete multiple resources with typed objects", testCase{
 			objs: []client.Object{
@@ -877,6 +1060,42 @@ var _ = Describe("DeleteAndWait", func() {
 			expectedDuration: fastTimeout,
 		}),
 
+		Entry("should remove finalizers after window (template)", testCase{
+			objs: []client.Object{
+				withFinalizers(testutil.NewConfigMap("test-cm1", "default", nil), "example.com/finalizer"),
+				testutil.NewConfigMap("test-cm2", "default", nil),
+				withFinalizers(testutil.NewTestResource("test-resource", "default"), "example.com/a", "example.com/b"),
+			},
+			client:     &MockClient{Client: testutil.NewStandardFakeClientWithTestResource()},
+			globalArgs: []any{sawchain.VerbosityVerbose},
+			methodArgs: []any{
+				sawchain.RemoveFinalizersAfter(fastTimeout / 4),
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm2
+				  namespace: default
+				---
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-resource
+				  namespace: default
+				`,
+			},
+			expectedInfoLogs: []string{
+				"[SAWCHAIN][INFO] removed finalizers from resource pending deletion: ConfigMap (default/test-cm1) [example.com/finalizer]",
+				"[SAWCHAIN][INFO] removed finalizers from resource pending deletion: TestResource (default/test-resource) [example.com/a example.com/b]",
+			},
+		}),
+
 		// Failure cases
 		Entry("should fail with no arguments", testCase{
 			client: &MockClient{Client: testutil.NewStandardFakeClient()},
@@ -1041,5 +1260,697 @@ var _ = Describe("DeleteAndWait", func() {
 			},
 			expectedDuration: fastTimeout,
 		}),
+
+		Entry("should fail with finalizer removal window not less than timeout", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.RemoveFinalizersAfter(fastTimeout),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"finalizer removal window must be less than timeout",
+			},
+		}),
+
+		Entry("should fail when stuck on finalizers without removal window", testCase{
+			objs: []client.Object{
+				withFinalizers(testutil.NewConfigMap("test-cm", "default", nil), "example.com/finalizer"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] delete not reflected within timeout (may be due to finalizers or client cache sync delay)",
+				"ConfigMap (default/test-cm): expected resource not to be found",
+			},
+		}),
+
+		Entry("should fail when patch fails indefinitely while removing finalizers", testCase{
+			objs: []client.Object{
+				withFinalizers(testutil.NewConfigMap("test-cm", "default", nil), "example.com/finalizer"),
+			},
+			client: &MockClient{
+				Client:          testutil.NewStandardFakeClient(),
+				patchFailFirstN: -1, // Fail all patch attempts
+			},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.RemoveFinalizersAfter(fastTimeout / 4),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] delete not reflected within timeout (may be due to finalizers or client cache sync delay)",
+				"ConfigMap (default/test-cm): failed to remove finalizers [example.com/finalizer]: simulated patch failure",
+			},
+		}),
+	)
+})
+
+var _ = Describe("DeleteAll", func() {
+	type testCase struct {
+		objs                []client.Object
+		client              client.Client
+		globalBindings      map[string]any
+		globalArgs          []any
+		methodArgs          []any
+		expectedReturnErrs  []string
+		expectedFailureLogs []string
+		expectedDeleted     []client.Object
+		expectedRemaining   []client.Object
+	}
+	DescribeTable("deleting all matching test resources",
+		func(tc testCase) {
+			// Create resources
+			for _, obj := range tc.objs {
+				Expect(tc.client.Create(ctx, obj)).To(Succeed(), "failed to create resource")
+			}
+
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, append([]any{fastTimeout, fastInterval, tc.globalBindings}, tc.globalArgs...)...)
+
+			// Test DeleteAll
+			var err error
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				err = sc.DeleteAll(ctx, tc.methodArgs...)
+			}()
+			<-done
+
+			if len(tc.expectedReturnErrs) > 0 {
+				Expect(err).To(HaveOccurred(), "expected error")
+				for _, expectedErr := range tc.expectedReturnErrs {
+					Expect(err.Error()).To(ContainSubstring(expectedErr))
+				}
+			} else if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(err).NotTo(HaveOccurred(), "expected no error")
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+
+			// Verify resources
+			for _, obj := range tc.expectedDeleted {
+				key := client.ObjectKeyFromObject(obj)
+				Expect(tc.client.Get(ctx, key, copy(obj))).NotTo(Succeed(), "expected resource to be deleted: %s", key)
+			}
+			for _, obj := range tc.expectedRemaining {
+				key := client.ObjectKeyFromObject(obj)
+				Expect(tc.client.Get(ctx, key, copy(obj))).To(Succeed(), "expected resource to remain: %s", key)
+			}
+		},
+
+		// Success cases
+		Entry("should delete ConfigMaps with matching labels in namespace", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMapWithLabels("test-cm1", "default", map[string]string{"app": "test"}, nil),
+				testutil.NewConfigMapWithLabels("test-cm2", "default", map[string]string{"app": "test"}, nil),
+				testutil.NewConfigMapWithLabels("test-cm3", "default", map[string]string{"app": "other"}, nil),
+				testutil.NewConfigMapWithLabels("test-cm4", "other", map[string]string{"app": "test"}, nil),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				  labels:
+				    app: test
+				`,
+			},
+			expectedDeleted: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", nil),
+				testutil.NewConfigMap("test-cm2", "default", nil),
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm3", "default", nil),
+				testutil.NewConfigMap("test-cm4", "other", nil),
+			},
+		}),
+
+		Entry("should delete partial template matches with strict instance", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMapWithLabels("test-cm1", "default", map[string]string{"app": "test"}, map[string]string{"key": "value"}),
+				testutil.NewConfigMapWithLabels("test-cm2", "default", map[string]string{"app": "other"}, nil),
+			},
+			client:     &MockClient{Client: testutil.NewStandardFakeClient()},
+			globalArgs: []any{sawchain.Strict},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				  labels:
+				    app: test
+				`,
+			},
+			expectedDeleted: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", nil),
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm2", "default", nil),
+			},
+		}),
+
+		Entry("should delete ConfigMaps matching data fields and expressions with bindings", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("tmp-cm1", "default", map[string]string{"env": "test"}),
+				testutil.NewConfigMap("tmp-cm2", "default", map[string]string{"env": "prod"}),
+				testutil.NewConfigMap("keep-cm", "default", map[string]string{"env": "test"}),
+			},
+			client:         &MockClient{Client: testutil.NewStandardFakeClient()},
+			globalBindings: map[string]any{"namespace": "default"},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: ($namespace)
+				  (starts_with(name, 'tmp-')): true
+				data:
+				  env: ($env)
+				`,
+				map[string]any{"env": "test"},
+			},
+			expectedDeleted: []client.Object{
+				testutil.NewConfigMap("tmp-cm1", "default", nil),
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("tmp-cm2", "default", nil),
+				testutil.NewConfigMap("keep-cm", "default", nil),
+			},
+		}),
+
+		Entry("should delete matches of multiple documents", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMapWithLabels("test-cm", "default", map[string]string{"app": "test"}, nil),
+				testutil.NewTestResource("test-resource1", "default"),
+				testutil.NewTestResource("test-resource2", "default"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResource()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  labels:
+				    app: test
+				---
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-resource2
+				  namespace: default
+				`,
+			},
+			expectedDeleted: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				testutil.NewTestResource("test-resource2", "default"),
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewTestResource("test-resource1", "default"),
+			},
+		}),
+
+		Entry("should delete matches with propagation policy and grace period", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMapWithLabels("test-cm", "default", map[string]string{"app": "test"}, nil),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				sawchain.PropagationBackground,
+				sawchain.GracePeriod(0),
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  labels:
+				    app: test
+				`,
+			},
+			expectedDeleted: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+		}),
+
+		Entry("should succeed when nothing matches", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"env": "prod"}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				data:
+				  env: test
+				`,
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+		}),
+
+		Entry("should succeed when named resource does not exist", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: missing-cm
+				  namespace: default
+				`,
+			},
+		}),
+
+		// Error cases
+		Entry("should return list error", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			client: &MockClient{
+				Client:         testutil.NewStandardFakeClient(),
+				listFailFirstN: 1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				`,
+			},
+			expectedReturnErrs: []string{"simulated list failure"},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+		}),
+
+		Entry("should return delete error", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			client: &MockClient{
+				Client:           testutil.NewStandardFakeClient(),
+				deleteFailFirstN: 1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				`,
+			},
+			expectedReturnErrs: []string{"simulated delete failure"},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+		}),
+
+		Entry("should delete unscoped matches of cluster-scoped type", testCase{
+			objs: []client.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-ns1",
+					Annotations: map[string]string{"purpose": "test"},
+				}},
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns2"}},
+			},
+			client: &MockClient{Client: newFakeClientWithRESTMapper()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: Namespace
+				metadata:
+				  annotations:
+				    purpose: test
+				`,
+			},
+			expectedDeleted: []client.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns1"}},
+			},
+			expectedRemaining: []client.Object{
+				&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-ns2"}},
+			},
+		}),
+
+		// Failure cases
+		Entry("should fail with unscoped template of namespaced type", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", map[string]string{"env": "test"}),
+				testutil.NewConfigMap("test-cm2", "other", map[string]string{"env": "test"}),
+			},
+			client: &MockClient{Client: newFakeClientWithRESTMapper()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  env: test
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] template documents for namespaced types must set metadata.name, metadata.namespace, or metadata.labels",
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", nil),
+				testutil.NewConfigMap("test-cm2", "other", nil),
+			},
+		}),
+
+		Entry("should fail with unscoped document after scoped document", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", nil),
+				testutil.NewConfigMap("test-cm2", "other", nil),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] template documents for namespaced types must set metadata.name, metadata.namespace, or metadata.labels",
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", nil),
+				testutil.NewConfigMap("test-cm2", "other", nil),
+			},
+		}),
+
+		Entry("should fail with no template", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string)",
+			},
+		}),
+
+		Entry("should fail with object argument", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unexpected argument type: *v1.ConfigMap",
+			},
+		}),
+
+		Entry("should fail with missing binding", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: ($missing)
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid template",
+				"variable not defined: $missing",
+			},
+		}),
+	)
+})
+
+var _ = Describe("DeleteAllAndWait", func() {
+	type testCase struct {
+		objs                []client.Object
+		client              client.Client
+		globalArgs          []any
+		methodArgs          []any
+		expectedFailureLogs []string
+		expectedDeleted     []client.Object
+		expectedRemaining   []client.Object
+		expectedInfoLogs    []string
+		expectedDuration    time.Duration
+	}
+	DescribeTable("deleting all matching test resources and waiting",
+		func(tc testCase) {
+			// Create resources
+			for _, obj := range tc.objs {
+				Expect(tc.client.Create(ctx, obj)).To(Succeed(), "failed to create resource")
+			}
+
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, append([]any{fastTimeout, fastInterval}, tc.globalArgs...)...)
+
+			// Test DeleteAllAndWait
+			done := make(chan struct{})
+			start := time.Now()
+			go func() {
+				defer close(done)
+				sc.DeleteAllAndWait(ctx, tc.methodArgs...)
+			}()
+			<-done
+			executionTime := time.Since(start)
+
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+
+			// Verify resources
+			for _, obj := range tc.expectedDeleted {
+				key := client.ObjectKeyFromObject(obj)
+				Expect(tc.client.Get(ctx, key, copy(obj))).NotTo(Succeed(), "expected resource to be deleted: %s", key)
+			}
+			for _, obj := range tc.expectedRemaining {
+				key := client.ObjectKeyFromObject(obj)
+				Expect(tc.client.Get(ctx, key, copy(obj))).To(Succeed(), "expected resource to remain: %s", key)
+			}
+
+			// Verify finalizer removal
+			for _, expectedLog := range tc.expectedInfoLogs {
+				Expect(t.InfoLogs).To(ContainElement(ContainSubstring(expectedLog)))
+			}
+
+			// Verify execution time
+			if tc.expectedDuration > 0 {
+				maxAllowedDuration := time.Duration(float64(tc.expectedDuration) * 1.2)
+				Expect(executionTime).To(BeNumerically("<", maxAllowedDuration),
+					"expected execution time %v to be less than %v",
+					executionTime, maxAllowedDuration)
+			}
+		},
+
+		// Success cases
+		Entry("should delete ConfigMaps with matching labels", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMapWithLabels("test-cm1", "default", map[string]string{"app": "test"}, nil),
+				testutil.NewConfigMapWithLabels("test-cm2", "default", map[string]string{"app": "test"}, nil),
+				testutil.NewConfigMapWithLabels("test-cm3", "default", map[string]string{"app": "other"}, nil),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  labels:
+				    app: test
+				`,
+			},
+			expectedDeleted: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", nil),
+				testutil.NewConfigMap("test-cm2", "default", nil),
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm3", "default", nil),
+			},
+			expectedDuration: fastTimeout,
+		}),
+
+		Entry("should delete partial template matches with strict instance", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMapWithLabels("test-cm1", "default", map[string]string{"app": "test"}, map[string]string{"key": "value"}),
+				testutil.NewConfigMapWithLabels("test-cm2", "default", map[string]string{"app": "other"}, nil),
+			},
+			client:     &MockClient{Client: testutil.NewStandardFakeClient()},
+			globalArgs: []any{sawchain.Strict},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				  labels:
+				    app: test
+				`,
+			},
+			expectedDeleted: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", nil),
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm2", "default", nil),
+			},
+		}),
+
+		Entry("should succeed when nothing matches", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				`,
+			},
+			expectedDuration: fastTimeout,
+		}),
+
+		Entry("should remove finalizers after window", testCase{
+			objs: []client.Object{
+				withFinalizers(testutil.NewConfigMapWithLabels("test-cm1", "default", map[string]string{"app": "test"}, nil),
+					"example.com/finalizer"),
+				testutil.NewConfigMapWithLabels("test-cm2", "default", map[string]string{"app": "test"}, nil),
+			},
+			client:     &MockClient{Client: testutil.NewStandardFakeClient()},
+			globalArgs: []any{sawchain.VerbosityVerbose},
+			methodArgs: []any{
+				sawchain.RemoveFinalizersAfter(fastTimeout / 4),
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  labels:
+				    app: test
+				`,
+			},
+			expectedDeleted: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", nil),
+				testutil.NewConfigMap("test-cm2", "default", nil),
+			},
+			expectedInfoLogs: []string{
+				"[SAWCHAIN][INFO] removed finalizers from resource pending deletion: ConfigMap (default/test-cm1) [example.com/finalizer]",
+			},
+		}),
+
+		// Failure cases
+		Entry("should fail when stuck on finalizers without removal window", testCase{
+			objs: []client.Object{
+				withFinalizers(testutil.NewConfigMapWithLabels("test-cm", "default", map[string]string{"app": "test"}, nil),
+					"example.com/finalizer"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  labels:
+				    app: test
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] delete not reflected within timeout (may be due to finalizers or client cache sync delay)",
+				"ConfigMap (default/test-cm): stuck on finalizers [example.com/finalizer]",
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+		}),
+
+		Entry("should fail with unscoped template of namespaced type", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] template documents for namespaced types must set metadata.name, metadata.namespace, or metadata.labels",
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+		}),
+
+		Entry("should fail when delete fails", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			client: &MockClient{
+				Client:           testutil.NewStandardFakeClient(),
+				deleteFailFirstN: 1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to delete all matches with template",
+				"simulated delete failure",
+			},
+			expectedRemaining: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			expectedDuration: fastTimeout,
+		}),
+
+		Entry("should fail when list fails", testCase{
+			objs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			client: &MockClient{
+				Client:         testutil.NewStandardFakeClient(),
+				listFailFirstN: 1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to delete all matches with template",
+				"simulated list failure",
+			},
+			expectedDuration: fastTimeout,
+		}),
+
+		Entry("should fail with object argument", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unexpected argument type: *v1.ConfigMap",
+			},
+		}),
 	)
 })
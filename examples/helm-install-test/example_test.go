@@ -20,8 +20,9 @@ var _ = Describe("nginx chart", Ordered, func() {
 		releaseName = "test"
 		chartPath   = filepath.Join("charts", "nginx")
 
-		ctx context.Context
-		sc  *sawchain.Sawchain
+		ctx       context.Context
+		sc        *sawchain.Sawchain
+		namespace string
 	)
 
 	BeforeAll(func() {
@@ -29,16 +30,19 @@ var _ = Describe("nginx chart", Ordered, func() {
 		ctx = context.Background()
 
 		// Initialize Sawchain
-		sc = sawchain.New(GinkgoTB(), k8sClient, map[string]any{
-			"namespace":   "default",
+		// Note: The timeout allows the namespace to terminate on cleanup
+		sc = sawchain.New(GinkgoTB(), k8sClient, "60s", "1s", map[string]any{
 			"releaseName": releaseName,
 		})
+
+		// Create isolated namespace and bind it as $namespace
+		sc, namespace = sc.CreateNamespace(ctx, "helm-install", GinkgoParallelProcess())
 	})
 
 	When("installed with defaults", func() {
 		BeforeAll(func() {
 			// Install chart with defaults
-			err := runHelmUpgradeInstall(releaseName, chartPath)
+			err := runHelmUpgradeInstall(namespace, releaseName, chartPath)
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -119,7 +123,7 @@ var _ = Describe("nginx chart", Ordered, func() {
 	When("upgraded with overrides", func() {
 		BeforeAll(func() {
 			// Upgrade chart with overrides
-			err := runHelmUpgradeInstall(releaseName, chartPath, "replicaCount=3", "image.tag=latest")
+			err := runHelmUpgradeInstall(namespace, releaseName, chartPath, "replicaCount=3", "image.tag=latest")
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -152,7 +156,7 @@ var _ = Describe("nginx chart", Ordered, func() {
 	When("upgraded with 'ingress' enabled", func() {
 		BeforeAll(func() {
 			// Upgrade chart with 'ingress' enabled
-			err := runHelmUpgradeInstall(releaseName, chartPath, "ingress.enabled=true")
+			err := runHelmUpgradeInstall(namespace, releaseName, chartPath, "ingress.enabled=true")
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -186,7 +190,7 @@ var _ = Describe("nginx chart", Ordered, func() {
 	When("upgraded with 'autoscaling' enabled", func() {
 		BeforeAll(func() {
 			// Upgrade chart with 'autoscaling' enabled
-			err := runHelmUpgradeInstall(releaseName, chartPath, "autoscaling.enabled=true")
+			err := runHelmUpgradeInstall(namespace, releaseName, chartPath, "autoscaling.enabled=true")
 			Expect(err).NotTo(HaveOccurred())
 		})
 
@@ -221,16 +225,16 @@ var _ = Describe("nginx chart", Ordered, func() {
 
 	AfterAll(func() {
 		// Uninstall chart
-		Expect(runHelmUninstall(releaseName)).To(Succeed())
+		Expect(runHelmUninstall(namespace, releaseName)).To(Succeed())
 	})
 })
 
 // HELPERS
 
-// runHelmUpgradeInstall runs `helm upgrade --install --reuse-values` with the given release name,
-// chart path, and values. It uses the default namespace and returns an error if the command fails.
-func runHelmUpgradeInstall(releaseName, chartPath string, values ...string) error {
-	args := []string{"upgrade", "--install", "--reuse-values", releaseName, chartPath}
+// runHelmUpgradeInstall runs `helm upgrade --install --reuse-values` with the given namespace,
+// release name, chart path, and values. It returns an error if the command fails.
+func runHelmUpgradeInstall(namespace, releaseName, chartPath string, values ...string) error {
+	args := []string{"upgrade", "--install", "--reuse-values", "--namespace", namespace, releaseName, chartPath}
 	for _, value := range values {
 		args = append(args, "--set", value)
 	}
@@ -249,11 +253,11 @@ func runHelmUpgradeInstall(releaseName, chartPath string, values ...string) erro
 	return nil
 }
 
-// runHelmUninstall runs `helm uninstall` with the given release name.
-// It uses the default namespace and returns an error if the command fails.
-func runHelmUninstall(releaseName string) error {
+// runHelmUninstall runs `helm uninstall` with the given namespace and release name.
+// It returns an error if the command fails.
+func runHelmUninstall(namespace, releaseName string) error {
 	var stderr bytes.Buffer
-	cmd := exec.Command("helm", "uninstall", releaseName)
+	cmd := exec.Command("helm", "uninstall", "--namespace", namespace, releaseName)
 	cmd.Stderr = &stderr
 
 	if err := cmd.Run(); err != nil {
@@ -83,7 +83,7 @@ func (s *Sawchain) FetchSingle(ctx context.Context, args ...any) client.Object {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, true, false, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Template: true}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
@@ -94,7 +94,7 @@ func (s *Sawchain) FetchSingle(ctx context.Context, args ...any) client.Object {
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObj, err := chainsaw.RenderTemplateSingle(ctx, opts.Template, bindings)
+		unstructuredObj, err := chainsaw.RenderTemplateSingle(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		// Get resource
@@ -200,7 +200,7 @@ func (s *Sawchain) FetchMultiple(ctx context.Context, args ...any) []client.Obje
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, false, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Objects: true, Template: true}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
@@ -211,7 +211,7 @@ func (s *Sawchain) FetchMultiple(ctx context.Context, args ...any) []client.Obje
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings)
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		// Validate objects length
@@ -259,7 +259,7 @@ func (s *Sawchain) FetchSingleFunc(ctx context.Context, args ...any) func() clie
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, true, false, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Template: true}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
@@ -270,7 +270,7 @@ func (s *Sawchain) FetchSingleFunc(ctx context.Context, args ...any) func() clie
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObj, err := chainsaw.RenderTemplateSingle(ctx, opts.Template, bindings)
+		unstructuredObj, err := chainsaw.RenderTemplateSingle(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		return func() client.Object {
@@ -309,7 +309,7 @@ func (s *Sawchain) FetchMultipleFunc(ctx context.Context, args ...any) func() []
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, false, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Objects: true, Template: true}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
@@ -320,7 +320,7 @@ func (s *Sawchain) FetchMultipleFunc(ctx context.Context, args ...any) func() []
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings)
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		// Validate objects length
@@ -49,7 +49,9 @@ import (
 //   - Templates will be sanitized before use, including de-indenting (removing any common leading
 //     whitespace prefix from non-empty lines) and pruning empty documents.
 //
-//   - Use GetFunc if you need to create a Get function for polling.
+//   - Use GetFunc if you need to create a Get function for polling, GetAndWait if you need to wait for
+//     resources with Sawchain's durations, or GetConsistently if you need to ensure resources keep
+//     existing over time.
 //
 // # Examples
 //
@@ -123,7 +125,7 @@ func (s *Sawchain) Get(ctx context.Context, args ...any) error {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, true, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
@@ -134,7 +136,7 @@ func (s *Sawchain) Get(ctx context.Context, args ...any) error {
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings)
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		// Validate objects length
@@ -188,10 +190,168 @@ func (s *Sawchain) GetFunc(ctx context.Context, args ...any) func() error {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, true, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
+	return s.getFunc(ctx, opts)
+}
+
+// GetAndWait retrieves resources with objects, a manifest, or a Chainsaw template until all client Get
+// operations succeed within a configurable duration. This is equivalent to polling GetFunc with Eventually
+// using Sawchain's durations.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. If provided without a template, resource state will be read from the object for
+//     identification and written back to the object. If provided with a template, resource state
+//     will be read from the template for identification and written to the object.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
+//     multiple resources. If provided without a template, resource states will be read from the objects
+//     for identification and written back to the objects. If provided with a template, resource states
+//     will be read from the template for identification and written to the objects.
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing resource
+//     identifiers to be read for retrieval. If provided with an object, must contain exactly one resource
+//     identifier matching the type of the object. If provided with a slice of objects, must contain resource
+//     identifiers exactly matching the count, order, and types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Timeout (string or time.Duration): Duration within which client Get operations should succeed. If
+//     provided, must be before interval. Defaults to Sawchain's global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for retrieving the resources. If provided, must
+//     be after timeout. Defaults to Sawchain's global interval value.
+//
+// A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
+// may not be provided together.
+//
+// # Notes
+//
+//   - Invalid input and timeout errors will result in immediate test failure.
+//
+//   - Resources are retrieved the same way as in Get. On timeout, the failure message includes the
+//     client error from the last attempt.
+//
+// # Examples
+//
+// Wait for a resource to exist and save its state to the object:
+//
+//	sc.GetAndWait(ctx, obj)
+//
+// Wait up to a minute for resources defined in a template to exist:
+//
+//	sc.GetAndWait(ctx, "1m", "2s", `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  `, map[string]any{"name": "test-cm", "namespace": "default"})
+func (s *Sawchain) GetAndWait(ctx context.Context, args ...any) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Wait for Get operations to succeed
+	s.g.Eventually(s.getFunc(ctx, opts), opts.Timeout, opts.Interval).Should(gomega.Succeed(), errGetNotSatisfied)
+}
+
+// GetConsistently retrieves resources with objects, a manifest, or a Chainsaw template repeatedly over a
+// configurable duration, and fails the test as soon as any client Get operation fails. This ensures
+// resources keep existing, e.g. that a controller does not delete them.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. If provided without a template, resource state will be read from the object for
+//     identification and written back to the object. If provided with a template, resource state
+//     will be read from the template for identification and written to the object.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
+//     multiple resources. If provided without a template, resource states will be read from the objects
+//     for identification and written back to the objects. If provided with a template, resource states
+//     will be read from the template for identification and written to the objects.
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing resource
+//     identifiers to be read for retrieval. If provided with an object, must contain exactly one resource
+//     identifier matching the type of the object. If provided with a slice of objects, must contain resource
+//     identifiers exactly matching the count, order, and types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Timeout (string or time.Duration): Duration for which client Get operations must keep succeeding.
+//     If provided, must be before interval. Defaults to Sawchain's global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval between Get operations. If provided, must be
+//     after timeout. Defaults to Sawchain's global interval value.
+//
+// A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
+// may not be provided together.
+//
+// # Notes
+//
+//   - Invalid input and client errors will result in immediate test failure.
+//
+//   - Resources are retrieved the same way as in Get, starting immediately and repeating until the
+//     timeout has elapsed. Objects hold the states retrieved by the last Get operations.
+//
+//   - The failure message reports the elapsed time at which a Get operation first failed, together
+//     with the client error.
+//
+// # Examples
+//
+// Ensure a resource keeps existing for Sawchain's global timeout:
+//
+//	sc.GetConsistently(ctx, obj)
+//
+// Ensure resources defined in a template keep existing for 10 seconds, checking every second:
+//
+//	sc.GetConsistently(ctx, "10s", "1s", `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  `, map[string]any{"name": "test-cm", "namespace": "default"})
+func (s *Sawchain) GetConsistently(ctx context.Context, args ...any) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Hold Get operations
+	s.holdConsistently(ctx, s.getFunc(ctx, opts), opts, errGetNotConsistent)
+}
+
+// HELPERS
+
+// getFunc validates opts and returns a function executing the Get operations they describe.
+func (s *Sawchain) getFunc(ctx context.Context, opts *options.Options) func() error {
+	s.t.Helper()
+
 	// Check required options
 	s.g.Expect(options.RequireTemplateObjectObjects(opts)).To(gomega.Succeed(), errInvalidArgs)
 
@@ -199,7 +359,7 @@ func (s *Sawchain) GetFunc(ctx context.Context, args ...any) func() error {
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings)
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		// Validate objects length
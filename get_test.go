@@ -1,8 +1,11 @@
 package sawchain_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -518,3 +521,208 @@ var _ = Describe("Get and GetFunc", func() {
 		}),
 	)
 })
+
+var _ = Describe("GetConsistently", func() {
+	type testCase struct {
+		client              client.Client
+		disturb             func(c client.Client)
+		methodArgs          func() []any
+		expectedFailureLogs []string
+	}
+
+	newConfigMap := func() *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{}
+		cm.SetName("test-cm")
+		cm.SetNamespace("default")
+		return cm
+	}
+
+	DescribeTable("holding Get operations over time",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval)
+
+			// Create resource
+			Expect(tc.client.Create(ctx, newConfigMap())).To(Succeed())
+
+			// Disturb resource while holding
+			if tc.disturb != nil {
+				go func() {
+					defer GinkgoRecover()
+					time.Sleep(fastTimeout / 4)
+					tc.disturb(tc.client)
+				}()
+			}
+
+			// Test GetConsistently
+			done := make(chan struct{})
+			start := time.Now()
+			go func() {
+				defer close(done)
+				sc.GetConsistently(ctx, tc.methodArgs()...)
+			}()
+			<-done
+			executionTime := time.Since(start)
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+				Expect(executionTime).To(BeNumerically("<", fastTimeout), "expected failure before timeout")
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+				Expect(executionTime).To(BeNumerically(">=", fastTimeout), "expected Get operations to hold for timeout")
+			}
+		},
+
+		// Success cases
+		Entry("should hold an existing resource with object", testCase{
+			client:     testutil.NewStandardFakeClient(),
+			methodArgs: func() []any { return []any{newConfigMap()} },
+		}),
+
+		Entry("should hold an existing resource with template and custom durations", testCase{
+			client: testutil.NewStandardFakeClient(),
+			methodArgs: func() []any {
+				return []any{fastTimeout, fastInterval, `
+					apiVersion: v1
+					kind: ConfigMap
+					metadata:
+					  name: test-cm
+					  namespace: default
+				`}
+			},
+		}),
+
+		// Failure cases
+		Entry("should fail when resource is deleted", testCase{
+			client: testutil.NewStandardFakeClient(),
+			disturb: func(c client.Client) {
+				Expect(c.Delete(ctx, newConfigMap())).To(Succeed())
+			},
+			methodArgs: func() []any { return []any{newConfigMap()} },
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] get did not hold consistently: invariant broke after",
+				"not found",
+			},
+		}),
+
+		Entry("should fail immediately when get fails", testCase{
+			client: &MockClient{
+				Client:        testutil.NewStandardFakeClient(),
+				getFailFirstN: -1,
+			},
+			methodArgs: func() []any { return []any{newConfigMap()} },
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] get did not hold consistently: invariant broke after",
+				"simulated get failure",
+			},
+		}),
+
+		Entry("should fail with no arguments", testCase{
+			client:     testutil.NewStandardFakeClient(),
+			methodArgs: func() []any { return nil },
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string), Object (client.Object), or Objects ([]client.Object)",
+			},
+		}),
+	)
+})
+
+var _ = Describe("GetAndWait", func() {
+	type testCase struct {
+		client              client.Client
+		delayed             bool
+		methodArgs          []any
+		expectedFailureLogs []string
+	}
+
+	DescribeTable("waiting for resources to exist",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval)
+
+			// Create resource while waiting
+			if tc.delayed {
+				go func() {
+					defer GinkgoRecover()
+					time.Sleep(fastTimeout / 4)
+					Expect(tc.client.Create(ctx, testutil.NewConfigMap("test-cm", "default", nil))).To(Succeed())
+				}()
+			}
+
+			// Test GetAndWait
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				sc.GetAndWait(ctx, tc.methodArgs...)
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+				return
+			}
+			Expect(t.Failed()).To(BeFalse(), "expected no failure")
+
+			// Verify saved state
+			for _, arg := range tc.methodArgs {
+				if obj, ok := arg.(client.Object); ok {
+					Expect(obj.GetResourceVersion()).NotTo(BeEmpty(), "resource state not saved to provided object")
+				}
+			}
+		},
+
+		// Success cases
+		Entry("should succeed when resource appears while waiting", testCase{
+			client:     testutil.NewStandardFakeClient(),
+			delayed:    true,
+			methodArgs: []any{testutil.NewConfigMap("test-cm", "default", nil)},
+		}),
+
+		Entry("should succeed with template after transient get failures", testCase{
+			client: &MockClient{
+				Client:        testutil.NewStandardFakeClient(),
+				getFailFirstN: 2,
+			},
+			delayed: true,
+			methodArgs: []any{
+				&corev1.ConfigMap{},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				`,
+			},
+		}),
+
+		// Failure cases
+		Entry("should fail with last client error when resource does not exist within timeout", testCase{
+			client:     testutil.NewStandardFakeClient(),
+			methodArgs: []any{testutil.NewConfigMap("test-cm", "default", nil)},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] get not satisfied within timeout",
+				"not found",
+			},
+		}),
+
+		Entry("should fail with no arguments", testCase{
+			client: testutil.NewStandardFakeClient(),
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string), Object (client.Object), or Objects ([]client.Object)",
+			},
+		}),
+	)
+})
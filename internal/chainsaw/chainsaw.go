@@ -5,24 +5,37 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	"github.com/kyverno/chainsaw/pkg/apis"
 	"github.com/kyverno/chainsaw/pkg/apis/v1alpha1"
 	"github.com/kyverno/chainsaw/pkg/engine/bindings"
 	"github.com/kyverno/chainsaw/pkg/engine/checks"
 	"github.com/kyverno/chainsaw/pkg/engine/templating"
 	"github.com/kyverno/chainsaw/pkg/loaders/resource"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/guidewire-oss/sawchain/internal/options"
+	"github.com/guidewire-oss/sawchain/internal/util"
 )
 
 type Bindings = apis.Bindings
 
 const errExpectedSingleResource = "expected template to contain a single resource; found %d"
 
-var compilers = apis.DefaultCompilers
+// jsonPatchOps are the operations defined by RFC 6902.
+var jsonPatchOps = []string{"add", "remove", "replace", "move", "copy", "test"}
 
 // normalizeBindingValue converts a binding value to a form compatible with K8s unstructured
 // objects by performing a JSON round-trip. This converts typed maps (e.g., map[string]string)
@@ -90,27 +103,39 @@ func parseTemplate(templateContent string) ([]unstructured.Unstructured, error)
 
 // RenderTemplate renders the template into unstructured objects (and processes template expressions).
 // Bindings are injected as is without type conversions, even when the template wraps them in quotes.
+// Expressions may call the custom functions in funcs (nil for built-in functions only). Errors name
+// the template document that failed to render.
 func RenderTemplate(
 	ctx context.Context,
 	templateContent string,
 	bindings Bindings,
+	funcs *Functions,
 ) ([]unstructured.Unstructured, error) {
 	parsed, err := parseTemplate(templateContent)
 	if err != nil {
 		return nil, err
 	}
 	var rendered []unstructured.Unstructured
-	for _, obj := range parsed {
+	for i, obj := range parsed {
 		template := v1alpha1.NewProjection(obj.UnstructuredContent())
-		obj, err := templating.TemplateAndMerge(ctx, compilers, obj, bindings, template)
+		merged, err := templating.TemplateAndMerge(ctx, funcs.compilers(), obj, bindings, template)
 		if err != nil {
-			return nil, fmt.Errorf("failed to render template: %w", err)
+			return nil, fmt.Errorf("failed to render template document %s: %w", documentID(obj, i, len(parsed)), err)
 		}
-		rendered = append(rendered, obj)
+		rendered = append(rendered, merged)
 	}
 	return rendered, nil
 }
 
+// documentID identifies a template document in errors by its position (if the template has
+// several documents) and resource identifier, e.g. "2 of 3 (v1/ConfigMap/default/test)".
+func documentID(obj unstructured.Unstructured, index, count int) string {
+	if count > 1 {
+		return fmt.Sprintf("%d of %d (%s)", index+1, count, ResourceID(obj))
+	}
+	return fmt.Sprintf("(%s)", ResourceID(obj))
+}
+
 // RenderTemplateSingle renders the single-resource template into an unstructured object
 // (and processes template expressions). Bindings are injected as is without
 // type conversions, even when the template wraps them in quotes.
@@ -118,8 +143,9 @@ func RenderTemplateSingle(
 	ctx context.Context,
 	templateContent string,
 	bindings Bindings,
+	funcs *Functions,
 ) (unstructured.Unstructured, error) {
-	rendered, err := RenderTemplate(ctx, templateContent, bindings)
+	rendered, err := RenderTemplate(ctx, templateContent, bindings, funcs)
 	if err != nil {
 		return unstructured.Unstructured{}, err
 	}
@@ -129,30 +155,132 @@ func RenderTemplateSingle(
 	return rendered[0], nil
 }
 
+// RenderJSONPatch renders the template into an RFC 6902 JSON Patch document (and processes
+// template expressions). The template must contain a single list of patch operations.
+// Bindings are injected as is without type conversions, even when the template wraps them in quotes.
+func RenderJSONPatch(
+	ctx context.Context,
+	templateContent string,
+	bindings Bindings,
+	funcs *Functions,
+) ([]byte, error) {
+	var operations []any
+	if err := yaml.Unmarshal([]byte(templateContent), &operations); err != nil {
+		msg := "failed to parse JSON patch"
+		tip := "ensure the template is a list of patch operations (e.g. '- op: replace')"
+		return nil, fmt.Errorf("%s; %s: %w", msg, tip, err)
+	}
+	// Wrap operations in an object to reuse resource templating
+	obj := unstructured.Unstructured{Object: map[string]any{"operations": operations}}
+	template := v1alpha1.NewProjection(obj.UnstructuredContent())
+	obj, err := templating.TemplateAndMerge(ctx, funcs.compilers(), obj, bindings, template)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render template: %w", err)
+	}
+	rendered, _ := obj.Object["operations"].([]any)
+	for i, operation := range rendered {
+		op, _ := operation.(map[string]any)
+		kind, _ := op["op"].(string)
+		_, hasPath := op["path"].(string)
+		if !slices.Contains(jsonPatchOps, kind) || !hasPath {
+			return nil, fmt.Errorf("invalid JSON patch: operation %d must have a valid op (%v) and a path", i, jsonPatchOps)
+		}
+	}
+	patch, err := json.Marshal(obj.Object["operations"])
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal JSON patch: %w", err)
+	}
+	if _, err := jsonpatch.DecodePatch(patch); err != nil {
+		return nil, fmt.Errorf("invalid JSON patch: %w", err)
+	}
+	return patch, nil
+}
+
+// MatchOptions configures how candidates are compared with expectations. A nil *MatchOptions
+// uses the defaults: non-strict matching of resources as stored.
+type MatchOptions struct {
+	// Strict enables strict matching if non-nil.
+	Strict *Strictness
+	// DecodeSecrets compares the data of Secrets in decoded form, like stringData, and marks
+	// attempts against Secrets for redaction in failure output.
+	DecodeSecrets bool
+}
+
+// strict returns the strict matching configuration, or nil if strict matching is disabled.
+func (o *MatchOptions) strict() *Strictness {
+	if o == nil {
+		return nil
+	}
+	return o.Strict
+}
+
+// decodeSecrets reports whether Secret data is compared in decoded form.
+func (o *MatchOptions) decodeSecrets() bool {
+	return o != nil && o.DecodeSecrets
+}
+
+// checkCandidate compares the candidate with the expectation and returns the resulting field
+// errors, along with the candidate as compared (i.e. with Secret data decoded if enabled). If
+// strict matching is enabled, a candidate passing the Chainsaw check is also checked for fields
+// absent in the expectation.
+func checkCandidate(
+	ctx context.Context,
+	candidate unstructured.Unstructured,
+	expected unstructured.Unstructured,
+	bindings Bindings,
+	funcs *Functions,
+	matchOpts *MatchOptions,
+) (field.ErrorList, unstructured.Unstructured, error) {
+	if matchOpts.decodeSecrets() {
+		decoded, err := util.DecodeSecretData(candidate)
+		if err != nil {
+			return nil, candidate, fmt.Errorf("failed to decode Secret data: %w", err)
+		}
+		candidate = decoded
+	}
+	fieldErrs, err := checks.Check(ctx, funcs.compilers(), candidate.UnstructuredContent(), bindings,
+		ptr.To(v1alpha1.NewCheck(expected.UnstructuredContent())))
+	if err != nil {
+		return nil, candidate, fmt.Errorf("failed to check candidate %s against template document %s: %w",
+			ResourceID(candidate), documentID(expected, 0, 1), err)
+	}
+	strict := matchOpts.strict()
+	if len(fieldErrs) > 0 || strict == nil {
+		return fieldErrs, candidate, nil
+	}
+	fieldErrs, err = UnexpectedFields(candidate.UnstructuredContent(), expected.UnstructuredContent(), strict.IgnorePaths)
+	return fieldErrs, candidate, err
+}
+
 // Match compares candidates with the expectation and returns the first match, or a
-// *MatchError if no match is found. Does not handle non-resource matching.
+// *MatchError if no match is found. Does not handle non-resource matching. Expressions may call
+// the custom functions in funcs (nil for built-in functions only). See MatchOptions for the
+// supported matching options.
 // Based on github.com/kyverno/chainsaw/pkg/engine/operations/assert.Exec.
 func Match(
 	ctx context.Context,
 	candidates []unstructured.Unstructured,
 	expected unstructured.Unstructured,
 	bindings Bindings,
+	funcs *Functions,
+	matchOpts *MatchOptions,
 ) (unstructured.Unstructured, error) {
 	var attempts []MatchAttempt
 	for _, candidate := range candidates {
-		fieldErrs, err := checks.Check(ctx, compilers, candidate.UnstructuredContent(), bindings,
-			ptr.To(v1alpha1.NewCheck(expected.UnstructuredContent())))
+		fieldErrs, compared, err := checkCandidate(ctx, candidate, expected, bindings, funcs, matchOpts)
 		if err != nil {
-			return unstructured.Unstructured{}, fmt.Errorf("failed to check candidate: %w", err)
+			return unstructured.Unstructured{}, err
 		}
 		if len(fieldErrs) == 0 {
 			// Match found
 			return candidate, nil
 		}
 		attempts = append(attempts, MatchAttempt{
-			Actual:    candidate,
-			Expected:  expected,
-			FieldErrs: fieldErrs,
+			Actual:           compared,
+			Expected:         expected,
+			FieldErrs:        fieldErrs,
+			RedactSecretData: matchOpts.decodeSecrets() && util.IsSecret(compared),
+			funcs:            funcs,
 		})
 	}
 	if len(attempts) == 0 {
@@ -162,26 +290,51 @@ func Match(
 }
 
 // MatchAll compares candidates with the expectation and returns all matches
-// or nil if no matches are found. Does not handle non-resource matching.
+// or nil if no matches are found. Does not handle non-resource matching. See MatchOptions
+// for the supported matching options.
 // Based on github.com/kyverno/chainsaw/pkg/engine/operations/assert.Exec.
 func MatchAll(
 	ctx context.Context,
 	candidates []unstructured.Unstructured,
 	expected unstructured.Unstructured,
 	bindings Bindings,
+	funcs *Functions,
+	matchOpts *MatchOptions,
 ) ([]unstructured.Unstructured, error) {
+	matches, _, err := matchEach(ctx, candidates, expected, bindings, funcs, matchOpts)
+	return matches, err
+}
+
+// matchEach compares candidates with the expectation and returns all matches, plus an
+// attempt recording the field errors of each non-matching candidate.
+func matchEach(
+	ctx context.Context,
+	candidates []unstructured.Unstructured,
+	expected unstructured.Unstructured,
+	bindings Bindings,
+	funcs *Functions,
+	matchOpts *MatchOptions,
+) ([]unstructured.Unstructured, []MatchAttempt, error) {
 	var matches []unstructured.Unstructured
+	var attempts []MatchAttempt
 	for _, candidate := range candidates {
-		fieldErrs, err := checks.Check(ctx, compilers, candidate.UnstructuredContent(), bindings,
-			ptr.To(v1alpha1.NewCheck(expected.UnstructuredContent())))
+		fieldErrs, compared, err := checkCandidate(ctx, candidate, expected, bindings, funcs, matchOpts)
 		if err != nil {
-			return nil, fmt.Errorf("failed to check candidate: %w", err)
+			return nil, nil, err
 		}
 		if len(fieldErrs) == 0 {
 			matches = append(matches, candidate)
+		} else {
+			attempts = append(attempts, MatchAttempt{
+				Actual:           compared,
+				Expected:         expected,
+				FieldErrs:        fieldErrs,
+				RedactSecretData: matchOpts.decodeSecrets() && util.IsSecret(compared),
+				funcs:            funcs,
+			})
 		}
 	}
-	return matches, nil
+	return matches, attempts, nil
 }
 
 // ListCandidates lists resources in the cluster that might match the expectation.
@@ -220,16 +373,19 @@ func ListCandidates(
 }
 
 // Check is equivalent to a Chainsaw assert resource operation without polling. Does not
-// handle non-resource assertions. Returns the first matching resource on success.
+// handle non-resource assertions. Returns the first matching resource on success. See
+// MatchOptions for the supported matching options.
 // Based on github.com/kyverno/chainsaw/pkg/engine/operations/assert.Exec.
 func Check(
 	c client.Client,
 	ctx context.Context,
 	templateContent string,
 	bindings Bindings,
+	funcs *Functions,
+	matchOpts *MatchOptions,
 ) (unstructured.Unstructured, error) {
 	// Render expected resource
-	expected, err := RenderTemplateSingle(ctx, templateContent, bindings)
+	expected, err := RenderTemplateSingle(ctx, templateContent, bindings, funcs)
 	if err != nil {
 		return unstructured.Unstructured{}, err
 	}
@@ -249,5 +405,222 @@ func Check(
 	}
 
 	// Return first match
-	return Match(ctx, candidates, expected, bindings)
+	return Match(ctx, candidates, expected, bindings, funcs, matchOpts)
+}
+
+// CheckNone is equivalent to a Chainsaw error resource operation without polling: it succeeds
+// if no resource in the cluster matches the expectation. Does not handle non-resource
+// assertions. Returns an *UnexpectedMatchError listing the matches on failure.
+// Based on github.com/kyverno/chainsaw/pkg/engine/operations/error.Exec.
+func CheckNone(
+	c client.Client,
+	ctx context.Context,
+	templateContent string,
+	bindings Bindings,
+	funcs *Functions,
+	matchOpts *MatchOptions,
+) error {
+	// Render expected resource
+	expected, err := RenderTemplateSingle(ctx, templateContent, bindings, funcs)
+	if err != nil {
+		return err
+	}
+
+	// List candidates
+	candidates, err := ListCandidates(c, ctx, &expected)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		msg := "failed to list candidates"
+		tip := "ensure template contains required fields"
+		return fmt.Errorf("%s; %s: %w", msg, tip, err)
+	}
+
+	// Fail on any match
+	matches, err := MatchAll(ctx, candidates, expected, bindings, funcs, matchOpts)
+	if err != nil {
+		return err
+	}
+	if len(matches) > 0 {
+		return &UnexpectedMatchError{Expected: expected, Matches: matches, Candidates: len(candidates)}
+	}
+	return nil
+}
+
+// CheckCount is like Check, but succeeds if the number of resources in the cluster matching
+// the expectation satisfies the count constraint. Does not handle non-resource assertions.
+// Returns all matches on success, or a *CountError on failure.
+func CheckCount(
+	c client.Client,
+	ctx context.Context,
+	templateContent string,
+	bindings Bindings,
+	funcs *Functions,
+	count options.Count,
+	matchOpts *MatchOptions,
+) ([]unstructured.Unstructured, error) {
+	// Render expected resource
+	expected, err := RenderTemplateSingle(ctx, templateContent, bindings, funcs)
+	if err != nil {
+		return nil, err
+	}
+
+	// List candidates
+	candidates, err := ListCandidates(c, ctx, &expected)
+	if err != nil && !apierrors.IsNotFound(err) {
+		msg := "failed to list candidates"
+		tip := "ensure template contains required fields"
+		return nil, fmt.Errorf("%s; %s: %w", msg, tip, err)
+	}
+
+	// Count matches
+	matches, attempts, err := matchEach(ctx, candidates, expected, bindings, funcs, matchOpts)
+	if err != nil {
+		return nil, err
+	}
+	if !count.Allows(len(matches)) {
+		return nil, &CountError{
+			Count:      count,
+			Expected:   expected,
+			Candidates: len(candidates),
+			Matches:    matches,
+			Attempts:   attempts,
+		}
+	}
+	return matches, nil
+}
+
+// CheckOwnedBy is like Check, but only considers resources with an owner reference pointing
+// at the owner (see util.ReferencesOwner). If the expectation has no namespace, resources are
+// looked up in the owner's namespace. Returns the first matching resource on success.
+func CheckOwnedBy(
+	c client.Client,
+	ctx context.Context,
+	templateContent string,
+	bindings Bindings,
+	funcs *Functions,
+	owner client.Object,
+	ownerGVK schema.GroupVersionKind,
+	matchOpts *MatchOptions,
+) (unstructured.Unstructured, error) {
+	// Render expected resource
+	expected, err := RenderTemplateSingle(ctx, templateContent, bindings, funcs)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	if expected.GetNamespace() == "" {
+		expected.SetNamespace(owner.GetNamespace())
+	}
+
+	// List candidates
+	candidates, err := ListCandidates(c, ctx, &expected)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return unstructured.Unstructured{}, errors.New("actual resource not found")
+		}
+		msg := "failed to list candidates"
+		tip := "ensure template contains required fields"
+		return unstructured.Unstructured{}, fmt.Errorf("%s; %s: %w", msg, tip, err)
+	}
+	if len(candidates) == 0 {
+		return unstructured.Unstructured{}, errors.New("no actual resource found")
+	}
+
+	// Keep owned candidates
+	var owned []unstructured.Unstructured
+	for _, candidate := range candidates {
+		if slices.ContainsFunc(candidate.GetOwnerReferences(), func(ref metav1.OwnerReference) bool {
+			return util.ReferencesOwner(ref, owner, ownerGVK)
+		}) {
+			owned = append(owned, candidate)
+		}
+	}
+	if len(owned) == 0 {
+		return unstructured.Unstructured{}, fmt.Errorf(
+			"no actual resource owned by %s found (%d candidate(s) not owned)", referencedID(owner, ownerGVK), len(candidates))
+	}
+
+	// Return first match
+	return Match(ctx, owned, expected, bindings, funcs, matchOpts)
+}
+
+// CheckEvent is equivalent to Check, restricted to Events about the given object. The template must
+// define a core/v1 or events.k8s.io/v1 Event, and candidates are Events of that API whose involvedObject
+// (core/v1) or regarding (events.k8s.io/v1) reference points at the object. Expectations without a
+// namespace are looked up in the object's namespace. Returns the first matching Event on success.
+func CheckEvent(
+	c client.Client,
+	ctx context.Context,
+	templateContent string,
+	bindings Bindings,
+	funcs *Functions,
+	obj client.Object,
+	objGVK schema.GroupVersionKind,
+	matchOpts *MatchOptions,
+) (unstructured.Unstructured, error) {
+	// Render expected Event
+	expected, err := RenderTemplateSingle(ctx, templateContent, bindings, funcs)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	referenceField, err := eventReferenceField(expected.GroupVersionKind())
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	if expected.GetNamespace() == "" {
+		expected.SetNamespace(obj.GetNamespace())
+	}
+
+	// List candidates
+	candidates, err := ListCandidates(c, ctx, &expected)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return unstructured.Unstructured{}, errors.New("actual resource not found")
+		}
+		msg := "failed to list candidates"
+		tip := "ensure template contains required fields"
+		return unstructured.Unstructured{}, fmt.Errorf("%s; %s: %w", msg, tip, err)
+	}
+
+	// Keep Events about the object
+	var related []unstructured.Unstructured
+	for _, candidate := range candidates {
+		refMap, _, _ := unstructured.NestedMap(candidate.Object, referenceField)
+		var ref corev1.ObjectReference
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(refMap, &ref); err != nil {
+			continue
+		}
+		if util.ReferencesObject(ref, obj, objGVK) {
+			related = append(related, candidate)
+		}
+	}
+	if len(related) == 0 {
+		return unstructured.Unstructured{}, fmt.Errorf(
+			"no actual %s Event found for %s (%d candidate(s) about other objects)",
+			expected.GetAPIVersion(), referencedID(obj, objGVK), len(candidates))
+	}
+
+	// Return first match
+	return Match(ctx, related, expected, bindings, funcs, matchOpts)
+}
+
+// referencedID renders an identifier for an object referenced by other resources, e.g.
+// "v1/ConfigMap default/my-config", or "v1/Namespace my-namespace" if cluster-scoped.
+func referencedID(obj client.Object, gvk schema.GroupVersionKind) string {
+	return fmt.Sprintf("%s/%s %s", gvk.GroupVersion().String(), gvk.Kind,
+		strings.TrimLeft(client.ObjectKeyFromObject(obj).String(), "/"))
+}
+
+// eventReferenceField returns the name of the field referencing the object an Event is about.
+func eventReferenceField(gvk schema.GroupVersionKind) (string, error) {
+	switch {
+	case gvk.Kind != "Event":
+	case gvk.Group == "" && gvk.Version == "v1":
+		return "involvedObject", nil
+	case gvk.Group == "events.k8s.io" && gvk.Version == "v1":
+		return "regarding", nil
+	}
+	return "", fmt.Errorf("expected template to define a v1 or events.k8s.io/v1 Event; found %s/%s",
+		gvk.GroupVersion().String(), gvk.Kind)
 }
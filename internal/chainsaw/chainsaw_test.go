@@ -8,9 +8,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/guidewire-oss/sawchain/internal/chainsaw"
+	"github.com/guidewire-oss/sawchain/internal/options"
 	"github.com/guidewire-oss/sawchain/internal/testutil"
 )
 
@@ -218,7 +220,7 @@ var _ = Describe("Chainsaw", func() {
 				bindings, err := chainsaw.BindingsFromMap(tc.bindings)
 				Expect(err).NotTo(HaveOccurred())
 				// Test RenderTemplate
-				objs, err := chainsaw.RenderTemplate(context.Background(), tc.templateContent, bindings)
+				objs, err := chainsaw.RenderTemplate(context.Background(), tc.templateContent, bindings, nil)
 				// Check error
 				if len(tc.expectedErrs) > 0 {
 					Expect(err).To(HaveOccurred())
@@ -528,7 +530,7 @@ data:
 				bindings, err := chainsaw.BindingsFromMap(tc.bindings)
 				Expect(err).NotTo(HaveOccurred())
 				// Test RenderTemplateSingle
-				obj, err := chainsaw.RenderTemplateSingle(context.Background(), tc.templateContent, bindings)
+				obj, err := chainsaw.RenderTemplateSingle(context.Background(), tc.templateContent, bindings, nil)
 				// Check error
 				if len(tc.expectedErrs) > 0 {
 					Expect(err).To(HaveOccurred())
@@ -785,11 +787,129 @@ data:
 		)
 	})
 
+	Describe("RenderJSONPatch", func() {
+		type testCase struct {
+			templateContent string
+			bindings        map[string]any
+			expectedPatch   string
+			expectedErrs    []string
+		}
+
+		DescribeTable("rendering JSON patch templates",
+			func(tc testCase) {
+				// Create bindings from map
+				bindings, err := chainsaw.BindingsFromMap(tc.bindings)
+				Expect(err).NotTo(HaveOccurred())
+				// Test RenderJSONPatch
+				patch, err := chainsaw.RenderJSONPatch(context.Background(), tc.templateContent, bindings, nil)
+				// Check error
+				if len(tc.expectedErrs) > 0 {
+					Expect(err).To(HaveOccurred())
+					for _, expectedErr := range tc.expectedErrs {
+						Expect(err.Error()).To(ContainSubstring(expectedErr))
+					}
+					Expect(patch).To(BeNil())
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(patch).To(MatchJSON(tc.expectedPatch))
+				}
+			},
+			Entry("should render static operations", testCase{
+				templateContent: `
+- op: replace
+  path: /data/key1
+  value: value1
+- op: remove
+  path: /data/key2
+`,
+				expectedPatch: `[
+					{"op": "replace", "path": "/data/key1", "value": "value1"},
+					{"op": "remove", "path": "/data/key2"}
+				]`,
+			}),
+			Entry("should render operations with bindings", testCase{
+				templateContent: `
+- op: add
+  path: (concat('/data/', $key))
+  value: ($value)
+- op: replace
+  path: /spec/replicas
+  value: ($replicas)
+`,
+				bindings: map[string]any{"key": "key1", "value": "value1", "replicas": 3},
+				expectedPatch: `[
+					{"op": "add", "path": "/data/key1", "value": "value1"},
+					{"op": "replace", "path": "/spec/replicas", "value": 3}
+				]`,
+			}),
+			Entry("should render operations with object values", testCase{
+				templateContent: `
+- op: add
+  path: /spec/template/spec/containers/-
+  value:
+    name: sidecar
+    image: ($image)
+`,
+				bindings: map[string]any{"image": "busybox"},
+				expectedPatch: `[
+					{"op": "add", "path": "/spec/template/spec/containers/-", "value": {"name": "sidecar", "image": "busybox"}}
+				]`,
+			}),
+			Entry("should accept JSON content", testCase{
+				templateContent: `[{"op": "remove", "path": "/data/key1"}]`,
+				expectedPatch:   `[{"op": "remove", "path": "/data/key1"}]`,
+			}),
+			Entry("should fail with non-list content", testCase{
+				templateContent: `
+apiVersion: v1
+kind: ConfigMap
+`,
+				expectedErrs: []string{
+					"failed to parse JSON patch",
+					"ensure the template is a list of patch operations",
+				},
+			}),
+			Entry("should fail with invalid operation", testCase{
+				templateContent: `
+- path: /data/key1
+`,
+				expectedErrs: []string{"invalid JSON patch: operation 0 must have a valid op"},
+			}),
+			Entry("should fail with missing binding", testCase{
+				templateContent: `
+- op: remove
+  path: ($missing)
+`,
+				expectedErrs: []string{
+					"failed to render template",
+					"variable not defined: $missing",
+				},
+			}),
+		)
+	})
+
 	Describe("Match", func() {
+		secret := func(password string) unstructured.Unstructured {
+			return unstructured.Unstructured{
+				Object: map[string]any{
+					"apiVersion": "v1",
+					"kind":       "Secret",
+					"metadata": map[string]any{
+						"name":      "test-secret",
+						"namespace": "default",
+					},
+					"data": map[string]any{
+						"password": password,
+					},
+				},
+			}
+		}
+
 		type testCase struct {
 			candidates    []unstructured.Unstructured
 			expected      unstructured.Unstructured
 			bindings      map[string]any
+			matchOpts     *chainsaw.MatchOptions
 			expectedMatch unstructured.Unstructured
 			expectedErrs  []string
 		}
@@ -800,7 +920,7 @@ data:
 				bindings, err := chainsaw.BindingsFromMap(tc.bindings)
 				Expect(err).NotTo(HaveOccurred())
 				// Test Match
-				match, err := chainsaw.Match(context.Background(), tc.candidates, tc.expected, bindings)
+				match, err := chainsaw.Match(context.Background(), tc.candidates, tc.expected, bindings, nil, tc.matchOpts)
 				// Check error
 				if len(tc.expectedErrs) > 0 {
 					Expect(err).To(HaveOccurred())
@@ -1248,6 +1368,115 @@ data:
 				expectedMatch: unstructured.Unstructured{},
 				expectedErrs:  nil,
 			}),
+			// Strict match tests
+			Entry("should match in strict mode when no fields are unexpected", testCase{
+				candidates: []unstructured.Unstructured{
+					*testutil.NewUnstructuredConfigMap("test-config", "default", map[string]string{"key1": "value1"}),
+				},
+				expected:  *testutil.NewUnstructuredConfigMap("test-config", "default", map[string]string{"key1": "value1"}),
+				matchOpts: &chainsaw.MatchOptions{Strict: &chainsaw.Strictness{}},
+				expectedMatch: *testutil.NewUnstructuredConfigMap("test-config", "default",
+					map[string]string{"key1": "value1"}),
+			}),
+			Entry("should not match in strict mode with unexpected fields", testCase{
+				candidates: []unstructured.Unstructured{
+					*testutil.NewUnstructuredConfigMap("test-config", "default", map[string]string{
+						"key1": "value1",
+						"key2": "value2",
+					}),
+				},
+				expected: unstructured.Unstructured{
+					Object: map[string]any{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata": map[string]any{
+							"name": "test-config",
+						},
+						"data": map[string]any{
+							"key1": "value1",
+						},
+					},
+				},
+				matchOpts:     &chainsaw.MatchOptions{Strict: &chainsaw.Strictness{}},
+				expectedMatch: unstructured.Unstructured{},
+				expectedErrs: []string{
+					"data.key2: Forbidden: field is not present in expectation",
+					"metadata.namespace: Forbidden: field is not present in expectation",
+				},
+			}),
+			Entry("should match in strict mode with unexpected fields under ignore paths", testCase{
+				candidates: []unstructured.Unstructured{
+					*testutil.NewUnstructuredConfigMap("test-config", "default", map[string]string{
+						"key1": "value1",
+						"key2": "value2",
+					}),
+				},
+				expected: unstructured.Unstructured{
+					Object: map[string]any{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata": map[string]any{
+							"name": "test-config",
+						},
+						"data": map[string]any{
+							"key1": "value1",
+						},
+					},
+				},
+				matchOpts: &chainsaw.MatchOptions{Strict: &chainsaw.Strictness{IgnorePaths: []string{"metadata.namespace", "data.key2"}}},
+				expectedMatch: *testutil.NewUnstructuredConfigMap("test-config", "default", map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			}),
+			Entry("should not apply strict mode to candidates failing the check", testCase{
+				candidates: []unstructured.Unstructured{
+					*testutil.NewUnstructuredConfigMap("test-config", "default", map[string]string{
+						"key1": "wrong-value",
+						"key2": "value2",
+					}),
+				},
+				expected:      *testutil.NewUnstructuredConfigMap("test-config", "default", map[string]string{"key1": "value1"}),
+				matchOpts:     &chainsaw.MatchOptions{Strict: &chainsaw.Strictness{}},
+				expectedMatch: unstructured.Unstructured{},
+				expectedErrs:  []string{"data.key1: Invalid value: \"wrong-value\": Expected value: \"value1\""},
+			}),
+			Entry("should fail in strict mode with invalid ignore paths", testCase{
+				candidates: []unstructured.Unstructured{
+					*testutil.NewUnstructuredConfigMap("test-config", "default", map[string]string{"key1": "value1"}),
+				},
+				expected:      *testutil.NewUnstructuredConfigMap("test-config", "default", map[string]string{"key1": "value1"}),
+				matchOpts:     &chainsaw.MatchOptions{Strict: &chainsaw.Strictness{IgnorePaths: []string{"metadata..name"}}},
+				expectedMatch: unstructured.Unstructured{},
+				expectedErrs:  []string{"invalid ignore path: field path \"metadata..name\" has an empty segment"},
+			}),
+			// Secret decoding tests
+			Entry("should match decoded Secret data and return the encoded candidate", testCase{
+				candidates:    []unstructured.Unstructured{secret("czNjcjN0")},
+				expected:      secret("s3cr3t"),
+				matchOpts:     &chainsaw.MatchOptions{DecodeSecrets: true},
+				expectedMatch: secret("czNjcjN0"),
+			}),
+			Entry("should not match decoded Secret data without DecodeSecrets", testCase{
+				candidates:    []unstructured.Unstructured{secret("czNjcjN0")},
+				expected:      secret("s3cr3t"),
+				expectedMatch: unstructured.Unstructured{},
+				expectedErrs:  []string{"data.password: Invalid value: \"czNjcjN0\": Expected value: \"s3cr3t\""},
+			}),
+			Entry("should redact mismatched decoded Secret data", testCase{
+				candidates:    []unstructured.Unstructured{secret("czNjcjN0")},
+				expected:      secret("wrong"),
+				matchOpts:     &chainsaw.MatchOptions{DecodeSecrets: true},
+				expectedMatch: unstructured.Unstructured{},
+				expectedErrs:  []string{"data.password: Invalid value: \"<redacted>\": Expected value: <redacted>"},
+			}),
+			Entry("should fail with invalid base64 Secret data", testCase{
+				candidates:    []unstructured.Unstructured{secret("not base64!")},
+				expected:      secret("s3cr3t"),
+				matchOpts:     &chainsaw.MatchOptions{DecodeSecrets: true},
+				expectedMatch: unstructured.Unstructured{},
+				expectedErrs:  []string{"data.password: invalid base64"},
+			}),
 		)
 	})
 
@@ -1299,7 +1528,7 @@ data:
 			Expect(err).NotTo(HaveOccurred())
 
 			_, err = chainsaw.Match(context.Background(),
-				[]unstructured.Unstructured{candidate1, candidate2}, expected, bindings)
+				[]unstructured.Unstructured{candidate1, candidate2}, expected, bindings, nil, nil)
 			Expect(err).To(HaveOccurred())
 
 			var me *chainsaw.MatchError
@@ -1318,11 +1547,44 @@ data:
 			Expect(best.FieldErrs).To(HaveLen(1))
 		})
 
+		It("redacts decoded Secret values below verbose verbosity", func() {
+			bindings, err := chainsaw.BindingsFromMap(map[string]any{})
+			Expect(err).NotTo(HaveOccurred())
+			secret := func(data map[string]any) unstructured.Unstructured {
+				return unstructured.Unstructured{
+					Object: map[string]any{
+						"apiVersion": "v1",
+						"kind":       "Secret",
+						"metadata":   map[string]any{"name": "test-secret", "namespace": "default"},
+						"data":       data,
+					},
+				}
+			}
+
+			_, err = chainsaw.Match(context.Background(),
+				[]unstructured.Unstructured{secret(map[string]any{"password": "czNjcjN0"})},
+				secret(map[string]any{"password": "wrong-password"}), bindings, nil,
+				&chainsaw.MatchOptions{DecodeSecrets: true})
+			Expect(err).To(HaveOccurred())
+
+			var me *chainsaw.MatchError
+			Expect(errors.As(err, &me)).To(BeTrue(), "error should be a *MatchError")
+			for _, verbosity := range []options.Verbosity{options.VerbosityMinimal, options.VerbosityNormal} {
+				msg := me.Format(verbosity, "", nil)
+				Expect(msg).To(ContainSubstring("data.password: Invalid value: \"<redacted>\""))
+				Expect(msg).NotTo(ContainSubstring("s3cr3t"))
+				Expect(msg).NotTo(ContainSubstring("czNjcjN0"))
+				Expect(msg).NotTo(ContainSubstring("wrong-password"))
+			}
+			msg := me.Format(options.VerbosityVerbose, "", nil)
+			Expect(msg).To(ContainSubstring("data.password: Invalid value: \"s3cr3t\": Expected value: \"wrong-password\""))
+		})
+
 		It("returns no error when the candidate list is empty", func() {
 			bindings, err := chainsaw.BindingsFromMap(map[string]any{})
 			Expect(err).NotTo(HaveOccurred())
 			match, err := chainsaw.Match(context.Background(),
-				[]unstructured.Unstructured{}, expected, bindings)
+				[]unstructured.Unstructured{}, expected, bindings, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(match).To(Equal(unstructured.Unstructured{}))
 		})
@@ -1343,7 +1605,7 @@ data:
 				bindings, err := chainsaw.BindingsFromMap(tc.bindings)
 				Expect(err).NotTo(HaveOccurred())
 				// Test MatchAll
-				matches, err := chainsaw.MatchAll(context.Background(), tc.candidates, tc.expected, bindings)
+				matches, err := chainsaw.MatchAll(context.Background(), tc.candidates, tc.expected, bindings, nil, nil)
 				// Check error
 				if len(tc.expectedErrs) > 0 {
 					Expect(err).To(HaveOccurred())
@@ -1818,6 +2080,7 @@ data:
 			resourcesYaml   string
 			templateContent string
 			bindings        map[string]any
+			matchOpts       *chainsaw.MatchOptions
 			expectedMatch   unstructured.Unstructured
 			expectedErrs    []string
 		}
@@ -1929,7 +2192,7 @@ spec:
 				BeforeEach(func() {
 					// Create resources if provided
 					if tc.resourcesYaml != "" {
-						resources, err := chainsaw.RenderTemplate(ctx, tc.resourcesYaml, nil)
+						resources, err := chainsaw.RenderTemplate(ctx, tc.resourcesYaml, nil, nil)
 						Expect(err).NotTo(HaveOccurred(), "Failed to parse test resources")
 
 						createdResources = make([]unstructured.Unstructured, 0, len(resources))
@@ -1970,7 +2233,7 @@ spec:
 
 				It("should check resources correctly", func() {
 					// Test Check
-					match, err := chainsaw.Check(k8sClient, ctx, tc.templateContent, bindings)
+					match, err := chainsaw.Check(k8sClient, ctx, tc.templateContent, bindings, nil, tc.matchOpts)
 
 					// Check error
 					if len(tc.expectedErrs) > 0 {
@@ -2519,6 +2782,70 @@ metadata:
 					},
 				},
 			}),
+			Entry("should check in strict mode ignoring server-populated fields", testCase{
+				resourcesYaml: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-strict-cm
+  namespace: default
+data:
+  key1: value1
+`,
+				templateContent: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-strict-cm
+  namespace: default
+data:
+  key1: value1
+`,
+				bindings: map[string]any{},
+				matchOpts: &chainsaw.MatchOptions{Strict: &chainsaw.Strictness{IgnorePaths: []string{
+					"metadata.resourceVersion", "metadata.creationTimestamp", "metadata.managedFields",
+				}}},
+				expectedMatch: unstructured.Unstructured{
+					Object: map[string]any{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata": map[string]any{
+							"name":      "test-strict-cm",
+							"namespace": "default",
+						},
+						"data": map[string]any{
+							"key1": "value1",
+						},
+					},
+				},
+			}),
+			Entry("should fail in strict mode with unexpected fields", testCase{
+				resourcesYaml: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-strict-cm
+  namespace: default
+  labels:
+    extra: label
+data:
+  key1: value1
+`,
+				templateContent: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-strict-cm
+  namespace: default
+data:
+  key1: value1
+`,
+				bindings: map[string]any{},
+				matchOpts: &chainsaw.MatchOptions{Strict: &chainsaw.Strictness{IgnorePaths: []string{
+					"metadata.resourceVersion", "metadata.creationTimestamp", "metadata.managedFields",
+				}}},
+				expectedErrs: []string{"metadata.labels: Forbidden: field is not present in expectation"},
+			}),
 		)
 	})
 
@@ -2563,7 +2890,7 @@ metadata:
 data:
   key1: expected-value
 `
-			resources, err := chainsaw.RenderTemplate(ctx, resourcesYaml, nil)
+			resources, err := chainsaw.RenderTemplate(ctx, resourcesYaml, nil, nil)
 			Expect(err).NotTo(HaveOccurred(), "Failed to parse test resources")
 			for _, r := range resources {
 				obj := r.DeepCopy() // Avoid modifying original
@@ -2573,7 +2900,7 @@ data:
 
 			bindings, err := chainsaw.BindingsFromMap(map[string]any{})
 			Expect(err).NotTo(HaveOccurred())
-			_, err = chainsaw.Check(k8sClient, ctx, template, bindings)
+			_, err = chainsaw.Check(k8sClient, ctx, template, bindings, nil, nil)
 			Expect(err).To(HaveOccurred())
 
 			var me *chainsaw.MatchError
@@ -2583,4 +2910,498 @@ data:
 			Expect(me.Attempts[0].FieldErrs).NotTo(BeEmpty())
 		})
 	})
+
+	Describe("CheckNone", func() {
+		var createdResources []unstructured.Unstructured
+
+		BeforeEach(func() {
+			createdResources = nil
+			resourcesYaml := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-check-none-1
+  namespace: default
+  labels:
+    app: check-none
+data:
+  key1: value1
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-check-none-2
+  namespace: default
+  labels:
+    app: check-none
+data:
+  key1: value2
+`
+			resources, err := chainsaw.RenderTemplate(ctx, resourcesYaml, nil, nil)
+			Expect(err).NotTo(HaveOccurred(), "Failed to parse test resources")
+			for _, r := range resources {
+				obj := r.DeepCopy() // Avoid modifying original
+				Expect(k8sClient.Create(ctx, obj)).To(Succeed(), "Failed to create test resource")
+				createdResources = append(createdResources, *obj)
+			}
+		})
+
+		AfterEach(func() {
+			for _, resource := range createdResources {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &resource))).
+					To(Succeed(), "Failed to delete test resource")
+			}
+		})
+
+		DescribeTable("checking the cluster for absent resources",
+			func(template string, expectedMatches []string) {
+				bindings, err := chainsaw.BindingsFromMap(map[string]any{})
+				Expect(err).NotTo(HaveOccurred())
+				err = chainsaw.CheckNone(k8sClient, ctx, template, bindings, nil, nil)
+				if len(expectedMatches) == 0 {
+					Expect(err).NotTo(HaveOccurred())
+					return
+				}
+				var ume *chainsaw.UnexpectedMatchError
+				Expect(errors.As(err, &ume)).To(BeTrue(), "error should be an *UnexpectedMatchError")
+				Expect(ume.Candidates).To(Equal(2))
+				var names []string
+				for _, match := range ume.Matches {
+					names = append(names, match.GetName())
+				}
+				Expect(names).To(Equal(expectedMatches))
+			},
+			Entry("succeeds when no candidate matches", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    app: check-none
+data:
+  key1: value3
+`, nil),
+			Entry("succeeds when named resource does not exist", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-check-none-missing
+  namespace: default
+`, nil),
+			Entry("succeeds when no candidate exists", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    app: check-none-missing
+`, nil),
+			Entry("fails with every matching candidate", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: default
+  labels:
+    app: check-none
+`, []string{"test-check-none-1", "test-check-none-2"}),
+			Entry("fails with candidates matching expressions", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    app: check-none
+data:
+  (ends_with(key1, '2')): true
+`, []string{"test-check-none-2"}),
+		)
+	})
+
+	Describe("CheckCount", func() {
+		var createdResources []unstructured.Unstructured
+
+		BeforeEach(func() {
+			createdResources = nil
+			resourcesYaml := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-check-count-1
+  namespace: default
+  labels:
+    app: check-count
+data:
+  key1: value1
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-check-count-2
+  namespace: default
+  labels:
+    app: check-count
+data:
+  key1: value2
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-check-count-3
+  namespace: default
+  labels:
+    app: check-count
+data:
+  key1: value2
+`
+			resources, err := chainsaw.RenderTemplate(ctx, resourcesYaml, nil, nil)
+			Expect(err).NotTo(HaveOccurred(), "Failed to parse test resources")
+			for _, r := range resources {
+				obj := r.DeepCopy() // Avoid modifying original
+				Expect(k8sClient.Create(ctx, obj)).To(Succeed(), "Failed to create test resource")
+				createdResources = append(createdResources, *obj)
+			}
+		})
+
+		AfterEach(func() {
+			for _, resource := range createdResources {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &resource))).
+					To(Succeed(), "Failed to delete test resource")
+			}
+		})
+
+		matchingValue2 := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  namespace: default
+  labels:
+    app: check-count
+data:
+  key1: value2
+`
+
+		DescribeTable("counting matching resources in the cluster",
+			func(template string, count options.Count, expectedMatches []string, expectedAttempts int) {
+				bindings, err := chainsaw.BindingsFromMap(map[string]any{})
+				Expect(err).NotTo(HaveOccurred())
+				matches, err := chainsaw.CheckCount(k8sClient, ctx, template, bindings, nil, count, nil)
+				var names []string
+				if expectedAttempts < 0 {
+					Expect(err).NotTo(HaveOccurred())
+					for _, match := range matches {
+						names = append(names, match.GetName())
+					}
+					Expect(names).To(Equal(expectedMatches))
+					return
+				}
+				var ce *chainsaw.CountError
+				Expect(errors.As(err, &ce)).To(BeTrue(), "error should be a *CountError")
+				Expect(ce.Count).To(Equal(count))
+				for _, match := range ce.Matches {
+					names = append(names, match.GetName())
+				}
+				Expect(names).To(Equal(expectedMatches))
+				Expect(ce.Attempts).To(HaveLen(expectedAttempts))
+			},
+			Entry("succeeds with exact count", matchingValue2,
+				options.Count{Min: 2, Max: 2}, []string{"test-check-count-2", "test-check-count-3"}, -1),
+			Entry("succeeds with minimum count", matchingValue2,
+				options.Count{Min: 1, Max: -1}, []string{"test-check-count-2", "test-check-count-3"}, -1),
+			Entry("succeeds with zero matches allowed by maximum", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    app: check-count
+data:
+  key1: value3
+`, options.Count{Min: 0, Max: 1}, nil, -1),
+			Entry("succeeds when no candidate exists", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    app: check-count-missing
+`, options.Count{Min: 0, Max: 0}, nil, -1),
+			Entry("fails with too few matches", matchingValue2,
+				options.Count{Min: 3, Max: -1}, []string{"test-check-count-2", "test-check-count-3"}, 1),
+			Entry("fails with too many matches", matchingValue2,
+				options.Count{Min: 0, Max: 1}, []string{"test-check-count-2", "test-check-count-3"}, 1),
+			Entry("fails when named resource does not exist", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-check-count-missing
+  namespace: default
+`, options.Count{Min: 1, Max: 1}, nil, 0),
+		)
+	})
+
+	Describe("CheckOwnedBy", func() {
+		var createdResources []unstructured.Unstructured
+
+		owner := testutil.NewConfigMap("test-owner", "default", nil)
+		owner.UID = "owner-uid"
+		ownerGVK := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+
+		BeforeEach(func() {
+			createdResources = nil
+			resourcesYaml := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-owned-1
+  namespace: default
+  labels:
+    app: check-owned-by
+  ownerReferences:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: test-owner
+    uid: owner-uid
+data:
+  key1: value1
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-owned-2
+  namespace: default
+  labels:
+    app: check-owned-by
+  ownerReferences:
+  - apiVersion: v1
+    kind: ConfigMap
+    name: test-owner
+    uid: owner-uid
+    controller: true
+data:
+  key1: value2
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-not-owned
+  namespace: default
+  labels:
+    app: check-owned-by
+data:
+  key1: value3
+`
+			resources, err := chainsaw.RenderTemplate(ctx, resourcesYaml, nil, nil)
+			Expect(err).NotTo(HaveOccurred(), "Failed to parse test resources")
+			for _, r := range resources {
+				obj := r.DeepCopy() // Avoid modifying original
+				Expect(k8sClient.Create(ctx, obj)).To(Succeed(), "Failed to create test resource")
+				createdResources = append(createdResources, *obj)
+			}
+		})
+
+		AfterEach(func() {
+			for _, resource := range createdResources {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &resource))).
+					To(Succeed(), "Failed to delete test resource")
+			}
+		})
+
+		DescribeTable("checking resources owned by the owner",
+			func(template, expectedMatch, expectedErr string) {
+				bindings, err := chainsaw.BindingsFromMap(map[string]any{})
+				Expect(err).NotTo(HaveOccurred())
+				match, err := chainsaw.CheckOwnedBy(k8sClient, ctx, template, bindings, nil, owner, ownerGVK, nil)
+				if expectedErr != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(expectedErr))
+					return
+				}
+				Expect(err).NotTo(HaveOccurred())
+				Expect(match.GetName()).To(Equal(expectedMatch))
+			},
+			Entry("finds owned resource in owner namespace", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    app: check-owned-by
+data:
+  key1: value2
+`, "test-owned-2", ""),
+			Entry("finds owned resource by name", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-owned-1
+`, "test-owned-1", ""),
+			Entry("ignores matching resource that is not owned", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  labels:
+    app: check-owned-by
+data:
+  key1: value3
+`, "", "key1: Invalid value: \"value1\": Expected value: \"value3\""),
+			Entry("fails when named resource is not owned", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-not-owned
+`, "", "no actual resource owned by v1/ConfigMap default/test-owner found (1 candidate(s) not owned)"),
+			Entry("fails when named resource does not exist", `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-owned-missing
+`, "", "actual resource not found"),
+		)
+
+		It("identifies a cluster-scoped owner without a namespace", func() {
+			bindings, err := chainsaw.BindingsFromMap(map[string]any{})
+			Expect(err).NotTo(HaveOccurred())
+			namespace := &unstructured.Unstructured{}
+			namespace.SetAPIVersion("v1")
+			namespace.SetKind("Namespace")
+			namespace.SetName("default")
+			_, err = chainsaw.CheckOwnedBy(k8sClient, ctx, `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-not-owned
+  namespace: default
+`, bindings, nil, namespace, schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, nil)
+			Expect(err).To(MatchError("no actual resource owned by v1/Namespace default found (1 candidate(s) not owned)"))
+		})
+	})
+	Describe("CheckEvent", func() {
+		var createdResources []unstructured.Unstructured
+
+		obj := testutil.NewConfigMap("test-subject", "default", nil)
+		obj.UID = "subject-uid"
+		objGVK := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+
+		BeforeEach(func() {
+			createdResources = nil
+			resourcesYaml := `
+apiVersion: v1
+kind: Event
+metadata:
+  name: test-subject.created
+  namespace: default
+involvedObject:
+  apiVersion: v1
+  kind: ConfigMap
+  name: test-subject
+  namespace: default
+  uid: subject-uid
+reason: Created
+type: Normal
+message: ConfigMap test-subject created
+---
+apiVersion: v1
+kind: Event
+metadata:
+  name: test-subject.failed
+  namespace: default
+involvedObject:
+  apiVersion: v1
+  kind: ConfigMap
+  name: test-subject
+  namespace: default
+reason: SyncFailed
+type: Warning
+message: failed to sync ConfigMap test-subject
+---
+apiVersion: v1
+kind: Event
+metadata:
+  name: test-other.deleted
+  namespace: default
+involvedObject:
+  apiVersion: v1
+  kind: ConfigMap
+  name: test-other
+  namespace: default
+reason: Deleted
+type: Normal
+message: ConfigMap test-other deleted
+---
+apiVersion: events.k8s.io/v1
+kind: Event
+metadata:
+  name: test-subject.synced
+  namespace: default
+eventTime: "2025-01-01T00:00:00.000000Z"
+reportingController: example.com/controller
+reportingInstance: controller-0
+action: Sync
+regarding:
+  apiVersion: v1
+  kind: ConfigMap
+  name: test-subject
+  namespace: default
+  uid: subject-uid
+reason: Synced
+type: Normal
+note: ConfigMap test-subject synced
+`
+			resources, err := chainsaw.RenderTemplate(ctx, resourcesYaml, nil, nil)
+			Expect(err).NotTo(HaveOccurred(), "Failed to parse test resources")
+			for _, r := range resources {
+				obj := r.DeepCopy() // Avoid modifying original
+				Expect(k8sClient.Create(ctx, obj)).To(Succeed(), "Failed to create test resource")
+				createdResources = append(createdResources, *obj)
+			}
+		})
+
+		AfterEach(func() {
+			for _, resource := range createdResources {
+				Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, &resource))).
+					To(Succeed(), "Failed to delete test resource")
+			}
+		})
+
+		DescribeTable("checking Events about the object",
+			func(template, expectedMatch, expectedErr string) {
+				bindings, err := chainsaw.BindingsFromMap(map[string]any{})
+				Expect(err).NotTo(HaveOccurred())
+				match, err := chainsaw.CheckEvent(k8sClient, ctx, template, bindings, nil, obj, objGVK, nil)
+				if expectedErr != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(expectedErr))
+					return
+				}
+				Expect(err).NotTo(HaveOccurred())
+				Expect(match.GetName()).To(Equal(expectedMatch))
+			},
+			Entry("finds core Event by reason and type", `
+apiVersion: v1
+kind: Event
+reason: SyncFailed
+type: Warning
+`, "test-subject.failed", ""),
+			Entry("finds core Event by message expression", `
+apiVersion: v1
+kind: Event
+(starts_with(message, 'ConfigMap')): true
+`, "test-subject.created", ""),
+			Entry("finds events.k8s.io Event by note", `
+apiVersion: events.k8s.io/v1
+kind: Event
+reason: Synced
+(contains(note, 'synced')): true
+`, "test-subject.synced", ""),
+			Entry("ignores matching Event about another object", `
+apiVersion: v1
+kind: Event
+reason: Deleted
+`, "", "reason: Invalid value: \"Created\": Expected value: \"Deleted\""),
+			Entry("fails when named Event is about another object", `
+apiVersion: v1
+kind: Event
+metadata:
+  name: test-other.deleted
+`, "", "no actual v1 Event found for v1/ConfigMap default/test-subject (1 candidate(s) about other objects)"),
+			Entry("fails when template does not define an Event", `
+apiVersion: v1
+kind: ConfigMap
+`, "", "expected template to define a v1 or events.k8s.io/v1 Event; found v1/ConfigMap"),
+		)
+	})
 })
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/guidewire-oss/sawchain/internal/options"
+	"github.com/guidewire-oss/sawchain/internal/util"
 )
 
 // MatchMode describes what varied across the attempts in a MatchError, which
@@ -33,6 +34,10 @@ type MatchAttempt struct {
 	Actual    unstructured.Unstructured
 	Expected  unstructured.Unstructured
 	FieldErrs field.ErrorList
+	// RedactSecretData hides Secret data and stringData values below VerbosityVerbose.
+	RedactSecretData bool
+	// Custom functions for evaluating expressions in Expected when rendering the diff.
+	funcs *Functions
 }
 
 // MatchError is a structured error describing why one or more match attempts failed. It
@@ -69,6 +74,19 @@ func (e *MatchError) BestMatch() MatchAttempt {
 // The template and bindings arguments are only used at VerbosityVerbose; callers may pass zero
 // values when verbose context is not needed.
 func (e *MatchError) Format(verbosity options.Verbosity, template string, bindings Bindings) string {
+	detail := e.FormatDetail(verbosity, bindings)
+	if len(e.Attempts) == 0 || verbosity < options.VerbosityVerbose {
+		return detail
+	}
+
+	// Global context, shown once (verbose only)
+	return detail + "\n\n" + ContextSection(template, bindings)
+}
+
+// FormatDetail renders the error like Format, but without the template and bindings context
+// sections, so that callers combining several errors into one report can render the context
+// once.
+func (e *MatchError) FormatDetail(verbosity options.Verbosity, bindings Bindings) string {
 	if len(e.Attempts) == 0 {
 		return "no match attempts recorded"
 	}
@@ -93,7 +111,7 @@ func (e *MatchError) Format(verbosity options.Verbosity, template string, bindin
 			best := e.Attempts[bestIdx]
 			sections = append(sections, fmt.Sprintf(
 				"0 of %d attempts matched expectation; best match: %s (%s)",
-				len(e.Attempts), resourceID(e.varyingObj(best)), fieldErrorCount(len(best.FieldErrs))))
+				len(e.Attempts), ResourceID(e.varyingObj(best)), fieldErrorCount(len(best.FieldErrs))))
 			sections = append(sections, e.attemptBlock(best, bestIdx, verbosity, bindings, true))
 			var summaries []string
 			for i := range e.Attempts {
@@ -107,6 +125,29 @@ func (e *MatchError) Format(verbosity options.Verbosity, template string, bindin
 		}
 	}
 
+	return strings.Join(sections, "\n\n")
+}
+
+// FormatEach renders the error like Format, but details every attempt at every verbosity
+// instead of only the best match. It suits all-of semantics, where each failing attempt has
+// to be fixed independently rather than being an alternative to the others.
+func (e *MatchError) FormatEach(verbosity options.Verbosity, template string, bindings Bindings) string {
+	if len(e.Attempts) == 0 {
+		return "no match attempts recorded"
+	}
+
+	var sections []string
+
+	// Fixed object shared by all attempts, shown once (verbose only)
+	if s := e.fixedSection(verbosity); s != "" {
+		sections = append(sections, s)
+	}
+
+	multi := len(e.Attempts) > 1
+	for i := range e.Attempts {
+		sections = append(sections, e.attemptBlock(e.Attempts[i], i, verbosity, bindings, multi))
+	}
+
 	// Global context, shown once (verbose only)
 	if verbosity >= options.VerbosityVerbose {
 		sections = append(sections, ContextSection(template, bindings))
@@ -123,10 +164,10 @@ func (e *MatchError) FormatError(verbosity options.Verbosity, template string, b
 }
 
 // formattedError carries a pre-rendered message while remaining unwrappable to the
-// originating *MatchError via errors.As.
+// originating structured error (e.g. *MatchError) via errors.As.
 type formattedError struct {
 	msg string
-	err *MatchError
+	err error
 }
 
 func (e *formattedError) Error() string { return e.msg }
@@ -199,17 +240,185 @@ func (e *MatchError) attemptBlock(
 // errorDetail renders an attempt's field errors, including a YAML diff at VerbosityNormal
 // and above.
 func (e *MatchError) errorDetail(a MatchAttempt, verbosity options.Verbosity, bindings Bindings) string {
+	if a.RedactSecretData && verbosity < options.VerbosityVerbose {
+		a = redactAttempt(a)
+	}
 	if verbosity >= options.VerbosityNormal {
 		return strings.TrimSpace(
-			operrors.ResourceError(compilers, a.Expected, a.Actual, true, bindings, a.FieldErrs).Error())
+			operrors.ResourceError(a.funcs.compilers(), a.Expected, a.Actual, true, bindings, a.FieldErrs).Error())
 	}
-	lines := append([]string{resourceID(a.Actual)}, fieldErrorLines(a.FieldErrs)...)
+	lines := append([]string{ResourceID(a.Actual)}, fieldErrorLines(a.FieldErrs)...)
 	return strings.Join(lines, "\n")
 }
 
 // summaryLine renders a one-line summary of an attempt for the multi-attempt non-verbose case.
 func (e *MatchError) summaryLine(a MatchAttempt, idx int) string {
-	return fmt.Sprintf("Attempt #%d: %s (%s)", idx+1, resourceID(e.varyingObj(a)), fieldErrorCount(len(a.FieldErrs)))
+	return fmt.Sprintf("Attempt #%d: %s (%s)", idx+1, ResourceID(e.varyingObj(a)), fieldErrorCount(len(a.FieldErrs)))
+}
+
+// UnexpectedMatchError is a structured error describing resources that matched an expectation
+// which should have had no matches (the inverse of a MatchError).
+type UnexpectedMatchError struct {
+	Expected   unstructured.Unstructured
+	Matches    []unstructured.Unstructured
+	Candidates int
+}
+
+// Error implements the error interface, rendering at VerbosityNormal without template
+// content or bindings (which are only included by Format at VerbosityVerbose).
+func (e *UnexpectedMatchError) Error() string {
+	return e.Format(options.VerbosityNormal, "", nil)
+}
+
+// Format renders the error at the given verbosity:
+//
+//   - VerbosityMinimal: the identifiers of the offending matches only.
+//   - VerbosityNormal: the full YAML of the first match, with Secret data and stringData values
+//     redacted; the rest are summarized in one line each.
+//   - VerbosityVerbose: the full YAML of the expectation and every match, plus template content
+//     and bindings.
+//
+// The template and bindings arguments are only used at VerbosityVerbose; callers may pass zero
+// values when verbose context is not needed.
+func (e *UnexpectedMatchError) Format(verbosity options.Verbosity, template string, bindings Bindings) string {
+	if len(e.Matches) == 0 {
+		return "no unexpected matches recorded"
+	}
+
+	var sections []string
+
+	// Expectation, shown once (verbose only)
+	if verbosity >= options.VerbosityVerbose {
+		sections = append(sections, "[EXPECTED]\n"+wrapYAML(toYAML(e.Expected)))
+	}
+
+	header := fmt.Sprintf("expected no matches, but %d of %d candidates matched expectation",
+		len(e.Matches), e.Candidates)
+	switch {
+	case verbosity >= options.VerbosityVerbose:
+		sections = append(sections, header+":")
+		for i := range e.Matches {
+			sections = append(sections, fmt.Sprintf("[MATCH #%d]\n%s", i+1, wrapYAML(toYAML(e.Matches[i]))))
+		}
+	case verbosity >= options.VerbosityNormal:
+		sections = append(sections, fmt.Sprintf("%s; first match: %s", header, ResourceID(e.Matches[0])))
+		sections = append(sections, "[MATCH #1]\n"+wrapYAML(toYAML(redactMatch(e.Matches[0]))))
+		if len(e.Matches) > 1 {
+			var summaries []string
+			for i := 1; i < len(e.Matches); i++ {
+				summaries = append(summaries, fmt.Sprintf("Match #%d: %s", i+1, ResourceID(e.Matches[i])))
+			}
+			sections = append(sections, "[OTHER MATCHES]\n"+strings.Join(summaries, "\n"))
+		}
+	default:
+		lines := []string{header + ":"}
+		for i := range e.Matches {
+			lines = append(lines, "* "+ResourceID(e.Matches[i]))
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+
+	// Global context, shown once (verbose only)
+	if verbosity >= options.VerbosityVerbose {
+		sections = append(sections, ContextSection(template, bindings))
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+// FormatError is the error-returning counterpart to Format: it renders at the given verbosity
+// (with template and bindings context) and returns an error whose message is that rendering,
+// while remaining unwrappable to this *UnexpectedMatchError via errors.As.
+func (e *UnexpectedMatchError) FormatError(verbosity options.Verbosity, template string, bindings Bindings) error {
+	return &formattedError{msg: e.Format(verbosity, template, bindings), err: e}
+}
+
+// CountError is a structured error describing a number of matches that does not satisfy a
+// count constraint, including the matches found and the non-matching attempts.
+type CountError struct {
+	Count      options.Count
+	Expected   unstructured.Unstructured
+	Candidates int
+	Matches    []unstructured.Unstructured
+	Attempts   []MatchAttempt
+}
+
+// Error implements the error interface, rendering at VerbosityNormal without template
+// content or bindings (which are only included by Format at VerbosityVerbose).
+func (e *CountError) Error() string {
+	return e.Format(options.VerbosityNormal, "", nil)
+}
+
+// Format renders the error at the given verbosity. The matches found are always listed. When
+// too few resources matched, the non-matching attempts are rendered like a MatchError:
+//
+//   - VerbosityMinimal: field-level errors of the best non-matching attempt only, without YAML
+//     diffs; the rest are summarized in one line each.
+//   - VerbosityNormal: field-level errors with a YAML diff for the best non-matching attempt;
+//     the rest are summarized in one line each.
+//   - VerbosityVerbose: field-level errors with YAML diffs for every non-matching attempt, plus
+//     the full expected/actual YAML, template content, and bindings.
+//
+// The template and bindings arguments are only used at VerbosityVerbose; callers may pass zero
+// values when verbose context is not needed.
+func (e *CountError) Format(verbosity options.Verbosity, template string, bindings Bindings) string {
+	var sections []string
+
+	// Expectation, shown once (verbose only)
+	if verbosity >= options.VerbosityVerbose {
+		sections = append(sections, "[EXPECTED]\n"+wrapYAML(toYAML(e.Expected)))
+	}
+
+	sections = append(sections, fmt.Sprintf("expected %s matching resource(s), but %d of %d candidates matched expectation",
+		e.Count, len(e.Matches), e.Candidates))
+
+	// Matches found
+	if len(e.Matches) > 0 {
+		lines := make([]string, len(e.Matches))
+		for i := range e.Matches {
+			lines[i] = "* " + ResourceID(e.Matches[i])
+		}
+		sections = append(sections, "[MATCHES]\n"+strings.Join(lines, "\n"))
+	}
+
+	// Non-matching attempts, only relevant when too few resources matched
+	if len(e.Matches) < e.Count.Min && len(e.Attempts) > 0 {
+		me := &MatchError{Attempts: e.Attempts, Mode: MatchModeVaryActual}
+		if verbosity >= options.VerbosityVerbose {
+			for i := range e.Attempts {
+				sections = append(sections, me.attemptBlock(e.Attempts[i], i, verbosity, bindings, true))
+			}
+		} else {
+			bestIdx := me.bestMatchIndex()
+			best := e.Attempts[bestIdx]
+			sections = append(sections, fmt.Sprintf("best non-matching attempt: %s (%s)",
+				ResourceID(best.Actual), fieldErrorCount(len(best.FieldErrs))))
+			sections = append(sections, me.attemptBlock(best, bestIdx, verbosity, bindings, true))
+			var summaries []string
+			for i := range e.Attempts {
+				if i != bestIdx {
+					summaries = append(summaries, me.summaryLine(e.Attempts[i], i))
+				}
+			}
+			if len(summaries) > 0 {
+				sections = append(sections, "[OTHER ATTEMPTS]\n"+strings.Join(summaries, "\n"))
+			}
+		}
+	}
+
+	// Global context, shown once (verbose only)
+	if verbosity >= options.VerbosityVerbose {
+		sections = append(sections, ContextSection(template, bindings))
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+// FormatError is the error-returning counterpart to Format: it renders at the given verbosity
+// (with template and bindings context) and returns an error whose message is that rendering,
+// while remaining unwrappable to this *CountError via errors.As.
+func (e *CountError) FormatError(verbosity options.Verbosity, template string, bindings Bindings) error {
+	return &formattedError{msg: e.Format(verbosity, template, bindings), err: e}
 }
 
 // ContextSection renders the [TEMPLATE] and [BINDINGS] sections. It is shared by Format's
@@ -223,9 +432,9 @@ func ContextSection(template string, bindings Bindings) string {
 	return strings.Join(sections, "\n\n")
 }
 
-// resourceID renders a slash-joined identifier for an object, e.g.
+// ResourceID renders a slash-joined identifier for an object, e.g.
 // "v1/ConfigMap/default/my-config". Empty segments are omitted.
-func resourceID(obj unstructured.Unstructured) string {
+func ResourceID(obj unstructured.Unstructured) string {
 	var parts []string
 	if v := obj.GetAPIVersion(); v != "" {
 		parts = append(parts, v)
@@ -277,3 +486,68 @@ func toYAML(obj unstructured.Unstructured) string {
 func wrapYAML(s string) string {
 	return fmt.Sprintf("```yaml\n%s\n```", strings.TrimSpace(s))
 }
+
+// redactedValue replaces redacted Secret values in failure output.
+const redactedValue = "<redacted>"
+
+// secretValueFields are the Secret fields whose values are redacted.
+var secretValueFields = []string{"data", "stringData"}
+
+// redactAttempt returns a copy of the attempt with the string values of Secret data and
+// stringData replaced, in both objects and in field errors.
+func redactAttempt(a MatchAttempt) MatchAttempt {
+	a.Actual = redactSecretValues(a.Actual)
+	a.Expected = redactSecretValues(a.Expected)
+	fieldErrs := make(field.ErrorList, len(a.FieldErrs))
+	for i, fe := range a.FieldErrs {
+		redacted := *fe
+		if isSecretValueField(fe.Field) {
+			redacted.BadValue = redactedValue
+			if strings.HasPrefix(redacted.Detail, "Expected value: ") {
+				redacted.Detail = "Expected value: " + redactedValue
+			}
+		}
+		fieldErrs[i] = &redacted
+	}
+	a.FieldErrs = fieldErrs
+	return a
+}
+
+// redactMatch returns a copy of a matched resource with its values redacted if it is a Secret.
+func redactMatch(obj unstructured.Unstructured) unstructured.Unstructured {
+	if !util.IsSecret(obj) {
+		return obj
+	}
+	return redactSecretValues(obj)
+}
+
+// redactSecretValues returns a copy of the object with the string values of its data and
+// stringData fields replaced.
+func redactSecretValues(obj unstructured.Unstructured) unstructured.Unstructured {
+	if obj.Object == nil {
+		return obj
+	}
+	redacted := *obj.DeepCopy()
+	for _, name := range secretValueFields {
+		values, ok := redacted.Object[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		for key, value := range values {
+			if _, ok := value.(string); ok {
+				values[key] = redactedValue
+			}
+		}
+	}
+	return redacted
+}
+
+// isSecretValueField reports whether the field path points into Secret data or stringData.
+func isSecretValueField(path string) bool {
+	for _, name := range secretValueFields {
+		if strings.HasPrefix(path, name+".") || strings.HasPrefix(path, name+"[") {
+			return true
+		}
+	}
+	return false
+}
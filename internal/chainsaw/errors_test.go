@@ -369,3 +369,329 @@ var _ = Describe("MatchError", func() {
 		})
 	})
 })
+
+var _ = Describe("MatchError FormatEach", func() {
+	actual := unstructuredConfigMap("test-config", "default", map[string]any{"key1": "actual", "key2": "actual"})
+	attempt := func(keys ...string) chainsaw.MatchAttempt {
+		data := map[string]any{}
+		for _, key := range keys {
+			data[key] = "expected-" + key
+		}
+		return chainsaw.MatchAttempt{
+			Actual:    actual,
+			Expected:  unstructuredConfigMap("test-config", "default", data),
+			FieldErrs: fieldErrs(keys...),
+		}
+	}
+
+	type testCase struct {
+		matchErr     *chainsaw.MatchError
+		verbosity    options.Verbosity
+		containsStrs []string
+		excludesStrs []string
+	}
+	DescribeTable("rendering every attempt",
+		func(tc testCase) {
+			msg := tc.matchErr.FormatEach(tc.verbosity, "the-template", nil)
+			for _, s := range tc.containsStrs {
+				Expect(msg).To(ContainSubstring(s))
+			}
+			for _, s := range tc.excludesStrs {
+				Expect(msg).NotTo(ContainSubstring(s))
+			}
+		},
+		Entry("no attempts", testCase{
+			matchErr:     &chainsaw.MatchError{Mode: chainsaw.MatchModeVaryExpected},
+			verbosity:    options.VerbosityNormal,
+			containsStrs: []string{"no match attempts recorded"},
+		}),
+		Entry("single attempt is unnumbered", testCase{
+			matchErr: &chainsaw.MatchError{
+				Mode:     chainsaw.MatchModeVaryExpected,
+				Attempts: []chainsaw.MatchAttempt{attempt("key1")},
+			},
+			verbosity:    options.VerbosityNormal,
+			containsStrs: []string{"[ERROR]", "data.key1", "--- expected"},
+			excludesStrs: []string{"[ERROR #1]"},
+		}),
+		Entry("minimal details every attempt without diffs", testCase{
+			matchErr: &chainsaw.MatchError{
+				Mode:     chainsaw.MatchModeVaryExpected,
+				Attempts: []chainsaw.MatchAttempt{attempt("key1", "key2"), attempt("key2")},
+			},
+			verbosity:    options.VerbosityMinimal,
+			containsStrs: []string{"[ERROR #1]", "[ERROR #2]", "data.key1", "data.key2"},
+			excludesStrs: []string{"--- expected", "best match", "[OTHER ATTEMPTS]", "[ACTUAL]"},
+		}),
+		Entry("normal details every attempt with diffs", testCase{
+			matchErr: &chainsaw.MatchError{
+				Mode:     chainsaw.MatchModeVaryExpected,
+				Attempts: []chainsaw.MatchAttempt{attempt("key1", "key2"), attempt("key2")},
+			},
+			verbosity:    options.VerbosityNormal,
+			containsStrs: []string{"[ERROR #1]", "[ERROR #2]", "--- expected"},
+			excludesStrs: []string{"best match", "[OTHER ATTEMPTS]", "[TEMPLATE]"},
+		}),
+		Entry("verbose adds full YAML and context", testCase{
+			matchErr: &chainsaw.MatchError{
+				Mode:     chainsaw.MatchModeVaryExpected,
+				Attempts: []chainsaw.MatchAttempt{attempt("key1"), attempt("key2")},
+			},
+			verbosity: options.VerbosityVerbose,
+			containsStrs: []string{
+				"[ACTUAL]", "[EXPECTED #1]", "[ERROR #1]", "[EXPECTED #2]", "[ERROR #2]",
+				"[TEMPLATE]", "the-template", "[BINDINGS]",
+			},
+		}),
+	)
+})
+
+var _ = Describe("MatchError FormatDetail", func() {
+	actual := unstructuredConfigMap("test-config", "default", map[string]any{"key1": "actual"})
+	matchErr := &chainsaw.MatchError{
+		Mode: chainsaw.MatchModeVaryExpected,
+		Attempts: []chainsaw.MatchAttempt{{
+			Actual:    actual,
+			Expected:  unstructuredConfigMap("test-config", "default", map[string]any{"key1": "expected-key1"}),
+			FieldErrs: fieldErrs("key1"),
+		}},
+	}
+
+	It("should render like Format below VerbosityVerbose", func() {
+		Expect(matchErr.FormatDetail(options.VerbosityNormal, nil)).To(
+			Equal(matchErr.Format(options.VerbosityNormal, "the-template", nil)))
+	})
+
+	It("should omit the context sections at VerbosityVerbose", func() {
+		msg := matchErr.FormatDetail(options.VerbosityVerbose, nil)
+		Expect(msg).To(ContainSubstring("[ACTUAL]"))
+		Expect(msg).To(ContainSubstring("data.key1"))
+		Expect(msg).NotTo(ContainSubstring("[TEMPLATE]"))
+		Expect(matchErr.Format(options.VerbosityVerbose, "the-template", nil)).To(
+			Equal(msg + "\n\n" + chainsaw.ContextSection("the-template", nil)))
+	})
+})
+
+var _ = Describe("UnexpectedMatchError", func() {
+	expected := unstructuredConfigMap("", "default", map[string]any{"key1": "value"})
+	newError := func(names ...string) *chainsaw.UnexpectedMatchError {
+		e := &chainsaw.UnexpectedMatchError{Expected: expected, Candidates: 5}
+		for _, name := range names {
+			e.Matches = append(e.Matches, unstructuredConfigMap(name, "default", map[string]any{"key1": "value"}))
+		}
+		return e
+	}
+
+	Describe("Error", func() {
+		It("should render at VerbosityNormal without template or bindings", func() {
+			e := newError("cm-1")
+			Expect(e.Error()).To(Equal(e.Format(options.VerbosityNormal, "", nil)))
+			Expect(e.Error()).NotTo(ContainSubstring("[TEMPLATE]"))
+		})
+	})
+
+	Describe("Format", func() {
+		type testCase struct {
+			err          *chainsaw.UnexpectedMatchError
+			verbosity    options.Verbosity
+			containsStrs []string
+			excludesStrs []string
+		}
+		DescribeTable("rendering unexpected match errors",
+			func(tc testCase) {
+				msg := tc.err.Format(tc.verbosity, "the-template", nil)
+				for _, s := range tc.containsStrs {
+					Expect(msg).To(ContainSubstring(s))
+				}
+				for _, s := range tc.excludesStrs {
+					Expect(msg).NotTo(ContainSubstring(s))
+				}
+			},
+			Entry("no matches", testCase{
+				err:          newError(),
+				verbosity:    options.VerbosityNormal,
+				containsStrs: []string{"no unexpected matches recorded"},
+			}),
+			Entry("minimal lists identifiers only", testCase{
+				err:       newError("cm-1", "cm-2"),
+				verbosity: options.VerbosityMinimal,
+				containsStrs: []string{
+					"expected no matches, but 2 of 5 candidates matched expectation:",
+					"* v1/ConfigMap/default/cm-1",
+					"* v1/ConfigMap/default/cm-2",
+				},
+				excludesStrs: []string{"[MATCH #1]", "[EXPECTED]", "[TEMPLATE]", "[BINDINGS]", "key1: value"},
+			}),
+			Entry("normal details the first match and summarizes the rest", testCase{
+				err:       newError("cm-1", "cm-2", "cm-3"),
+				verbosity: options.VerbosityNormal,
+				containsStrs: []string{
+					"expected no matches, but 3 of 5 candidates matched expectation; first match: v1/ConfigMap/default/cm-1",
+					"[MATCH #1]", "name: cm-1", "key1: value",
+					"[OTHER MATCHES]",
+					"Match #2: v1/ConfigMap/default/cm-2",
+					"Match #3: v1/ConfigMap/default/cm-3",
+				},
+				excludesStrs: []string{"[MATCH #2]", "[EXPECTED]", "[TEMPLATE]", "[BINDINGS]"},
+			}),
+			Entry("normal omits other matches section for a single match", testCase{
+				err:          newError("cm-1"),
+				verbosity:    options.VerbosityNormal,
+				containsStrs: []string{"[MATCH #1]", "name: cm-1"},
+				excludesStrs: []string{"[OTHER MATCHES]"},
+			}),
+			Entry("normal redacts Secret values of the first match", testCase{
+				err: &chainsaw.UnexpectedMatchError{
+					Expected: expected,
+					Matches: []unstructured.Unstructured{{Object: map[string]any{
+						"apiVersion": "v1",
+						"kind":       "Secret",
+						"metadata":   map[string]any{"name": "test-secret", "namespace": "default"},
+						"data":       map[string]any{"password": "czNjcjN0"},
+					}}},
+					Candidates: 1,
+				},
+				verbosity:    options.VerbosityNormal,
+				containsStrs: []string{"[MATCH #1]", "name: test-secret", "password: <redacted>"},
+				excludesStrs: []string{"czNjcjN0"},
+			}),
+			Entry("verbose details every match with expectation and context", testCase{
+				err:       newError("cm-1", "cm-2"),
+				verbosity: options.VerbosityVerbose,
+				containsStrs: []string{
+					"[EXPECTED]",
+					"expected no matches, but 2 of 5 candidates matched expectation:",
+					"[MATCH #1]", "name: cm-1",
+					"[MATCH #2]", "name: cm-2",
+					"[TEMPLATE]", "the-template", "[BINDINGS]",
+				},
+				excludesStrs: []string{"[OTHER MATCHES]"},
+			}),
+		)
+	})
+
+	Describe("FormatError", func() {
+		It("should render at the given verbosity and remain unwrappable to the *UnexpectedMatchError", func() {
+			e := newError("cm-1")
+
+			err := e.FormatError(options.VerbosityVerbose, "the-template", nil)
+			Expect(err.Error()).To(Equal(e.Format(options.VerbosityVerbose, "the-template", nil)))
+
+			var extracted *chainsaw.UnexpectedMatchError
+			Expect(errors.As(err, &extracted)).To(BeTrue())
+			Expect(extracted).To(BeIdenticalTo(e))
+
+			var me *chainsaw.MatchError
+			Expect(errors.As(err, &me)).To(BeFalse())
+		})
+	})
+})
+
+var _ = Describe("CountError", func() {
+	expected := unstructuredConfigMap("", "default", map[string]any{"key1": "value"})
+	newError := func(count options.Count, matchNames []string, attempts ...chainsaw.MatchAttempt) *chainsaw.CountError {
+		e := &chainsaw.CountError{Count: count, Expected: expected, Candidates: 5, Attempts: attempts}
+		for _, name := range matchNames {
+			e.Matches = append(e.Matches, unstructuredConfigMap(name, "default", map[string]any{"key1": "value"}))
+		}
+		return e
+	}
+	attempt := func(name string, keys ...string) chainsaw.MatchAttempt {
+		return chainsaw.MatchAttempt{
+			Actual:    unstructuredConfigMap(name, "default", map[string]any{"key1": "other"}),
+			Expected:  expected,
+			FieldErrs: fieldErrs(keys...),
+		}
+	}
+
+	Describe("Error", func() {
+		It("should render at VerbosityNormal without template or bindings", func() {
+			e := newError(options.Count{Min: 2, Max: 2}, []string{"cm-1"}, attempt("cm-2", "key1"))
+			Expect(e.Error()).To(Equal(e.Format(options.VerbosityNormal, "", nil)))
+			Expect(e.Error()).NotTo(ContainSubstring("[TEMPLATE]"))
+		})
+	})
+
+	Describe("Format", func() {
+		type testCase struct {
+			err          *chainsaw.CountError
+			verbosity    options.Verbosity
+			containsStrs []string
+			excludesStrs []string
+		}
+		DescribeTable("rendering count errors",
+			func(tc testCase) {
+				msg := tc.err.Format(tc.verbosity, "the-template", nil)
+				for _, s := range tc.containsStrs {
+					Expect(msg).To(ContainSubstring(s))
+				}
+				for _, s := range tc.excludesStrs {
+					Expect(msg).NotTo(ContainSubstring(s))
+				}
+			},
+			Entry("too many lists matches without attempts", testCase{
+				err:       newError(options.Count{Min: 0, Max: 1}, []string{"cm-1", "cm-2"}, attempt("cm-3", "key1")),
+				verbosity: options.VerbosityNormal,
+				containsStrs: []string{
+					"expected at most 1 matching resource(s), but 2 of 5 candidates matched expectation",
+					"[MATCHES]",
+					"* v1/ConfigMap/default/cm-1",
+					"* v1/ConfigMap/default/cm-2",
+				},
+				excludesStrs: []string{"best non-matching attempt", "[ERROR", "cm-3", "[EXPECTED]", "[TEMPLATE]"},
+			}),
+			Entry("too few details the best non-matching attempt and summarizes the rest", testCase{
+				err: newError(options.Count{Min: 2, Max: -1}, []string{"cm-1"},
+					attempt("cm-2", "key1", "key2"), attempt("cm-3", "key1")),
+				verbosity: options.VerbosityNormal,
+				containsStrs: []string{
+					"expected at least 2 matching resource(s), but 1 of 5 candidates matched expectation",
+					"* v1/ConfigMap/default/cm-1",
+					"best non-matching attempt: v1/ConfigMap/default/cm-3 (1 field error)",
+					"[ERROR #2]", "--- expected",
+					"[OTHER ATTEMPTS]",
+					"Attempt #1: v1/ConfigMap/default/cm-2 (2 field errors)",
+				},
+				excludesStrs: []string{"[ERROR #1]", "[EXPECTED]", "[TEMPLATE]"},
+			}),
+			Entry("too few without matches omits matches section", testCase{
+				err:          newError(options.Count{Min: 1, Max: 1}, nil, attempt("cm-1", "key1")),
+				verbosity:    options.VerbosityNormal,
+				containsStrs: []string{"expected exactly 1 matching resource(s), but 0 of 5 candidates matched expectation"},
+				excludesStrs: []string{"[MATCHES]", "[OTHER ATTEMPTS]"},
+			}),
+			Entry("minimal omits diffs", testCase{
+				err:          newError(options.Count{Min: 1, Max: 1}, nil, attempt("cm-1", "key1")),
+				verbosity:    options.VerbosityMinimal,
+				containsStrs: []string{"best non-matching attempt: v1/ConfigMap/default/cm-1", "[ERROR #1]"},
+				excludesStrs: []string{"--- expected", "[TEMPLATE]"},
+			}),
+			Entry("verbose details every attempt with expectation and context", testCase{
+				err: newError(options.Count{Min: 3, Max: 4}, []string{"cm-1"},
+					attempt("cm-2", "key1"), attempt("cm-3", "key1")),
+				verbosity: options.VerbosityVerbose,
+				containsStrs: []string{
+					"[EXPECTED]",
+					"expected between 3 and 4 matching resource(s), but 1 of 5 candidates matched expectation",
+					"[ACTUAL #1]", "[ERROR #1]",
+					"[ACTUAL #2]", "[ERROR #2]",
+					"[TEMPLATE]", "the-template", "[BINDINGS]",
+				},
+				excludesStrs: []string{"best non-matching attempt", "[OTHER ATTEMPTS]"},
+			}),
+		)
+	})
+
+	Describe("FormatError", func() {
+		It("should render at the given verbosity and remain unwrappable to the *CountError", func() {
+			e := newError(options.Count{Min: 1, Max: 1}, nil, attempt("cm-1", "key1"))
+
+			err := e.FormatError(options.VerbosityVerbose, "the-template", nil)
+			Expect(err.Error()).To(Equal(e.Format(options.VerbosityVerbose, "the-template", nil)))
+
+			var extracted *chainsaw.CountError
+			Expect(errors.As(err, &extracted)).To(BeTrue())
+			Expect(extracted).To(BeIdenticalTo(e))
+		})
+	})
+})
@@ -0,0 +1,221 @@
+package chainsaw
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+
+	gocel "github.com/google/cel-go/cel"
+	jpfunctions "github.com/jmespath-community/go-jmespath/pkg/functions"
+	"github.com/jmespath-community/go-jmespath/pkg/interpreter"
+	"github.com/kyverno/chainsaw/pkg/apis"
+	chainsawfunctions "github.com/kyverno/chainsaw/pkg/engine/functions"
+	corecompilers "github.com/kyverno/kyverno-json/pkg/core/compilers"
+	"github.com/kyverno/kyverno-json/pkg/core/compilers/cel"
+	"github.com/kyverno/kyverno-json/pkg/core/compilers/jp"
+	kyvernojp "github.com/kyverno/kyverno-json/pkg/jp"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apiserver/pkg/cel/library"
+)
+
+const (
+	quantityCmp   = "quantity_cmp"
+	quantityEqual = "quantity_equal"
+)
+
+// defaultCompilers are Chainsaw's default compilers, with JMESPath extended by Sawchain's functions.
+var defaultCompilers = newDefaultCompilers()
+
+// newDefaultCompilers returns Chainsaw's default compilers with a JMESPath compiler that supports
+// Chainsaw's built-in functions as well as Sawchain's functions.
+func newDefaultCompilers() corecompilers.Compilers {
+	c := apis.DefaultCompilers
+	c.Jp = jp.NewCompiler(jp.WithFunctionCaller(newFunctionCaller(builtinFunctions())))
+	return c.WithDefaultCompiler(corecompilers.CompilerJP)
+}
+
+// Functions are custom JMESPath and CEL functions available to template expressions in addition
+// to the built-in functions. A nil *Functions provides the built-in functions only.
+type Functions struct {
+	// Compilers extended with the custom functions.
+	extended corecompilers.Compilers
+}
+
+// NewFunctions returns Functions extending the built-in JMESPath functions with jpFuncs and the
+// built-in CEL environment with celFuncs (e.g. created with cel.Function). Returns an error if a
+// JMESPath function is unnamed, has no handler, or is defined more than once (including as a
+// built-in function), or if the CEL environment cannot be extended.
+func NewFunctions(jpFuncs []jpfunctions.FunctionEntry, celFuncs []gocel.EnvOption) (*Functions, error) {
+	funcs := builtinFunctions()
+	defined := make(map[string]bool, len(funcs)+len(jpFuncs))
+	for _, f := range funcs {
+		defined[f.Name] = true
+	}
+	for _, f := range jpFuncs {
+		if f.Name == "" {
+			return nil, errors.New("JMESPath function name is empty")
+		} else if f.Handler == nil {
+			return nil, fmt.Errorf("JMESPath function %s has no handler", f.Name)
+		} else if defined[f.Name] {
+			return nil, fmt.Errorf("JMESPath function %s is already defined", f.Name)
+		}
+		defined[f.Name] = true
+		funcs = append(funcs, f)
+	}
+
+	env := sync.OnceValues(func() (*gocel.Env, error) {
+		base, err := celEnv()
+		if err != nil {
+			return nil, err
+		}
+		return base.Extend(celFuncs...)
+	})
+	if _, err := env(); err != nil {
+		return nil, fmt.Errorf("invalid CEL functions: %w", err)
+	}
+
+	c := corecompilers.Compilers{
+		Jp:  jp.NewCompiler(jp.WithFunctionCaller(newFunctionCaller(funcs))),
+		Cel: cel.NewCompiler(env),
+	}
+	return &Functions{extended: c.WithDefaultCompiler(corecompilers.CompilerJP)}, nil
+}
+
+// compilers returns the compilers evaluating template expressions with the functions.
+func (f *Functions) compilers() corecompilers.Compilers {
+	if f == nil {
+		return defaultCompilers
+	}
+	return f.extended
+}
+
+// builtinFunctions returns Chainsaw's built-in JMESPath functions and Sawchain's functions.
+func builtinFunctions() []jpfunctions.FunctionEntry {
+	var funcs []jpfunctions.FunctionEntry
+	funcs = append(funcs, kyvernojp.GetFunctions(context.Background())...)
+	funcs = append(funcs, chainsawfunctions.GetFunctions()...)
+	funcs = append(funcs, functions()...)
+	return funcs
+}
+
+// celEnv returns Chainsaw's CEL environment.
+// Based on github.com/kyverno/chainsaw/pkg/apis.DefaultCompilers.
+func celEnv() (*gocel.Env, error) {
+	env, err := cel.DefaultEnv()
+	if err != nil {
+		return nil, err
+	}
+	return env.Extend(
+		library.URLs(),
+		library.Regex(),
+		library.Lists(),
+		library.Authz(),
+		library.Quantity(),
+		library.IP(),
+		library.CIDR(),
+		library.Format(),
+		library.AuthzSelectors(),
+	)
+}
+
+// functionCaller calls JMESPath functions, naming the function in errors so that misused
+// functions can be told apart in templates with many expressions.
+type functionCaller struct {
+	caller  interpreter.FunctionCaller
+	defined map[string]bool
+}
+
+// newFunctionCaller returns a functionCaller for the functions.
+func newFunctionCaller(funcs []jpfunctions.FunctionEntry) *functionCaller {
+	defined := make(map[string]bool, len(funcs))
+	for _, f := range funcs {
+		defined[f.Name] = true
+	}
+	return &functionCaller{caller: interpreter.NewFunctionCaller(funcs...), defined: defined}
+}
+
+func (c *functionCaller) CallFunction(name string, arguments []any) (any, error) {
+	if !c.defined[name] {
+		return nil, fmt.Errorf("unknown function: %s", name)
+	}
+	result, err := c.caller.CallFunction(name, arguments)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return result, nil
+}
+
+// functions returns the JMESPath functions Sawchain adds to Chainsaw's built-in functions.
+func functions() []jpfunctions.FunctionEntry {
+	quantityArgs := []jpfunctions.ArgSpec{
+		{Types: []jpfunctions.JpType{jpfunctions.JpString, jpfunctions.JpNumber}},
+		{Types: []jpfunctions.JpType{jpfunctions.JpString, jpfunctions.JpNumber}},
+	}
+	return []jpfunctions.FunctionEntry{{
+		Name:        quantityCmp,
+		Arguments:   quantityArgs,
+		Handler:     jpQuantityCmp,
+		Description: "Compares two Kubernetes quantities, returning -1, 0, or 1 if the first is less than, equal to, or greater than the second.",
+	}, {
+		Name:        quantityEqual,
+		Arguments:   quantityArgs,
+		Handler:     jpQuantityEqual,
+		Description: "Checks if two Kubernetes quantities are semantically equal, e.g. 1Gi and 1024Mi.",
+	}}
+}
+
+func jpQuantityCmp(arguments []any) (any, error) {
+	q1, q2, err := quantityArgs(arguments)
+	if err != nil {
+		return nil, err
+	}
+	return float64(q1.Cmp(q2)), nil
+}
+
+func jpQuantityEqual(arguments []any) (any, error) {
+	q1, q2, err := quantityArgs(arguments)
+	if err != nil {
+		return nil, err
+	}
+	return q1.Cmp(q2) == 0, nil
+}
+
+// quantityArgs parses the two arguments of a quantity function.
+func quantityArgs(arguments []any) (resource.Quantity, resource.Quantity, error) {
+	if len(arguments) != 2 {
+		return resource.Quantity{}, resource.Quantity{}, fmt.Errorf("expected 2 arguments, got %d", len(arguments))
+	}
+	q1, err := parseQuantity(arguments[0])
+	if err != nil {
+		return resource.Quantity{}, resource.Quantity{}, err
+	}
+	q2, err := parseQuantity(arguments[1])
+	if err != nil {
+		return resource.Quantity{}, resource.Quantity{}, err
+	}
+	return q1, q2, nil
+}
+
+// parseQuantity parses a quantity from a string (e.g. "500m") or a number (e.g. 0.5).
+func parseQuantity(arg any) (resource.Quantity, error) {
+	var s string
+	switch v := arg.(type) {
+	case string:
+		s = v
+	case float64:
+		s = strconv.FormatFloat(v, 'f', -1, 64)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case int:
+		s = strconv.Itoa(v)
+	default:
+		return resource.Quantity{}, fmt.Errorf("invalid quantity %v: expected string or number, got %T", arg, arg)
+	}
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return resource.Quantity{}, fmt.Errorf("invalid quantity %q: %w", s, err)
+	}
+	return q, nil
+}
@@ -0,0 +1,392 @@
+package chainsaw_test
+
+import (
+	"errors"
+	"strings"
+
+	gocel "github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	jpfunctions "github.com/jmespath-community/go-jmespath/pkg/functions"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/guidewire-oss/sawchain/internal/chainsaw"
+)
+
+var _ = Describe("Functions", func() {
+	const actualYaml = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: test-pod
+  namespace: default
+spec:
+  containers:
+  - name: app
+    resources:
+      requests:
+        cpu: 500m
+        memory: 1024Mi
+      limits:
+        cpu: 1
+        memory: 2Gi
+`
+
+	type testCase struct {
+		template    string
+		bindings    map[string]any
+		expectedErr string
+	}
+
+	DescribeTable("matching with Sawchain's JMESPath functions",
+		func(tc testCase) {
+			var actual unstructured.Unstructured
+			Expect(yaml.Unmarshal([]byte(actualYaml), &actual.Object)).To(Succeed())
+
+			bindings, err := chainsaw.BindingsFromMap(tc.bindings)
+			Expect(err).NotTo(HaveOccurred())
+			expected, err := chainsaw.RenderTemplateSingle(ctx, tc.template, bindings, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = chainsaw.Match(ctx, []unstructured.Unstructured{actual}, expected, bindings, nil, nil)
+			if tc.expectedErr != "" {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(tc.expectedErr))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+		},
+
+		// quantity_equal
+		Entry("quantity_equal with equivalent binary and decimal units", testCase{
+			template: `
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - resources:
+      requests:
+        (quantity_equal(memory, '1Gi')): true
+        (quantity_equal(cpu, '0.5')): true
+`,
+		}),
+
+		Entry("quantity_equal with numbers and bindings", testCase{
+			template: `
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - resources:
+      limits:
+        (quantity_equal(cpu, ` + "`1`" + `)): true
+        (quantity_equal(memory, $memory)): true
+`,
+			bindings: map[string]any{"memory": "2048Mi"},
+		}),
+
+		Entry("quantity_equal with different quantities", testCase{
+			template: `
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - resources:
+      requests:
+        (quantity_equal(memory, '1G')): true
+`,
+			expectedErr: "spec.containers[0].resources.requests.(quantity_equal(memory, '1G')): Invalid value: false: Expected value: true",
+		}),
+
+		// quantity_cmp
+		Entry("quantity_cmp comparing requests and limits", testCase{
+			template: `
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - resources:
+      requests:
+        (quantity_cmp(cpu, '1')): -1
+        (quantity_cmp(memory, '1Gi')): 0
+      limits:
+        (quantity_cmp(memory, '1500Mi')): 1
+`,
+		}),
+
+		Entry("quantity_cmp in boolean expression", testCase{
+			template: `
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - resources:
+      (quantity_cmp(requests.memory, limits.memory) < ` + "`0`" + `): true
+`,
+		}),
+
+		// Errors
+		Entry("invalid quantity", testCase{
+			template: `
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - resources:
+      requests:
+        (quantity_cmp(memory, 'lots')): 0
+`,
+			expectedErr: `quantity_cmp: invalid quantity "lots"`,
+		}),
+
+		Entry("missing field", testCase{
+			template: `
+apiVersion: v1
+kind: Pod
+spec:
+  containers:
+  - resources:
+      requests:
+        (quantity_equal(storage, '1Gi')): true
+`,
+			expectedErr: "invalid type for",
+		}),
+	)
+})
+
+var _ = Describe("Custom Functions", func() {
+	shout := jpfunctions.FunctionEntry{
+		Name:      "shout",
+		Arguments: []jpfunctions.ArgSpec{{Types: []jpfunctions.JpType{jpfunctions.JpString}}},
+		Handler: func(arguments []any) (any, error) {
+			return strings.ToUpper(arguments[0].(string)), nil
+		},
+	}
+	broken := jpfunctions.FunctionEntry{
+		Name: "broken",
+		Handler: func(arguments []any) (any, error) {
+			return nil, errors.New("always fails")
+		},
+	}
+	celShout := gocel.Function("cel_shout",
+		gocel.Overload("cel_shout_string", []*gocel.Type{gocel.StringType}, gocel.StringType,
+			gocel.UnaryBinding(func(value ref.Val) ref.Val {
+				return types.String(strings.ToUpper(string(value.(types.String))))
+			}),
+		),
+	)
+
+	Describe("NewFunctions", func() {
+		type testCase struct {
+			jpFuncs     []jpfunctions.FunctionEntry
+			celFuncs    []gocel.EnvOption
+			expectedErr string
+		}
+
+		DescribeTable("validating custom functions",
+			func(tc testCase) {
+				funcs, err := chainsaw.NewFunctions(tc.jpFuncs, tc.celFuncs)
+				if tc.expectedErr != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(tc.expectedErr))
+					Expect(funcs).To(BeNil())
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(funcs).NotTo(BeNil())
+				}
+			},
+			Entry("no custom functions", testCase{}),
+			Entry("JMESPath and CEL functions", testCase{
+				jpFuncs:  []jpfunctions.FunctionEntry{shout, broken},
+				celFuncs: []gocel.EnvOption{celShout},
+			}),
+			Entry("unnamed JMESPath function", testCase{
+				jpFuncs:     []jpfunctions.FunctionEntry{{Handler: shout.Handler}},
+				expectedErr: "JMESPath function name is empty",
+			}),
+			Entry("JMESPath function without handler", testCase{
+				jpFuncs:     []jpfunctions.FunctionEntry{{Name: "shout"}},
+				expectedErr: "JMESPath function shout has no handler",
+			}),
+			Entry("JMESPath function defined twice", testCase{
+				jpFuncs:     []jpfunctions.FunctionEntry{shout, shout},
+				expectedErr: "JMESPath function shout is already defined",
+			}),
+			Entry("JMESPath function shadowing a built-in function", testCase{
+				jpFuncs:     []jpfunctions.FunctionEntry{{Name: "quantity_cmp", Handler: shout.Handler}},
+				expectedErr: "JMESPath function quantity_cmp is already defined",
+			}),
+			Entry("invalid CEL function", testCase{
+				celFuncs: []gocel.EnvOption{func(env *gocel.Env) (*gocel.Env, error) {
+					return nil, errors.New("bad option")
+				}},
+				expectedErr: "invalid CEL functions: bad option",
+			}),
+		)
+	})
+
+	Describe("rendering and matching", func() {
+		actual := unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]any{"name": "test-cm", "namespace": "default"},
+			"data":       map[string]any{"key": "VALUE"},
+		}}
+
+		type testCase struct {
+			template    string
+			noFuncs     bool
+			expectedErr string
+		}
+
+		DescribeTable("using custom functions in templates",
+			func(tc testCase) {
+				funcs, err := chainsaw.NewFunctions([]jpfunctions.FunctionEntry{shout, broken}, []gocel.EnvOption{celShout})
+				Expect(err).NotTo(HaveOccurred())
+				if tc.noFuncs {
+					funcs = nil
+				}
+				bindings, err := chainsaw.BindingsFromMap(nil)
+				Expect(err).NotTo(HaveOccurred())
+
+				expectedObjs, err := chainsaw.RenderTemplate(ctx, tc.template, bindings, funcs)
+				if err == nil {
+					_, err = chainsaw.Match(ctx, []unstructured.Unstructured{actual}, expectedObjs[0], bindings, funcs, nil)
+				}
+				if tc.expectedErr != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(tc.expectedErr))
+					return
+				}
+				Expect(err).NotTo(HaveOccurred())
+			},
+
+			// Success cases
+			Entry("JMESPath function in rendered field", testCase{
+				template: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+data:
+  key: (shout('value'))
+`,
+			}),
+			Entry("JMESPath function in assertion", testCase{
+				template: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+data:
+  (shout('value') == key): true
+`,
+			}),
+			Entry("CEL function in rendered field", testCase{
+				template: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+data:
+  key: (cel;cel_shout('value'))
+`,
+			}),
+
+			// Error cases
+			Entry("unknown function names the function and document", testCase{
+				template: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+data:
+  key: (shout('value'))
+`,
+				noFuncs:     true,
+				expectedErr: "failed to render template document (v1/ConfigMap/test-cm): data.key: Internal error: unknown function: shout",
+			}),
+			Entry("unknown function in multi-document template names the document", testCase{
+				template: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  name: test-secret
+data:
+  key: (whisper('value'))
+`,
+				expectedErr: "failed to render template document 2 of 2 (v1/Secret/test-secret): data.key: Internal error: unknown function: whisper",
+			}),
+			Entry("function with invalid argument type names the function", testCase{
+				template: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+data:
+  key: (shout(` + "`1`" + `))
+`,
+				expectedErr: "data.key: Internal error: shout: invalid type for: 1",
+			}),
+			Entry("failing function in assertion names the function and document", testCase{
+				template: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+data:
+  (broken()): true
+`,
+				expectedErr: "failed to check candidate v1/ConfigMap/default/test-cm against template document (v1/ConfigMap/test-cm): " +
+					"data.(broken()): Internal error: broken: always fails",
+			}),
+			Entry("unknown CEL function", testCase{
+				template: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+data:
+  key: (cel;cel_whisper('value'))
+`,
+				expectedErr: "undeclared reference to 'cel_whisper'",
+			}),
+			Entry("mismatch renders diff with custom functions", testCase{
+				template: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-cm
+data:
+  key: (shout('other'))
+`,
+				expectedErr: "data.key: Invalid value: \"VALUE\": Expected value: \"OTHER\"",
+			}),
+		)
+
+		It("evaluates custom functions in failure diffs", func() {
+			funcs, err := chainsaw.NewFunctions([]jpfunctions.FunctionEntry{shout}, nil)
+			Expect(err).NotTo(HaveOccurred())
+			bindings, err := chainsaw.BindingsFromMap(map[string]any{"expected": "other"})
+			Expect(err).NotTo(HaveOccurred())
+			expected := unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"data":       map[string]any{"(shout(key))": "OTHER"},
+			}}
+
+			_, err = chainsaw.Match(ctx, []unstructured.Unstructured{actual}, expected, bindings, funcs, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("data.(shout(key)): Invalid value: \"VALUE\": Expected value: \"OTHER\""))
+			Expect(err.Error()).NotTo(ContainSubstring("failed to compute expected template"))
+		})
+	})
+})
@@ -0,0 +1,134 @@
+package chainsaw
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/guidewire-oss/sawchain/internal/util"
+)
+
+// errUnexpectedField is the detail of field errors reported by strict matching.
+const errUnexpectedField = "field is not present in expectation"
+
+// Strictness configures strict matching: once a candidate passes the Chainsaw check, any
+// field present in the candidate but absent in the expectation makes it a mismatch.
+// A nil *Strictness disables strict matching.
+type Strictness struct {
+	// IgnorePaths are field paths (e.g. "metadata.managedFields", "spec.containers[*].image")
+	// that are never reported, along with all fields beneath them. A "*" segment matches any
+	// key or index.
+	IgnorePaths []string
+}
+
+// UnexpectedFields returns a field error for every field present in actual but absent in
+// expected, skipping fields under the given ignore paths. Null values and empty maps in actual
+// are treated as absent. Maps in expected containing JMESPath keys (e.g. "(length(data))" or
+// "~.(items)") cannot be resolved to fields, so their unmatched fields are not reported. Lists
+// are compared element by element.
+func UnexpectedFields(actual, expected map[string]any, ignorePaths []string) (field.ErrorList, error) {
+	var ignored [][]string
+	for _, path := range ignorePaths {
+		segments, err := util.ParseFieldPath(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ignore path: %w", err)
+		}
+		ignored = append(ignored, segments)
+	}
+	return unexpectedFields(actual, expected, nil, nil, ignored), nil
+}
+
+// unexpectedFields walks actual alongside expected, tracking the field path and its segments.
+func unexpectedFields(actual, expected any, path *field.Path, segments []string, ignored [][]string) field.ErrorList {
+	if isIgnored(segments, ignored) {
+		return nil
+	}
+	var errs field.ErrorList
+	switch actualValue := actual.(type) {
+	case map[string]any:
+		expectedValue, ok := expected.(map[string]any)
+		if !ok {
+			return nil
+		}
+		open := false
+		for key := range expectedValue {
+			if isExpressionKey(key) {
+				open = true
+				break
+			}
+		}
+		keys := make([]string, 0, len(actualValue))
+		for key := range actualValue {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			childPath := ChildFieldPath(path, key)
+			childSegments := append(append([]string{}, segments...), key)
+			if expectedChild, ok := expectedValue[key]; ok {
+				errs = append(errs, unexpectedFields(actualValue[key], expectedChild, childPath, childSegments, ignored)...)
+			} else if !open && !isAbsent(actualValue[key]) && !isIgnored(childSegments, ignored) {
+				errs = append(errs, field.Forbidden(childPath, errUnexpectedField))
+			}
+		}
+	case []any:
+		expectedValue, ok := expected.([]any)
+		if !ok || len(expectedValue) != len(actualValue) {
+			return nil
+		}
+		for i := range actualValue {
+			childSegments := append(append([]string{}, segments...), strconv.Itoa(i))
+			errs = append(errs, unexpectedFields(actualValue[i], expectedValue[i], path.Index(i), childSegments, ignored)...)
+		}
+	}
+	return errs
+}
+
+// ChildFieldPath returns the path of the given key under path, bracketing keys containing dots
+// (e.g. annotation names) so that the rendered path stays unambiguous.
+func ChildFieldPath(path *field.Path, key string) *field.Path {
+	if path == nil {
+		return field.NewPath(key)
+	}
+	if strings.Contains(key, ".") {
+		return path.Key(key)
+	}
+	return path.Child(key)
+}
+
+// isExpressionKey reports whether the expectation key is a JMESPath projection or iteration.
+func isExpressionKey(key string) bool {
+	return strings.HasPrefix(key, "(") || strings.HasPrefix(key, "~")
+}
+
+// isAbsent reports whether the value carries no data (null or an empty map).
+func isAbsent(value any) bool {
+	if value == nil {
+		return true
+	}
+	m, ok := value.(map[string]any)
+	return ok && len(m) == 0
+}
+
+// isIgnored reports whether any ignore path is a prefix of the given segments.
+func isIgnored(segments []string, ignored [][]string) bool {
+	for _, prefix := range ignored {
+		if len(prefix) > len(segments) {
+			continue
+		}
+		matched := true
+		for i, segment := range prefix {
+			if segment != "*" && segment != segments[i] {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
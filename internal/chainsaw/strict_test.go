@@ -0,0 +1,114 @@
+package chainsaw_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+
+	"github.com/guidewire-oss/sawchain/internal/chainsaw"
+)
+
+var _ = Describe("UnexpectedFields", func() {
+	type testCase struct {
+		actual         string
+		expected       string
+		ignorePaths    []string
+		expectedFields []string
+		expectedErr    string
+	}
+
+	DescribeTable("finding fields absent in expectations",
+		func(tc testCase) {
+			var actual, expected map[string]any
+			Expect(yaml.Unmarshal([]byte(tc.actual), &actual)).To(Succeed())
+			Expect(yaml.Unmarshal([]byte(tc.expected), &expected)).To(Succeed())
+
+			fieldErrs, err := chainsaw.UnexpectedFields(actual, expected, tc.ignorePaths)
+			if tc.expectedErr != "" {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(tc.expectedErr))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			var fields []string
+			for _, fieldErr := range fieldErrs {
+				Expect(fieldErr.Error()).To(HaveSuffix("Forbidden: field is not present in expectation"))
+				fields = append(fields, fieldErr.Field)
+			}
+			Expect(fields).To(Equal(tc.expectedFields))
+		},
+		Entry("no unexpected fields", testCase{
+			actual:   "data: {key1: value1}",
+			expected: "data: {key1: value1}",
+		}),
+		Entry("unexpected fields are reported in sorted order", testCase{
+			actual:         "data: {key1: value1, key3: value3, key2: value2}\nimmutable: true",
+			expected:       "data: {key1: value1}",
+			expectedFields: []string{"data.key2", "data.key3", "immutable"},
+		}),
+		Entry("unexpected maps are reported once", testCase{
+			actual:         "metadata: {name: test, labels: {a: b, c: d}}",
+			expected:       "metadata: {name: test}",
+			expectedFields: []string{"metadata.labels"},
+		}),
+		Entry("null values and empty maps are treated as absent", testCase{
+			actual:   "metadata: {name: test, creationTimestamp: null}\nstatus: {}",
+			expected: "metadata: {name: test}",
+		}),
+		Entry("lists are compared element by element", testCase{
+			actual:         "spec: {containers: [{name: a, image: x}, {name: b, image: y}]}",
+			expected:       "spec: {containers: [{name: a, image: x}, {name: b}]}",
+			expectedFields: []string{"spec.containers[1].image"},
+		}),
+		Entry("keys containing dots are bracketed", testCase{
+			actual:         "metadata: {annotations: {example.com/key: value}}",
+			expected:       "metadata: {annotations: {}}",
+			expectedFields: []string{"metadata.annotations[example.com/key]"},
+		}),
+		Entry("maps with expression keys are not reported", testCase{
+			actual:   "data: {key1: value1, key2: value2}",
+			expected: "data: {(length(@)): 2}",
+		}),
+		Entry("expression values are treated as leaves", testCase{
+			actual:   "data: {key1: value1}",
+			expected: "data: (length(@) > `0`)",
+		}),
+		Entry("ignore paths skip fields and everything beneath them", testCase{
+			actual:         "metadata: {name: test, resourceVersion: '1', managedFields: [{manager: x}]}\nstatus: {phase: Running}",
+			expected:       "metadata: {name: test}",
+			ignorePaths:    []string{"metadata.managedFields", "status"},
+			expectedFields: []string{"metadata.resourceVersion"},
+		}),
+		Entry("ignore paths apply beneath expected fields", testCase{
+			actual:      "spec: {containers: [{name: a, imagePullPolicy: Always}, {name: b, imagePullPolicy: Never}]}",
+			expected:    "spec: {containers: [{name: a}, {name: b}]}",
+			ignorePaths: []string{"spec.containers[*].imagePullPolicy"},
+		}),
+		Entry("ignore paths match bracketed keys", testCase{
+			actual:      "metadata: {annotations: {example.com/key: value}}",
+			expected:    "metadata: {annotations: {}}",
+			ignorePaths: []string{"metadata.annotations[example.com/key]"},
+		}),
+		Entry("invalid ignore paths fail", testCase{
+			actual:      "data: {key1: value1}",
+			expected:    "data: {key1: value1}",
+			ignorePaths: []string{"data[key1"},
+			expectedErr: "invalid ignore path: field path \"data[key1\" has an unclosed bracket",
+		}),
+	)
+})
+
+var _ = Describe("ChildFieldPath", func() {
+	DescribeTable("building child field paths",
+		func(path *field.Path, key, expected string) {
+			Expect(chainsaw.ChildFieldPath(path, key).String()).To(Equal(expected))
+		},
+		Entry("root key", nil, "metadata", "metadata"),
+		Entry("nested key", field.NewPath("metadata"), "name", "metadata.name"),
+		Entry("root key with dots", nil, "example.com/key", "example.com/key"),
+		Entry("nested key with dots", field.NewPath("metadata", "annotations"), "example.com/key",
+			"metadata.annotations[example.com/key]"),
+	)
+})
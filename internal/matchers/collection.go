@@ -0,0 +1,351 @@
+package matchers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/chainsaw"
+	"github.com/guidewire-oss/sawchain/internal/options"
+	"github.com/guidewire-oss/sawchain/internal/util"
+)
+
+// collectionMode selects the semantics of a collectionMatcher.
+type collectionMode int
+
+const (
+	// modeHaveEach requires every element to match at least one document.
+	modeHaveEach collectionMode = iota
+	// modeContainElement requires at least one element to match at least one document.
+	modeContainElement
+	// modeConsistOf requires elements and documents to pair up one-to-one, in any order.
+	modeConsistOf
+)
+
+// collectionMatcher is a Gomega matcher that checks a slice of client.Objects against
+// the documents of a Chainsaw template. Every element is matched against every document,
+// and failures report the best match attempt for each element or document at fault.
+type collectionMatcher struct {
+	// K8s client used for type conversions.
+	c client.Client
+	// Template content.
+	templateContent string
+	// Template bindings.
+	bindings chainsaw.Bindings
+	// Custom template functions (nil provides built-ins only).
+	funcs *chainsaw.Functions
+	// Matching options (nil uses default matching).
+	matchOpts *chainsaw.MatchOptions
+	// Verbosity level for error output.
+	verbosity options.Verbosity
+	// Matching semantics.
+	mode collectionMode
+	// Elements of the current actual value.
+	elements []unstructured.Unstructured
+	// Current expectation documents.
+	documents []unstructured.Unstructured
+	// Current match attempts, indexed by element then document (nil means matched).
+	attempts [][]*chainsaw.MatchAttempt
+	// Current pairing for modeConsistOf: the element index paired with each document (-1 if unpaired).
+	pairing []int
+}
+
+func (m *collectionMatcher) Match(actual any) (bool, error) {
+	// Convert actual to unstructured elements
+	if util.IsNil(actual) {
+		return false, errors.New("actual must be a slice of client.Object, not nil")
+	}
+	objs, ok := util.AsSliceOfObjects(actual)
+	if !ok {
+		return false, fmt.Errorf("actual must be a slice of client.Object, not %T", actual)
+	}
+	if util.ContainsNil(objs) {
+		return false, errors.New("actual must not contain nil elements")
+	}
+	m.elements = make([]unstructured.Unstructured, len(objs))
+	for i, obj := range objs {
+		element, err := util.UnstructuredFromObject(m.c, obj)
+		if err != nil {
+			return false, err
+		}
+		m.elements[i] = element
+	}
+
+	// Render expectation documents
+	documents, err := chainsaw.RenderTemplate(context.TODO(), m.templateContent, m.bindings, m.funcs)
+	if err != nil {
+		return false, err
+	}
+	if len(documents) == 0 {
+		return false, errors.New("template must contain at least one resource")
+	}
+	m.documents = documents
+
+	// Match every element against every document
+	m.attempts = make([][]*chainsaw.MatchAttempt, len(m.elements))
+	for i, element := range m.elements {
+		m.attempts[i] = make([]*chainsaw.MatchAttempt, len(m.documents))
+		for j, expected := range m.documents {
+			_, matchErr := chainsaw.Match(
+				context.TODO(), []unstructured.Unstructured{element}, expected, m.bindings, m.funcs, m.matchOpts,
+			)
+			if matchErr == nil {
+				continue
+			}
+			var me *chainsaw.MatchError
+			if !errors.As(matchErr, &me) {
+				// Genuine evaluation error (e.g. invalid assertion expression)
+				return false, matchErr
+			}
+			m.attempts[i][j] = &me.Attempts[0]
+		}
+	}
+
+	switch m.mode {
+	case modeHaveEach:
+		if len(m.elements) == 0 {
+			return false, errors.New("actual must contain at least one element")
+		}
+		return len(m.unmatchedElements()) == 0, nil
+	case modeContainElement:
+		return len(m.unmatchedElements()) < len(m.elements), nil
+	default:
+		m.pairing = m.pair()
+		unpairedElements, unpairedDocuments := m.unpaired()
+		return len(unpairedElements) == 0 && len(unpairedDocuments) == 0, nil
+	}
+}
+
+func (m *collectionMatcher) String() string {
+	return "\n" + chainsaw.ContextSection(m.templateContent, m.bindings) + "\n"
+}
+
+func (m *collectionMatcher) FailureMessage(actual any) string {
+	var sections []string
+	switch m.mode {
+	case modeHaveEach:
+		unmatched := m.unmatchedElements()
+		sections = append(sections, fmt.Sprintf(
+			"Expected each element to match Chainsaw template; %d of %d elements did not match",
+			len(unmatched), len(m.elements)))
+		for _, i := range unmatched {
+			sections = append(sections, m.elementSection(i, m.allDocuments()))
+		}
+	case modeContainElement:
+		sections = append(sections, fmt.Sprintf(
+			"Expected some element to match Chainsaw template; 0 of %d elements matched", len(m.elements)))
+		for i := range m.elements {
+			sections = append(sections, m.elementSection(i, m.allDocuments()))
+		}
+	default:
+		unpairedElements, unpairedDocuments := m.unpaired()
+		sections = append(sections, fmt.Sprintf(
+			"Expected elements to pair one-to-one with documents in Chainsaw template; "+
+				"%d of %d elements and %d of %d documents were left unpaired",
+			len(unpairedElements), len(m.elements), len(unpairedDocuments), len(m.documents)))
+		for _, j := range unpairedDocuments {
+			sections = append(sections, m.documentSection(j, unpairedElements))
+		}
+		for _, i := range unpairedElements {
+			sections = append(sections, m.elementSection(i, unpairedDocuments))
+		}
+	}
+
+	// Global context, shown once (verbose only)
+	if m.verbosity >= options.VerbosityVerbose {
+		sections = append(sections, chainsaw.ContextSection(m.templateContent, m.bindings))
+	}
+
+	return strings.Join(sections, "\n\n")
+}
+
+func (m *collectionMatcher) NegatedFailureMessage(actual any) string {
+	switch m.mode {
+	case modeHaveEach:
+		return "Expected some element not to match Chainsaw template"
+	case modeContainElement:
+		var lines []string
+		for i := range m.elements {
+			if !m.isUnmatched(i) {
+				lines = append(lines, fmt.Sprintf("* Element #%d: %s", i+1, chainsaw.ResourceID(m.elements[i])))
+			}
+		}
+		return "Expected no element to match Chainsaw template\n\n[MATCHING ELEMENTS]\n" + strings.Join(lines, "\n")
+	default:
+		return "Expected elements not to pair one-to-one with documents in Chainsaw template"
+	}
+}
+
+// elementSection renders the best attempts of element i against the given documents.
+func (m *collectionMatcher) elementSection(i int, documents []int) string {
+	header := fmt.Sprintf("[ELEMENT #%d] %s", i+1, chainsaw.ResourceID(m.elements[i]))
+	var attempts []chainsaw.MatchAttempt
+	for _, j := range documents {
+		if a := m.attempts[i][j]; a != nil {
+			attempts = append(attempts, *a)
+		}
+	}
+	if len(attempts) == 0 {
+		return header + "\nno unpaired documents left to compare"
+	}
+	me := &chainsaw.MatchError{Attempts: attempts, Mode: chainsaw.MatchModeVaryExpected}
+	return header + "\n" + me.FormatDetail(m.verbosity, m.bindings)
+}
+
+// documentSection renders the best attempts of the given elements against document j.
+func (m *collectionMatcher) documentSection(j int, elements []int) string {
+	header := fmt.Sprintf("[DOCUMENT #%d] %s", j+1, chainsaw.ResourceID(m.documents[j]))
+	var attempts []chainsaw.MatchAttempt
+	for _, i := range elements {
+		if a := m.attempts[i][j]; a != nil {
+			attempts = append(attempts, *a)
+		}
+	}
+	if len(attempts) == 0 {
+		return header + "\nno unpaired elements left to compare"
+	}
+	me := &chainsaw.MatchError{Attempts: attempts, Mode: chainsaw.MatchModeVaryActual}
+	return header + "\n" + me.FormatDetail(m.verbosity, m.bindings)
+}
+
+// isUnmatched reports whether element i matches none of the documents.
+func (m *collectionMatcher) isUnmatched(i int) bool {
+	for j := range m.documents {
+		if m.attempts[i][j] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// unmatchedElements returns the indices of elements matching none of the documents.
+func (m *collectionMatcher) unmatchedElements() []int {
+	var unmatched []int
+	for i := range m.elements {
+		if m.isUnmatched(i) {
+			unmatched = append(unmatched, i)
+		}
+	}
+	return unmatched
+}
+
+// allDocuments returns the indices of all documents.
+func (m *collectionMatcher) allDocuments() []int {
+	documents := make([]int, len(m.documents))
+	for j := range m.documents {
+		documents[j] = j
+	}
+	return documents
+}
+
+// pair computes a maximum one-to-one pairing of elements to matching documents using
+// augmenting paths, returning the element index paired with each document (-1 if unpaired).
+func (m *collectionMatcher) pair() []int {
+	pairing := make([]int, len(m.documents))
+	for j := range pairing {
+		pairing[j] = -1
+	}
+	var augment func(i int, visited []bool) bool
+	augment = func(i int, visited []bool) bool {
+		for j := range m.documents {
+			if m.attempts[i][j] != nil || visited[j] {
+				continue
+			}
+			visited[j] = true
+			if pairing[j] < 0 || augment(pairing[j], visited) {
+				pairing[j] = i
+				return true
+			}
+		}
+		return false
+	}
+	for i := range m.elements {
+		augment(i, make([]bool, len(m.documents)))
+	}
+	return pairing
+}
+
+// unpaired returns the indices of elements and documents left unpaired by the current pairing.
+func (m *collectionMatcher) unpaired() (elements, documents []int) {
+	paired := make([]bool, len(m.elements))
+	for j, i := range m.pairing {
+		if i < 0 {
+			documents = append(documents, j)
+		} else {
+			paired[i] = true
+		}
+	}
+	for i := range m.elements {
+		if !paired[i] {
+			elements = append(elements, i)
+		}
+	}
+	return elements, documents
+}
+
+// newCollectionMatcher creates a new collectionMatcher with static template content.
+// See chainsaw.MatchOptions for the supported matching options.
+func newCollectionMatcher(
+	c client.Client,
+	templateContent string,
+	bindings chainsaw.Bindings,
+	funcs *chainsaw.Functions,
+	matchOpts *chainsaw.MatchOptions,
+	verbosity options.Verbosity,
+	mode collectionMode,
+) types.GomegaMatcher {
+	return &collectionMatcher{
+		c:               c,
+		templateContent: templateContent,
+		bindings:        bindings,
+		funcs:           funcs,
+		matchOpts:       matchOpts,
+		verbosity:       verbosity,
+		mode:            mode,
+	}
+}
+
+// NewHaveEachMatcher creates a new collectionMatcher that checks if every element
+// of a slice of client.Objects matches at least one document in a Chainsaw template.
+func NewHaveEachMatcher(
+	c client.Client,
+	templateContent string,
+	bindings chainsaw.Bindings,
+	funcs *chainsaw.Functions,
+	matchOpts *chainsaw.MatchOptions,
+	verbosity options.Verbosity,
+) types.GomegaMatcher {
+	return newCollectionMatcher(c, templateContent, bindings, funcs, matchOpts, verbosity, modeHaveEach)
+}
+
+// NewContainElementMatcher creates a new collectionMatcher that checks if at least one
+// element of a slice of client.Objects matches at least one document in a Chainsaw template.
+func NewContainElementMatcher(
+	c client.Client,
+	templateContent string,
+	bindings chainsaw.Bindings,
+	funcs *chainsaw.Functions,
+	matchOpts *chainsaw.MatchOptions,
+	verbosity options.Verbosity,
+) types.GomegaMatcher {
+	return newCollectionMatcher(c, templateContent, bindings, funcs, matchOpts, verbosity, modeContainElement)
+}
+
+// NewConsistOfMatcher creates a new collectionMatcher that checks if the elements of a
+// slice of client.Objects pair up one-to-one, in any order, with the documents in a
+// Chainsaw template.
+func NewConsistOfMatcher(
+	c client.Client,
+	templateContent string,
+	bindings chainsaw.Bindings,
+	funcs *chainsaw.Functions,
+	matchOpts *chainsaw.MatchOptions,
+	verbosity options.Verbosity,
+) types.GomegaMatcher {
+	return newCollectionMatcher(c, templateContent, bindings, funcs, matchOpts, verbosity, modeConsistOf)
+}
@@ -0,0 +1,325 @@
+package matchers_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/chainsaw"
+	"github.com/guidewire-oss/sawchain/internal/matchers"
+	"github.com/guidewire-oss/sawchain/internal/options"
+	"github.com/guidewire-oss/sawchain/internal/testutil"
+)
+
+var _ = Describe("Collection Matchers", func() {
+	type newMatcherFunc func(client.Client, string, chainsaw.Bindings, *chainsaw.Functions, *chainsaw.MatchOptions, options.Verbosity) types.GomegaMatcher
+
+	type testCase struct {
+		newMatcher          newMatcherFunc
+		actual              any
+		templateContent     string
+		matchOpts           *chainsaw.MatchOptions
+		verbosity           options.Verbosity
+		shouldMatch         bool
+		expectedInternalErr string
+		expectedMatchErrs   []string
+		excludedMatchErrs   []string
+		expectedNegatedErrs []string
+	}
+
+	haveEach := newMatcherFunc(matchers.NewHaveEachMatcher)
+	containElement := newMatcherFunc(matchers.NewContainElementMatcher)
+	consistOf := newMatcherFunc(matchers.NewConsistOfMatcher)
+
+	cm := func(name, value string) *corev1.ConfigMap {
+		return testutil.NewConfigMap(name, "default", map[string]string{"key": value})
+	}
+
+	const keyTemplate = `
+apiVersion: v1
+kind: ConfigMap
+data:
+  key: ($value)
+`
+
+	const twoDocumentTemplate = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+---
+apiVersion: v1
+kind: ConfigMap
+data:
+  key: val2
+`
+
+	DescribeTable("matching slices of objects against templates",
+		func(tc testCase) {
+			bindings, err := chainsaw.BindingsFromMap(map[string]any{"value": "val1"})
+			Expect(err).NotTo(HaveOccurred())
+			matcher := tc.newMatcher(standardClient, tc.templateContent, bindings, nil, tc.matchOpts, tc.verbosity)
+
+			// Test Match
+			match, err := matcher.Match(tc.actual)
+			Expect(match).To(Equal(tc.shouldMatch))
+			if tc.expectedInternalErr != "" {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(tc.expectedInternalErr))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			// Test FailureMessage
+			if !tc.shouldMatch {
+				failureMsg := matcher.FailureMessage(tc.actual)
+				for _, expectedErr := range tc.expectedMatchErrs {
+					Expect(failureMsg).To(ContainSubstring(expectedErr))
+				}
+				for _, excludedErr := range tc.excludedMatchErrs {
+					Expect(failureMsg).NotTo(ContainSubstring(excludedErr))
+				}
+			}
+
+			// Test NegatedFailureMessage
+			negatedFailureMsg := matcher.NegatedFailureMessage(tc.actual)
+			for _, expectedErr := range tc.expectedNegatedErrs {
+				Expect(negatedFailureMsg).To(ContainSubstring(expectedErr))
+			}
+		},
+
+		// HaveEach
+		Entry("HaveEach matches when every element matches", testCase{
+			newMatcher:          haveEach,
+			actual:              []client.Object{cm("cm1", "val1"), cm("cm2", "val1")},
+			templateContent:     keyTemplate,
+			shouldMatch:         true,
+			expectedNegatedErrs: []string{"Expected some element not to match Chainsaw template"},
+		}),
+
+		Entry("HaveEach matches typed slices with any document", testCase{
+			newMatcher:      haveEach,
+			actual:          []*corev1.ConfigMap{cm("cm1", "other"), cm("cm2", "val2")},
+			templateContent: twoDocumentTemplate,
+			shouldMatch:     true,
+		}),
+
+		Entry("HaveEach reports each unmatched element", testCase{
+			newMatcher:      haveEach,
+			actual:          []client.Object{cm("cm1", "val1"), cm("cm2", "val2"), cm("cm3", "val3")},
+			templateContent: keyTemplate,
+			verbosity:       options.VerbosityNormal,
+			shouldMatch:     false,
+			expectedMatchErrs: []string{
+				"Expected each element to match Chainsaw template; 2 of 3 elements did not match",
+				"[ELEMENT #2] v1/ConfigMap/default/cm2",
+				"data.key: Invalid value: \"val2\": Expected value: \"val1\"",
+				"[ELEMENT #3] v1/ConfigMap/default/cm3",
+				"data.key: Invalid value: \"val3\": Expected value: \"val1\"",
+			},
+			excludedMatchErrs: []string{"[ELEMENT #1]", "[TEMPLATE]"},
+		}),
+
+		Entry("HaveEach reports best document per element", testCase{
+			newMatcher:      haveEach,
+			actual:          []client.Object{cm("cm3", "val3")},
+			templateContent: twoDocumentTemplate,
+			verbosity:       options.VerbosityMinimal,
+			shouldMatch:     false,
+			expectedMatchErrs: []string{
+				"[ELEMENT #1] v1/ConfigMap/default/cm3",
+				"0 of 2 attempts matched expectation; best match: v1/ConfigMap/cm1 (1 field error)",
+				"[OTHER ATTEMPTS]",
+				"Attempt #2: v1/ConfigMap (1 field error)",
+			},
+		}),
+
+		Entry("HaveEach errors on empty slice", testCase{
+			newMatcher:          haveEach,
+			actual:              []client.Object{},
+			templateContent:     keyTemplate,
+			expectedInternalErr: "actual must contain at least one element",
+		}),
+
+		// ContainElement
+		Entry("ContainElement matches when some element matches", testCase{
+			newMatcher:      containElement,
+			actual:          []client.Object{cm("cm1", "val2"), cm("cm2", "val1")},
+			templateContent: keyTemplate,
+			shouldMatch:     true,
+			expectedNegatedErrs: []string{
+				"Expected no element to match Chainsaw template",
+				"[MATCHING ELEMENTS]",
+				"* Element #2: v1/ConfigMap/default/cm2",
+			},
+		}),
+
+		Entry("ContainElement reports every element", testCase{
+			newMatcher:      containElement,
+			actual:          []client.Object{cm("cm1", "val2"), cm("cm2", "val3")},
+			templateContent: keyTemplate,
+			verbosity:       options.VerbosityVerbose,
+			shouldMatch:     false,
+			expectedMatchErrs: []string{
+				"Expected some element to match Chainsaw template; 0 of 2 elements matched",
+				"[ELEMENT #1] v1/ConfigMap/default/cm1",
+				"[ELEMENT #2] v1/ConfigMap/default/cm2",
+				"[ACTUAL]",
+				"[TEMPLATE]",
+				"[BINDINGS]",
+			},
+		}),
+
+		Entry("ContainElement does not match empty slice", testCase{
+			newMatcher:        containElement,
+			actual:            []client.Object{},
+			templateContent:   keyTemplate,
+			shouldMatch:       false,
+			expectedMatchErrs: []string{"0 of 0 elements matched"},
+		}),
+
+		// ConsistOf
+		Entry("ConsistOf matches elements in any order", testCase{
+			newMatcher:          consistOf,
+			actual:              []client.Object{cm("cm2", "val2"), cm("cm1", "other")},
+			templateContent:     twoDocumentTemplate,
+			shouldMatch:         true,
+			expectedNegatedErrs: []string{"Expected elements not to pair one-to-one with documents in Chainsaw template"},
+		}),
+
+		Entry("ConsistOf finds pairing for overlapping documents", testCase{
+			// cm1 matches both documents; greedily pairing cm1 with document #1 would leave cm2 unpaired
+			newMatcher:      consistOf,
+			actual:          []client.Object{cm("cm1", "val2"), cm("cm2", "other")},
+			templateContent: "apiVersion: v1\nkind: ConfigMap\n---\napiVersion: v1\nkind: ConfigMap\ndata:\n  key: val2\n",
+			shouldMatch:     true,
+		}),
+
+		Entry("ConsistOf does not pair one element with two documents", testCase{
+			newMatcher:      consistOf,
+			actual:          []client.Object{cm("cm1", "val2"), cm("cm2", "other")},
+			templateContent: "apiVersion: v1\nkind: ConfigMap\ndata:\n  key: val2\n---\napiVersion: v1\nkind: ConfigMap\ndata:\n  key: val2\n",
+			shouldMatch:     false,
+			expectedMatchErrs: []string{
+				"1 of 2 elements and 1 of 2 documents were left unpaired",
+				"[DOCUMENT #2] v1/ConfigMap",
+				"[ELEMENT #2] v1/ConfigMap/default/cm2",
+				"data.key: Invalid value: \"other\": Expected value: \"val2\"",
+			},
+		}),
+
+		Entry("ConsistOf reports unpaired documents and elements", testCase{
+			newMatcher:      consistOf,
+			actual:          []client.Object{cm("cm1", "val1"), cm("cm3", "val3")},
+			templateContent: twoDocumentTemplate,
+			verbosity:       options.VerbosityNormal,
+			shouldMatch:     false,
+			expectedMatchErrs: []string{
+				"Expected elements to pair one-to-one with documents in Chainsaw template; " +
+					"1 of 2 elements and 1 of 2 documents were left unpaired",
+				"[DOCUMENT #2] v1/ConfigMap",
+				"[ELEMENT #2] v1/ConfigMap/default/cm3",
+				"data.key: Invalid value: \"val3\": Expected value: \"val2\"",
+			},
+			excludedMatchErrs: []string{"[DOCUMENT #1]", "[ELEMENT #1]"},
+		}),
+
+		Entry("ConsistOf reports extra elements", testCase{
+			newMatcher:      consistOf,
+			actual:          []client.Object{cm("cm1", "val1"), cm("cm2", "val2"), cm("cm3", "val2")},
+			templateContent: twoDocumentTemplate,
+			shouldMatch:     false,
+			expectedMatchErrs: []string{
+				"1 of 3 elements and 0 of 2 documents were left unpaired",
+				"[ELEMENT #3] v1/ConfigMap/default/cm3",
+				"no unpaired documents left to compare",
+			},
+		}),
+
+		Entry("ConsistOf reports missing elements", testCase{
+			newMatcher:      consistOf,
+			actual:          []client.Object{cm("cm1", "val1")},
+			templateContent: twoDocumentTemplate,
+			shouldMatch:     false,
+			expectedMatchErrs: []string{
+				"0 of 1 elements and 1 of 2 documents were left unpaired",
+				"[DOCUMENT #2] v1/ConfigMap",
+				"no unpaired elements left to compare",
+			},
+		}),
+
+		// Strict matching
+		Entry("HaveEach reports unexpected fields in strict mode", testCase{
+			newMatcher:      haveEach,
+			actual:          []client.Object{cm("cm1", "val1"), testutil.NewConfigMapWithLabels("cm2", "default", map[string]string{"extra": "label"}, map[string]string{"key": "val1"})},
+			templateContent: "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  namespace: default\ndata:\n  key: ($value)\n",
+			matchOpts:       &chainsaw.MatchOptions{Strict: &chainsaw.Strictness{IgnorePaths: []string{"metadata.name"}}},
+			shouldMatch:     false,
+			expectedMatchErrs: []string{
+				"1 of 2 elements did not match",
+				"[ELEMENT #2] v1/ConfigMap/default/cm2",
+				"metadata.labels: Forbidden: field is not present in expectation",
+			},
+		}),
+
+		// Error cases
+		Entry("error on nil input", testCase{
+			newMatcher:          consistOf,
+			actual:              nil,
+			templateContent:     keyTemplate,
+			expectedInternalErr: "actual must be a slice of client.Object, not nil",
+		}),
+
+		Entry("error on single object input", testCase{
+			newMatcher:          haveEach,
+			actual:              cm("cm1", "val1"),
+			templateContent:     keyTemplate,
+			expectedInternalErr: "actual must be a slice of client.Object, not *v1.ConfigMap",
+		}),
+
+		Entry("error on nil element", testCase{
+			newMatcher:          containElement,
+			actual:              []client.Object{cm("cm1", "val1"), nil},
+			templateContent:     keyTemplate,
+			expectedInternalErr: "actual must not contain nil elements",
+		}),
+
+		Entry("error on template with no resources", testCase{
+			newMatcher:          haveEach,
+			actual:              []client.Object{cm("cm1", "val1")},
+			templateContent:     "# only a comment, no resources\n",
+			expectedInternalErr: "template must contain at least one resource",
+		}),
+	)
+
+	Describe("String", func() {
+		It("should render template and bindings sections", func() {
+			bindings, err := chainsaw.BindingsFromMap(map[string]any{"value": "expected-value"})
+			Expect(err).NotTo(HaveOccurred())
+			matcher := matchers.NewHaveEachMatcher(standardClient, keyTemplate, bindings, nil, nil, options.VerbosityNormal)
+
+			str := matcher.(fmt.Stringer).String()
+			Expect(str).To(ContainSubstring("[TEMPLATE]"))
+			Expect(str).To(ContainSubstring("key: ($value)"))
+			Expect(str).To(ContainSubstring("[BINDINGS]"))
+			Expect(str).To(ContainSubstring("expected-value"))
+		})
+	})
+
+	It("should accept unstructured elements", func() {
+		bindings, err := chainsaw.BindingsFromMap(map[string]any{"value": "val1"})
+		Expect(err).NotTo(HaveOccurred())
+		matcher := matchers.NewConsistOfMatcher(standardClient, keyTemplate, bindings, nil, nil, options.VerbosityNormal)
+
+		match, err := matcher.Match([]*unstructured.Unstructured{
+			testutil.NewUnstructuredConfigMap("cm1", "default", map[string]string{"key": "val1"}),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(match).To(BeTrue())
+	})
+})
@@ -0,0 +1,93 @@
+package matchers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/chainsaw"
+	"github.com/guidewire-oss/sawchain/internal/options"
+	"github.com/guidewire-oss/sawchain/internal/util"
+)
+
+// eventMatcher is a Gomega matcher that checks if the cluster holds an Event about a client.Object
+// matching a Chainsaw template.
+type eventMatcher struct {
+	// K8s client used to list Events and resolve the actual object's type.
+	c client.Client
+	// Context used for listing Events.
+	ctx context.Context
+	// Template content for the expected Event.
+	templateContent string
+	// Template bindings.
+	bindings chainsaw.Bindings
+	// Custom template functions (nil provides built-ins only).
+	funcs *chainsaw.Functions
+	// Matching options (nil uses default matching).
+	matchOpts *chainsaw.MatchOptions
+	// Verbosity of failure messages.
+	verbosity options.Verbosity
+	// Matching Event found for the last actual object.
+	match unstructured.Unstructured
+	// Error returned by the last check.
+	checkErr error
+}
+
+func (m *eventMatcher) Match(actual any) (bool, error) {
+	if util.IsNil(actual) {
+		return false, errors.New("actual must be a client.Object, not nil")
+	}
+	obj, ok := util.AsObject(actual)
+	if !ok {
+		return false, fmt.Errorf("actual must be a client.Object, not %T", actual)
+	}
+	gvk, err := util.GetGroupVersionKind(obj, m.c.Scheme())
+	if err != nil {
+		return false, fmt.Errorf("failed to determine GroupVersionKind of actual: %w", err)
+	}
+	m.match, m.checkErr = chainsaw.CheckEvent(m.c, m.ctx, m.templateContent, m.bindings, m.funcs, obj, gvk, m.matchOpts)
+	return m.checkErr == nil, nil
+}
+
+func (m *eventMatcher) FailureMessage(actual any) string {
+	base := "Expected actual to have emitted an Event matching Chainsaw template"
+	if m.checkErr == nil {
+		return base
+	}
+	var matchErr *chainsaw.MatchError
+	if errors.As(m.checkErr, &matchErr) {
+		return base + "\n\n" + matchErr.Format(m.verbosity, m.templateContent, m.bindings)
+	}
+	return base + "\n\n" + m.checkErr.Error()
+}
+
+func (m *eventMatcher) NegatedFailureMessage(actual any) string {
+	return "Expected actual not to have emitted an Event matching Chainsaw template\n\n" +
+		"found " + chainsaw.ResourceID(m.match)
+}
+
+// NewEventMatcher creates a new eventMatcher with static template content.
+// See chainsaw.MatchOptions for the supported matching options.
+func NewEventMatcher(
+	c client.Client,
+	ctx context.Context,
+	templateContent string,
+	bindings chainsaw.Bindings,
+	funcs *chainsaw.Functions,
+	matchOpts *chainsaw.MatchOptions,
+	verbosity options.Verbosity,
+) types.GomegaMatcher {
+	return &eventMatcher{
+		c:               c,
+		ctx:             ctx,
+		templateContent: templateContent,
+		bindings:        bindings,
+		funcs:           funcs,
+		matchOpts:       matchOpts,
+		verbosity:       verbosity,
+	}
+}
@@ -0,0 +1,177 @@
+package matchers_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	eventsv1 "k8s.io/api/events/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/matchers"
+	"github.com/guidewire-oss/sawchain/internal/options"
+	"github.com/guidewire-oss/sawchain/internal/testutil"
+)
+
+var _ = Describe("Event Matcher", func() {
+	ctx := context.Background()
+
+	subject := testutil.NewConfigMap("test-cm", "default", nil)
+	subject.UID = "test-uid"
+
+	c := testutil.NewStandardFakeClient()
+	for _, event := range []client.Object{
+		&corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cm.failed", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Name:       "test-cm",
+				Namespace:  "default",
+				UID:        "test-uid",
+			},
+			Reason:  "SyncFailed",
+			Type:    corev1.EventTypeWarning,
+			Message: "failed to sync ConfigMap test-cm",
+		},
+		&eventsv1.Event{
+			ObjectMeta:          metav1.ObjectMeta{Name: "test-cm.synced", Namespace: "default"},
+			EventTime:           metav1.NowMicro(),
+			ReportingController: "example.com/controller",
+			ReportingInstance:   "controller-0",
+			Action:              "Sync",
+			Regarding:           corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "test-cm", Namespace: "default"},
+			Reason:              "Synced",
+			Type:                corev1.EventTypeNormal,
+			Note:                "ConfigMap test-cm synced",
+		},
+	} {
+		Expect(c.Create(ctx, event)).To(Succeed())
+	}
+
+	type testCase struct {
+		template            string
+		actual              any
+		shouldMatch         bool
+		expectedInternalErr string
+		expectedMatchErrs   []string
+		expectedNegatedErrs []string
+	}
+
+	DescribeTable("checking emitted Events",
+		func(tc testCase) {
+			matcher := matchers.NewEventMatcher(c, ctx, tc.template, nil, nil, nil, options.VerbosityNormal)
+
+			// Test Match
+			match, err := matcher.Match(tc.actual)
+			Expect(match).To(Equal(tc.shouldMatch))
+			if tc.expectedInternalErr != "" {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(tc.expectedInternalErr))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			// Test FailureMessage
+			if !tc.shouldMatch {
+				failureMsg := matcher.FailureMessage(tc.actual)
+				for _, expectedErr := range tc.expectedMatchErrs {
+					Expect(failureMsg).To(ContainSubstring(expectedErr))
+				}
+			}
+
+			// Test NegatedFailureMessage
+			negatedFailureMsg := matcher.NegatedFailureMessage(tc.actual)
+			for _, expectedErr := range tc.expectedNegatedErrs {
+				Expect(negatedFailureMsg).To(ContainSubstring(expectedErr))
+			}
+		},
+
+		// Success cases
+		Entry("core Event with matching reason and message", testCase{
+			template: `
+apiVersion: v1
+kind: Event
+reason: SyncFailed
+type: Warning
+(contains(message, 'failed')): true
+`,
+			actual:      subject,
+			shouldMatch: true,
+			expectedNegatedErrs: []string{
+				"Expected actual not to have emitted an Event matching Chainsaw template",
+				"found v1/Event/default/test-cm.failed",
+			},
+		}),
+
+		Entry("events.k8s.io Event referencing object without UID", testCase{
+			template: `
+apiVersion: events.k8s.io/v1
+kind: Event
+reason: Synced
+`,
+			actual:      subject,
+			shouldMatch: true,
+		}),
+
+		// Failure cases
+		Entry("no Event with matching reason", testCase{
+			template: `
+apiVersion: v1
+kind: Event
+reason: Synced
+`,
+			actual:      subject,
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				"Expected actual to have emitted an Event matching Chainsaw template",
+				`reason: Invalid value: "SyncFailed": Expected value: "Synced"`,
+			},
+		}),
+
+		Entry("no Events about the object", testCase{
+			template: `
+apiVersion: v1
+kind: Event
+`,
+			actual:      testutil.NewConfigMap("other-cm", "default", nil),
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				"no actual v1 Event found for v1/ConfigMap default/other-cm (1 candidate(s) about other objects)",
+			},
+		}),
+
+		Entry("template without Event type metadata", testCase{
+			template: `
+apiVersion: v1
+kind: ConfigMap
+`,
+			actual:      subject,
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				"expected template to define a v1 or events.k8s.io/v1 Event; found v1/ConfigMap",
+			},
+		}),
+
+		// Error cases
+		Entry("nil actual", testCase{
+			actual:              nil,
+			shouldMatch:         false,
+			expectedInternalErr: "actual must be a client.Object, not nil",
+		}),
+
+		Entry("invalid actual type", testCase{
+			actual:              "not an object",
+			shouldMatch:         false,
+			expectedInternalErr: "actual must be a client.Object, not string",
+		}),
+
+		Entry("unregistered typed object", testCase{
+			actual:              &testutil.TestResource{ObjectMeta: metav1.ObjectMeta{Name: "test-resource"}},
+			shouldMatch:         false,
+			expectedInternalErr: "failed to determine GroupVersionKind of actual",
+		}),
+	)
+})
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/onsi/gomega/types"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -21,7 +24,7 @@ const templateNotRendered = "# template not yet rendered"
 
 // chainsawMatcher is a Gomega matcher that checks if a client.Object matches
 // a Chainsaw template. Supports single-document matching and multi-document
-// matching with "match any document" semantics.
+// matching with "match any document" or "match all documents" semantics.
 type chainsawMatcher struct {
 	// K8s client used for type conversions.
 	c client.Client
@@ -31,9 +34,17 @@ type chainsawMatcher struct {
 	templateContent string
 	// Template bindings.
 	bindings chainsaw.Bindings
+	// Custom template functions (nil provides built-ins only).
+	funcs *chainsaw.Functions
 	// Verbosity level for error output.
 	verbosity options.Verbosity
-	// Current match error (attempts flattened across all documents).
+	// Matching options (nil uses default matching).
+	matchOpts *chainsaw.MatchOptions
+	// Whether every document must match ("match all documents" semantics).
+	allOf bool
+	// Number of documents in the current template content.
+	documents int
+	// Current match error (attempts flattened across all failing documents).
 	matchErr *chainsaw.MatchError
 }
 
@@ -58,7 +69,7 @@ func (m *chainsawMatcher) Match(actual any) (bool, error) {
 	}
 	m.templateContent = templateContent
 	expectedObjs, err := chainsaw.RenderTemplate(
-		context.TODO(), m.templateContent, m.bindings,
+		context.TODO(), m.templateContent, m.bindings, m.funcs,
 	)
 	if err != nil {
 		return false, err
@@ -67,14 +78,19 @@ func (m *chainsawMatcher) Match(actual any) (bool, error) {
 		return false, errors.New("template must contain at least one resource")
 	}
 
-	// Try matching against each expectation document, collecting one attempt per document
+	// Try matching against each expectation document, collecting one attempt per failing document
 	m.matchErr = nil
+	m.documents = len(expectedObjs)
 	var attempts []chainsaw.MatchAttempt
 	for _, expected := range expectedObjs {
 		_, matchErr := chainsaw.Match(
-			context.TODO(), []unstructured.Unstructured{candidate}, expected, m.bindings,
+			context.TODO(), []unstructured.Unstructured{candidate}, expected, m.bindings, m.funcs, m.matchOpts,
 		)
 		if matchErr == nil {
+			if m.allOf {
+				// Document matched; the rest must match too
+				continue
+			}
 			// Match found
 			return true, nil
 		}
@@ -85,6 +101,10 @@ func (m *chainsawMatcher) Match(actual any) (bool, error) {
 		}
 		attempts = append(attempts, me.Attempts...)
 	}
+	if len(attempts) == 0 {
+		// Every document matched
+		return true, nil
+	}
 	m.matchErr = &chainsaw.MatchError{Attempts: attempts, Mode: chainsaw.MatchModeVaryExpected}
 	return false, nil
 }
@@ -96,6 +116,10 @@ func (m *chainsawMatcher) String() string {
 // failureMessage renders the matcher failure message, delegating detail to
 // MatchError.Format and prepending a negation-aware header line.
 func (m *chainsawMatcher) failureMessage(negated bool) string {
+	if m.allOf {
+		return m.allOfFailureMessage(negated)
+	}
+
 	multi := m.matchErr != nil && len(m.matchErr.Attempts) > 1
 
 	var base string
@@ -117,6 +141,22 @@ func (m *chainsawMatcher) failureMessage(negated bool) string {
 	return base + "\n\n" + m.matchErr.Format(m.verbosity, m.templateContent, m.bindings)
 }
 
+// allOfFailureMessage renders the failure message for "match all documents" semantics,
+// detailing every failing document via MatchError.FormatEach.
+func (m *chainsawMatcher) allOfFailureMessage(negated bool) string {
+	if negated {
+		return "Expected actual not to match all documents in Chainsaw template"
+	}
+
+	if m.matchErr == nil || len(m.matchErr.Attempts) == 0 {
+		// Safety: should not happen, but handle gracefully
+		return "Expected actual to match all documents in Chainsaw template\n\n(no match details recorded)"
+	}
+	base := fmt.Sprintf("Expected actual to match all documents in Chainsaw template; %d of %d documents did not match",
+		len(m.matchErr.Attempts), m.documents)
+	return base + "\n\n" + m.matchErr.FormatEach(m.verbosity, m.templateContent, m.bindings)
+}
+
 func (m *chainsawMatcher) FailureMessage(actual any) string {
 	return m.failureMessage(false)
 }
@@ -126,10 +166,13 @@ func (m *chainsawMatcher) NegatedFailureMessage(actual any) string {
 }
 
 // NewChainsawMatcher creates a new chainsawMatcher with static template content.
+// See chainsaw.MatchOptions for the supported matching options.
 func NewChainsawMatcher(
 	c client.Client,
 	templateContent string,
 	bindings chainsaw.Bindings,
+	funcs *chainsaw.Functions,
+	matchOpts *chainsaw.MatchOptions,
 	verbosity options.Verbosity,
 ) types.GomegaMatcher {
 	return &chainsawMatcher{
@@ -139,27 +182,73 @@ func NewChainsawMatcher(
 		},
 		templateContent: templateNotRendered,
 		bindings:        bindings,
+		funcs:           funcs,
+		matchOpts:       matchOpts,
 		verbosity:       verbosity,
 	}
 }
 
+// NewChainsawAllMatcher creates a new chainsawMatcher with static template content
+// and "match all documents" semantics.
+func NewChainsawAllMatcher(
+	c client.Client,
+	templateContent string,
+	bindings chainsaw.Bindings,
+	funcs *chainsaw.Functions,
+	matchOpts *chainsaw.MatchOptions,
+	verbosity options.Verbosity,
+) types.GomegaMatcher {
+	return &chainsawMatcher{
+		c: c,
+		createTemplateContent: func(c client.Client, obj client.Object) (string, error) {
+			return templateContent, nil
+		},
+		templateContent: templateNotRendered,
+		bindings:        bindings,
+		funcs:           funcs,
+		matchOpts:       matchOpts,
+		verbosity:       verbosity,
+		allOf:           true,
+	}
+}
+
+// StatusCondition describes the expected state of a status condition. Type and Status are
+// required; the remaining criteria are only checked if set.
+type StatusCondition struct {
+	// Type of the condition (e.g. "Ready").
+	Type string
+	// Expected status of the condition ("True", "False", or "Unknown").
+	Status string
+	// Minimum observedGeneration of the condition (0 means not checked).
+	MinGeneration int64
+	// Expected reason of the condition.
+	Reason string
+	// Substring the condition's message must contain.
+	MessageSubstring string
+	// Regular expression (RE2 syntax) the condition's message must match.
+	MessagePattern string
+	// Time the condition's lastTransitionTime must not be before, compared at second precision.
+	TransitionedSince time.Time
+}
+
 // NewStatusConditionMatcher creates a new chainsawMatcher that checks
-// if resources have the expected status condition.
+// if resources have all of the expected status conditions.
 //
-// If minGeneration is greater than 0, the matcher additionally requires the matched condition's
-// observedGeneration to be at least minGeneration. This is expressed as a boolean assertion on the
-// matched condition (rather than a filter predicate) so that an insufficient or missing
+// If a condition's MinGeneration is greater than 0, the matcher additionally requires the matched
+// condition's observedGeneration to be at least MinGeneration. This is expressed as a boolean assertion
+// on the matched condition (rather than a filter predicate) so that an insufficient or missing
 // observedGeneration produces a failure that reports the comparison directly. A condition without
 // observedGeneration will not satisfy the check; there is no status-root observedGeneration fallback.
+// Message and transition time criteria are expressed as boolean assertions in the same way.
 func NewStatusConditionMatcher(
 	c client.Client,
-	conditionType string,
-	expectedStatus string,
-	minGeneration int64,
+	conditions []StatusCondition,
+	funcs *chainsaw.Functions,
 	verbosity options.Verbosity,
 ) types.GomegaMatcher {
 	return &chainsawMatcher{
 		c:               c,
+		funcs:           funcs,
 		verbosity:       verbosity,
 		templateContent: templateNotRendered,
 		createTemplateContent: func(c client.Client, obj client.Object) (string, error) {
@@ -174,19 +263,57 @@ func NewStatusConditionMatcher(
 			templateContent := fmt.Sprintf(`
 apiVersion: %s
 kind: %s
-status:
-  (conditions[?type == '%s']):
-  - status: '%s'`,
+status:`,
 				apiVersion,
 				kind,
-				conditionType,
-				expectedStatus,
 			)
-			// Optionally assert the matched condition's observedGeneration
-			if minGeneration > 0 {
-				templateContent += fmt.Sprintf("\n    (observedGeneration >= `%d`): true", minGeneration)
+			for _, condition := range conditions {
+				templateContent += statusConditionExpectation(condition)
 			}
 			return templateContent, nil
 		},
 	}
 }
+
+// statusConditionExpectation renders the template lines asserting a single status condition.
+func statusConditionExpectation(condition StatusCondition) string {
+	expectation := fmt.Sprintf(`
+  (conditions[?type == '%s']):
+  - status: '%s'`,
+		condition.Type,
+		condition.Status,
+	)
+	// Optionally assert the matched condition's observedGeneration
+	if condition.MinGeneration > 0 {
+		expectation += fmt.Sprintf("\n    (observedGeneration >= `%d`): true", condition.MinGeneration)
+	}
+	// Optionally assert the matched condition's reason, message, and lastTransitionTime
+	if condition.Reason != "" {
+		expectation += "\n    reason: " + strconv.Quote(condition.Reason)
+	}
+	if condition.MessageSubstring != "" {
+		expectation += booleanAssertion(fmt.Sprintf("contains(message || '', %s)",
+			jmespathString(condition.MessageSubstring)))
+	}
+	if condition.MessagePattern != "" {
+		expectation += booleanAssertion(fmt.Sprintf("regex_match(%s, message || '')",
+			jmespathString(condition.MessagePattern)))
+	}
+	if !condition.TransitionedSince.IsZero() {
+		after := condition.TransitionedSince.UTC().Truncate(time.Second).Format(time.RFC3339)
+		expectation += booleanAssertion(fmt.Sprintf("lastTransitionTime != null && !time_before(lastTransitionTime, %s)",
+			jmespathString(after)))
+	}
+	return expectation
+}
+
+// booleanAssertion renders a template line asserting that the JMESPath expression is true.
+// The key is double-quoted so that arbitrary expression content remains valid YAML.
+func booleanAssertion(expression string) string {
+	return "\n    " + strconv.Quote("("+expression+")") + ": true"
+}
+
+// jmespathString renders s as a JMESPath raw string literal.
+func jmespathString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `\'`) + "'"
+}
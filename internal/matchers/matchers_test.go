@@ -2,6 +2,7 @@ package matchers_test
 
 import (
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -22,6 +23,7 @@ var _ = Describe("Matchers", func() {
 				actual              any
 				templateContent     string
 				bindings            map[string]any
+				matchOpts           *chainsaw.MatchOptions
 				shouldMatch         bool
 				expectedInternalErr string
 				expectedMatchErrs   []string
@@ -31,7 +33,7 @@ var _ = Describe("Matchers", func() {
 				func(tc testCase) {
 					bindings, err := chainsaw.BindingsFromMap(tc.bindings)
 					Expect(err).NotTo(HaveOccurred())
-					matcher := matchers.NewChainsawMatcher(standardClient, tc.templateContent, bindings, options.VerbosityNormal)
+					matcher := matchers.NewChainsawMatcher(standardClient, tc.templateContent, bindings, nil, tc.matchOpts, options.VerbosityNormal)
 
 					// Test Match
 					match, err := matcher.Match(tc.actual)
@@ -510,6 +512,65 @@ data:
 					bindings:            map[string]any{},
 					expectedInternalErr: "failed to check candidate",
 				}),
+
+				Entry("strict match with typed object", testCase{
+					actual: testutil.NewConfigMap("test-config", "default", map[string]string{
+						"key1": "value1",
+					}),
+					templateContent: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test-config
+  namespace: default
+data:
+  key1: value1
+`,
+					matchOpts:   &chainsaw.MatchOptions{Strict: &chainsaw.Strictness{}},
+					shouldMatch: true,
+				}),
+
+				Entry("no strict match with unexpected fields", testCase{
+					actual: testutil.NewConfigMap("test-config", "default", map[string]string{
+						"key1": "value1",
+						"key2": "value2",
+					}),
+					templateContent: `
+apiVersion: v1
+kind: ConfigMap
+data:
+  key1: value1
+`,
+					matchOpts:   &chainsaw.MatchOptions{Strict: &chainsaw.Strictness{IgnorePaths: []string{"metadata"}}},
+					shouldMatch: false,
+					expectedMatchErrs: []string{
+						"data.key2: Forbidden: field is not present in expectation",
+					},
+				}),
+
+				Entry("no strict match with unexpected fields in any document", testCase{
+					actual: testutil.NewConfigMap("test-config", "default", map[string]string{
+						"key1": "value1",
+						"key2": "value2",
+					}),
+					templateContent: `
+apiVersion: v1
+kind: ConfigMap
+data:
+  key1: value1
+---
+apiVersion: v1
+kind: ConfigMap
+data:
+  key2: value2
+`,
+					matchOpts:   &chainsaw.MatchOptions{Strict: &chainsaw.Strictness{IgnorePaths: []string{"metadata"}}},
+					shouldMatch: false,
+					expectedMatchErrs: []string{
+						"0 of 2 attempts matched expectation",
+						"data.key2: Forbidden: field is not present in expectation",
+					},
+				}),
 			)
 		})
 
@@ -537,7 +598,7 @@ data:
 				func(tc verbosityTestCase) {
 					bindings, err := chainsaw.BindingsFromMap(map[string]any{})
 					Expect(err).NotTo(HaveOccurred())
-					matcher := matchers.NewChainsawMatcher(standardClient, mismatchTemplate, bindings, tc.verbosity)
+					matcher := matchers.NewChainsawMatcher(standardClient, mismatchTemplate, bindings, nil, nil, tc.verbosity)
 					match, err := matcher.Match(mismatchActual)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(match).To(BeFalse())
@@ -613,7 +674,7 @@ data:
 			It("should render a placeholder template before a match has been attempted", func() {
 				bindings, err := chainsaw.BindingsFromMap(map[string]any{"value": "expected-value"})
 				Expect(err).NotTo(HaveOccurred())
-				matcher := matchers.NewChainsawMatcher(standardClient, template, bindings, options.VerbosityNormal)
+				matcher := matchers.NewChainsawMatcher(standardClient, template, bindings, nil, nil, options.VerbosityNormal)
 
 				// String may be called before Match (e.g. when an empty slice is passed to a collection matcher).
 				str := matcher.(fmt.Stringer).String()
@@ -628,7 +689,7 @@ data:
 			It("should render template and bindings sections once a match has been attempted", func() {
 				bindings, err := chainsaw.BindingsFromMap(map[string]any{"value": "expected-value"})
 				Expect(err).NotTo(HaveOccurred())
-				matcher := matchers.NewChainsawMatcher(standardClient, template, bindings, options.VerbosityNormal)
+				matcher := matchers.NewChainsawMatcher(standardClient, template, bindings, nil, nil, options.VerbosityNormal)
 
 				// Match populates the matcher's template content used by String.
 				_, err = matcher.Match(testutil.NewConfigMap("test-config", "default", map[string]string{
@@ -645,6 +706,175 @@ data:
 		})
 	})
 
+	Describe("Chainsaw All Matcher", func() {
+		type testCase struct {
+			actual              any
+			templateContent     string
+			verbosity           options.Verbosity
+			shouldMatch         bool
+			expectedInternalErr string
+			expectedMatchErrs   []string
+			excludedMatchErrs   []string
+		}
+
+		DescribeTable("matching resources against every template document",
+			func(tc testCase) {
+				bindings, err := chainsaw.BindingsFromMap(map[string]any{"value": "val1"})
+				Expect(err).NotTo(HaveOccurred())
+				matcher := matchers.NewChainsawAllMatcher(standardClient, tc.templateContent, bindings, nil, nil, tc.verbosity)
+
+				// Test Match
+				match, err := matcher.Match(tc.actual)
+				Expect(match).To(Equal(tc.shouldMatch))
+				if tc.expectedInternalErr != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(tc.expectedInternalErr))
+					return
+				}
+				Expect(err).NotTo(HaveOccurred())
+
+				// Test FailureMessage and NegatedFailureMessage
+				if tc.shouldMatch {
+					Expect(matcher.NegatedFailureMessage(tc.actual)).
+						To(Equal("Expected actual not to match all documents in Chainsaw template"))
+					return
+				}
+				failureMsg := matcher.FailureMessage(tc.actual)
+				for _, expectedErr := range tc.expectedMatchErrs {
+					Expect(failureMsg).To(ContainSubstring(expectedErr))
+				}
+				for _, excludedErr := range tc.excludedMatchErrs {
+					Expect(failureMsg).NotTo(ContainSubstring(excludedErr))
+				}
+			},
+
+			// Success cases
+			Entry("match single document", testCase{
+				actual: testutil.NewConfigMap("cm1", "default", map[string]string{"key": "val1"}),
+				templateContent: `
+apiVersion: v1
+kind: ConfigMap
+data:
+  key: ($value)
+`,
+				shouldMatch: true,
+			}),
+
+			Entry("match every document", testCase{
+				actual: testutil.NewConfigMap("cm1", "default", map[string]string{"key": "val1", "other": "val2"}),
+				templateContent: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+---
+apiVersion: v1
+kind: ConfigMap
+data:
+  key: ($value)
+---
+apiVersion: v1
+kind: ConfigMap
+data:
+  (length(other) == length(key)): true
+`,
+				shouldMatch: true,
+			}),
+
+			// Failure cases
+			Entry("no match when one document fails", testCase{
+				actual: testutil.NewConfigMap("cm1", "default", map[string]string{"key": "val1"}),
+				templateContent: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm1
+---
+apiVersion: v1
+kind: ConfigMap
+data:
+  key: val2
+`,
+				verbosity:   options.VerbosityNormal,
+				shouldMatch: false,
+				expectedMatchErrs: []string{
+					"Expected actual to match all documents in Chainsaw template; 1 of 2 documents did not match",
+					"[ERROR]",
+					"* data.key: Invalid value: \"val1\": Expected value: \"val2\"",
+				},
+				excludedMatchErrs: []string{"[ERROR #1]", "best match", "[OTHER ATTEMPTS]"},
+			}),
+
+			Entry("no match details every failing document", testCase{
+				actual: testutil.NewConfigMap("cm1", "default", map[string]string{"key": "val1"}),
+				templateContent: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm2
+---
+apiVersion: v1
+kind: ConfigMap
+data:
+  key: ($value)
+---
+apiVersion: v1
+kind: ConfigMap
+data:
+  key: val3
+  other: val4
+`,
+				verbosity:   options.VerbosityMinimal,
+				shouldMatch: false,
+				expectedMatchErrs: []string{
+					"2 of 3 documents did not match",
+					"[ERROR #1]",
+					"* metadata.name: Invalid value: \"cm1\": Expected value: \"cm2\"",
+					"[ERROR #2]",
+					"* data.key: Invalid value: \"val1\": Expected value: \"val3\"",
+				},
+				excludedMatchErrs: []string{"[ERROR #3]", "best match", "[OTHER ATTEMPTS]", "[TEMPLATE]"},
+			}),
+
+			Entry("no match with verbose context", testCase{
+				actual: testutil.NewConfigMap("cm1", "default", map[string]string{"key": "val1"}),
+				templateContent: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: cm2
+---
+apiVersion: v1
+kind: ConfigMap
+data:
+  key: val2
+`,
+				verbosity:   options.VerbosityVerbose,
+				shouldMatch: false,
+				expectedMatchErrs: []string{
+					"2 of 2 documents did not match",
+					"[ACTUAL]",
+					"[EXPECTED #1]", "[ERROR #1]",
+					"[EXPECTED #2]", "[ERROR #2]",
+					"[TEMPLATE]", "[BINDINGS]",
+				},
+			}),
+
+			// Error cases
+			Entry("error on nil input", testCase{
+				actual:              nil,
+				templateContent:     "apiVersion: v1\nkind: ConfigMap",
+				expectedInternalErr: "actual must be a client.Object, not nil",
+			}),
+
+			Entry("error on template with no resources", testCase{
+				actual:              testutil.NewConfigMap("cm1", "default", nil),
+				templateContent:     "# empty",
+				expectedInternalErr: "template must contain at least one resource",
+			}),
+		)
+	})
+
 	Describe("Status Condition Matcher", func() {
 		Describe("Match", func() {
 			type testCase struct {
@@ -653,6 +883,11 @@ data:
 				conditionType       string
 				expectedStatus      string
 				minGeneration       int64
+				reason              string
+				messageSubstring    string
+				messagePattern      string
+				transitionedSince   time.Time
+				otherConditions     []matchers.StatusCondition
 				shouldMatch         bool
 				expectedInternalErr string
 				expectedMatchErrs   []string
@@ -660,8 +895,16 @@ data:
 
 			DescribeTable("matching resources against status conditions",
 				func(tc testCase) {
-					matcher := matchers.NewStatusConditionMatcher(
-						tc.client, tc.conditionType, tc.expectedStatus, tc.minGeneration, options.VerbosityNormal)
+					conditions := append([]matchers.StatusCondition{{
+						Type:              tc.conditionType,
+						Status:            tc.expectedStatus,
+						MinGeneration:     tc.minGeneration,
+						Reason:            tc.reason,
+						MessageSubstring:  tc.messageSubstring,
+						MessagePattern:    tc.messagePattern,
+						TransitionedSince: tc.transitionedSince,
+					}}, tc.otherConditions...)
+					matcher := matchers.NewStatusConditionMatcher(tc.client, conditions, nil, options.VerbosityNormal)
 
 					// Test Match
 					match, err := matcher.Match(tc.actual)
@@ -888,6 +1131,207 @@ data:
 					},
 				}),
 
+				// Reason, message, and transition time cases
+				Entry("reason and message match", testCase{
+					client: clientWithTestResource,
+					actual: testutil.NewTestResource("test-resource", "default",
+						metav1.Condition{
+							Type:    "Ready",
+							Status:  metav1.ConditionTrue,
+							Reason:  "MinimumReplicasAvailable",
+							Message: "Deployment 'web' has 3/3 replicas: ready.",
+						},
+					),
+					conditionType:    "Ready",
+					expectedStatus:   "True",
+					reason:           "MinimumReplicasAvailable",
+					messageSubstring: "'web' has 3/3 replicas: ready",
+					messagePattern:   `^Deployment '\w+' has \d+/\d+ replicas`,
+					shouldMatch:      true,
+				}),
+
+				Entry("no match with different reason", testCase{
+					client: clientWithTestResource,
+					actual: testutil.NewTestResource("test-resource", "default",
+						metav1.Condition{
+							Type:   "Ready",
+							Status: metav1.ConditionFalse,
+							Reason: "Progressing",
+						},
+					),
+					conditionType:  "Ready",
+					expectedStatus: "False",
+					reason:         "ReconcileError",
+					shouldMatch:    false,
+					expectedMatchErrs: []string{
+						"[ERROR]",
+						"* status.(conditions[?type == 'Ready'])[0].reason: Invalid value: \"Progressing\": Expected value: \"ReconcileError\"",
+					},
+				}),
+
+				Entry("no match with message missing substring", testCase{
+					client: clientWithTestResource,
+					actual: testutil.NewTestResource("test-resource", "default",
+						metav1.Condition{
+							Type:    "Ready",
+							Status:  metav1.ConditionFalse,
+							Message: "waiting for replicas",
+						},
+					),
+					conditionType:    "Ready",
+					expectedStatus:   "False",
+					messageSubstring: "image pull",
+					shouldMatch:      false,
+					expectedMatchErrs: []string{
+						"[ERROR]",
+						"* status.(conditions[?type == 'Ready'])[0].(contains(message || '', 'image pull')): Invalid value: false: Expected value: true",
+					},
+				}),
+
+				Entry("no match with message not matching pattern", testCase{
+					client: clientWithTestResource,
+					actual: testutil.NewTestResource("test-resource", "default",
+						metav1.Condition{
+							Type:    "Ready",
+							Status:  metav1.ConditionFalse,
+							Message: "waiting for replicas",
+						},
+					),
+					conditionType:  "Ready",
+					expectedStatus: "False",
+					messagePattern: "^failed: .*",
+					shouldMatch:    false,
+					expectedMatchErrs: []string{
+						"[ERROR]",
+						"* status.(conditions[?type == 'Ready'])[0].(regex_match('^failed: .*', message || '')): Invalid value: false: Expected value: true",
+					},
+				}),
+
+				Entry("no match with missing message", testCase{
+					client: clientWithTestResource,
+					actual: testutil.NewUnstructuredTestResource("test-resource", "default",
+						metav1.Condition{
+							Type:   "Ready",
+							Status: metav1.ConditionTrue,
+						},
+					),
+					conditionType:    "Ready",
+					expectedStatus:   "True",
+					messageSubstring: "ready",
+					shouldMatch:      false,
+					expectedMatchErrs: []string{
+						"(contains(message || '', 'ready')): Invalid value: false: Expected value: true",
+					},
+				}),
+
+				Entry("transition time match when after the given time", testCase{
+					client: clientWithTestResource,
+					actual: testutil.NewTestResource("test-resource", "default",
+						metav1.Condition{
+							Type:               "Ready",
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Date(2025, 1, 1, 12, 0, 1, 0, time.UTC)),
+						},
+					),
+					conditionType:     "Ready",
+					expectedStatus:    "True",
+					transitionedSince: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+					shouldMatch:       true,
+				}),
+
+				Entry("transition time match within the same second", testCase{
+					client: clientWithTestResource,
+					actual: testutil.NewTestResource("test-resource", "default",
+						metav1.Condition{
+							Type:               "Ready",
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)),
+						},
+					),
+					conditionType:     "Ready",
+					expectedStatus:    "True",
+					transitionedSince: time.Date(2025, 1, 1, 12, 0, 0, 500_000_000, time.UTC),
+					shouldMatch:       true,
+				}),
+
+				Entry("no transition time match when before the given time", testCase{
+					client: clientWithTestResource,
+					actual: testutil.NewTestResource("test-resource", "default",
+						metav1.Condition{
+							Type:               "Ready",
+							Status:             metav1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(time.Date(2025, 1, 1, 11, 59, 59, 0, time.UTC)),
+						},
+					),
+					conditionType:     "Ready",
+					expectedStatus:    "True",
+					transitionedSince: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+					shouldMatch:       false,
+					expectedMatchErrs: []string{
+						"[ERROR]",
+						"(lastTransitionTime != null && !time_before(lastTransitionTime, '2025-01-01T12:00:00Z')): Invalid value: false: Expected value: true",
+					},
+				}),
+
+				Entry("no transition time match when absent", testCase{
+					client: clientWithTestResource,
+					actual: testutil.NewTestResource("test-resource", "default",
+						metav1.Condition{
+							Type:   "Ready",
+							Status: metav1.ConditionTrue,
+						},
+					),
+					conditionType:     "Ready",
+					expectedStatus:    "True",
+					transitionedSince: time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+					shouldMatch:       false,
+					expectedMatchErrs: []string{
+						"Invalid value: false: Expected value: true",
+					},
+				}),
+
+				// Multiple condition cases
+				Entry("match with several expected conditions", testCase{
+					client: clientWithTestResource,
+					actual: testutil.NewTestResource("test-resource", "default",
+						metav1.Condition{
+							Type:   "Ready",
+							Status: metav1.ConditionTrue,
+						},
+						metav1.Condition{
+							Type:   "Synced",
+							Status: metav1.ConditionTrue,
+							Reason: "ReconcileSuccess",
+						},
+					),
+					conditionType:   "Ready",
+					expectedStatus:  "True",
+					otherConditions: []matchers.StatusCondition{{Type: "Synced", Status: "True", Reason: "ReconcileSuccess"}},
+					shouldMatch:     true,
+				}),
+
+				Entry("no match when one of several expected conditions differs", testCase{
+					client: clientWithTestResource,
+					actual: testutil.NewTestResource("test-resource", "default",
+						metav1.Condition{
+							Type:   "Ready",
+							Status: metav1.ConditionTrue,
+						},
+						metav1.Condition{
+							Type:   "Synced",
+							Status: metav1.ConditionFalse,
+						},
+					),
+					conditionType:   "Ready",
+					expectedStatus:  "True",
+					otherConditions: []matchers.StatusCondition{{Type: "Synced", Status: "True"}},
+					shouldMatch:     false,
+					expectedMatchErrs: []string{
+						"[ERROR]",
+						"* status.(conditions[?type == 'Synced'])[0].status: Invalid value: \"False\": Expected value: \"True\"",
+					},
+				}),
+
 				// Error cases
 				Entry("error on nil input", testCase{
 					client:              clientWithTestResource,
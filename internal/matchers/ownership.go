@@ -0,0 +1,130 @@
+package matchers
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/util"
+)
+
+// ownerMatcher is a Gomega matcher that checks if a client.Object has an owner reference
+// pointing at a specific owner, optionally as its controller.
+type ownerMatcher struct {
+	// The expected owner.
+	owner client.Object
+	// GroupVersionKind of the expected owner.
+	ownerGVK schema.GroupVersionKind
+	// Whether the owner reference must have controller=true.
+	controller bool
+	// Owner references of the last actual object.
+	refs []metav1.OwnerReference
+}
+
+func (m *ownerMatcher) Match(actual any) (bool, error) {
+	if util.IsNil(actual) {
+		return false, errors.New("actual must be a client.Object, not nil")
+	}
+	obj, ok := util.AsObject(actual)
+	if !ok {
+		return false, fmt.Errorf("actual must be a client.Object, not %T", actual)
+	}
+	m.refs = obj.GetOwnerReferences()
+	for _, ref := range m.refs {
+		if !util.ReferencesOwner(ref, m.owner, m.ownerGVK) {
+			continue
+		}
+		if !m.controller || (ref.Controller != nil && *ref.Controller) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *ownerMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("Expected actual to %s\n\n%s", m.description(), formatOwnerReferences(m.refs))
+}
+
+func (m *ownerMatcher) NegatedFailureMessage(actual any) string {
+	return fmt.Sprintf("Expected actual not to %s\n\n%s", m.description(), formatOwnerReferences(m.refs))
+}
+
+// description describes the expectation, e.g. "be controlled by apps/v1/Deployment/test (uid: 1234)".
+func (m *ownerMatcher) description() string {
+	verb := "have owner"
+	if m.controller {
+		verb = "be controlled by"
+	}
+	id := fmt.Sprintf("%s/%s %s", m.ownerGVK.GroupVersion().String(), m.ownerGVK.Kind, m.owner.GetName())
+	if uid := m.owner.GetUID(); uid != "" {
+		id += fmt.Sprintf(" (uid: %s)", uid)
+	}
+	return verb + " " + id
+}
+
+// formatOwnerReferences renders owner references as a bulleted list.
+func formatOwnerReferences(refs []metav1.OwnerReference) string {
+	if len(refs) == 0 {
+		return "actual has no ownerReferences"
+	}
+	lines := []string{"actual ownerReferences:"}
+	for _, ref := range refs {
+		controller := ref.Controller != nil && *ref.Controller
+		lines = append(lines, fmt.Sprintf("* %s/%s %s (uid: %s, controller: %t)",
+			ref.APIVersion, ref.Kind, ref.Name, ref.UID, controller))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// NewOwnerMatcher creates a new ownerMatcher. If controller is true, the matching owner
+// reference must also have controller=true.
+func NewOwnerMatcher(owner client.Object, ownerGVK schema.GroupVersionKind, controller bool) types.GomegaMatcher {
+	return &ownerMatcher{owner: owner, ownerGVK: ownerGVK, controller: controller}
+}
+
+// finalizerMatcher is a Gomega matcher that checks if a client.Object has a specific finalizer.
+type finalizerMatcher struct {
+	// The expected finalizer.
+	finalizer string
+	// Finalizers of the last actual object.
+	finalizers []string
+}
+
+func (m *finalizerMatcher) Match(actual any) (bool, error) {
+	if util.IsNil(actual) {
+		return false, errors.New("actual must be a client.Object, not nil")
+	}
+	obj, ok := util.AsObject(actual)
+	if !ok {
+		return false, fmt.Errorf("actual must be a client.Object, not %T", actual)
+	}
+	m.finalizers = obj.GetFinalizers()
+	return slices.Contains(m.finalizers, m.finalizer), nil
+}
+
+func (m *finalizerMatcher) FailureMessage(actual any) string {
+	return fmt.Sprintf("Expected actual to have finalizer %q\n\n%s", m.finalizer, m.formatFinalizers())
+}
+
+func (m *finalizerMatcher) NegatedFailureMessage(actual any) string {
+	return fmt.Sprintf("Expected actual not to have finalizer %q\n\n%s", m.finalizer, m.formatFinalizers())
+}
+
+// formatFinalizers renders the finalizers of the last actual object.
+func (m *finalizerMatcher) formatFinalizers() string {
+	if len(m.finalizers) == 0 {
+		return "actual has no finalizers"
+	}
+	return "actual finalizers: " + strings.Join(m.finalizers, ", ")
+}
+
+// NewFinalizerMatcher creates a new finalizerMatcher.
+func NewFinalizerMatcher(finalizer string) types.GomegaMatcher {
+	return &finalizerMatcher{finalizer: finalizer}
+}
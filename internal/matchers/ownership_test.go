@@ -0,0 +1,191 @@
+package matchers_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+
+	"github.com/guidewire-oss/sawchain/internal/matchers"
+	"github.com/guidewire-oss/sawchain/internal/testutil"
+)
+
+var _ = Describe("Ownership Matchers", func() {
+	owner := testutil.NewTestResource("test-owner", "default")
+	owner.UID = "owner-uid"
+	ownerGVK := schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "TestResource"}
+
+	ownedBy := func(refs ...metav1.OwnerReference) *corev1.ConfigMap {
+		cm := testutil.NewConfigMap("test-cm", "default", nil)
+		cm.OwnerReferences = refs
+		return cm
+	}
+	controllerRef := metav1.OwnerReference{
+		APIVersion: "example.com/v1",
+		Kind:       "TestResource",
+		Name:       "test-owner",
+		UID:        "owner-uid",
+		Controller: ptr.To(true),
+	}
+	ownerRef := metav1.OwnerReference{
+		APIVersion: "example.com/v1",
+		Kind:       "TestResource",
+		Name:       "test-owner",
+		UID:        "owner-uid",
+	}
+	otherRef := metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       "test-owner",
+		UID:        "other-uid",
+		Controller: ptr.To(true),
+	}
+
+	type testCase struct {
+		matcher             types.GomegaMatcher
+		actual              any
+		shouldMatch         bool
+		expectedInternalErr string
+		expectedMatchErrs   []string
+		expectedNegatedErrs []string
+	}
+
+	DescribeTable("checking ownership and finalizers",
+		func(tc testCase) {
+			// Test Match
+			match, err := tc.matcher.Match(tc.actual)
+			Expect(match).To(Equal(tc.shouldMatch))
+			if tc.expectedInternalErr != "" {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(tc.expectedInternalErr))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			// Test FailureMessage
+			if !tc.shouldMatch {
+				failureMsg := tc.matcher.FailureMessage(tc.actual)
+				for _, expectedErr := range tc.expectedMatchErrs {
+					Expect(failureMsg).To(ContainSubstring(expectedErr))
+				}
+			}
+
+			// Test NegatedFailureMessage
+			negatedFailureMsg := tc.matcher.NegatedFailureMessage(tc.actual)
+			for _, expectedErr := range tc.expectedNegatedErrs {
+				Expect(negatedFailureMsg).To(ContainSubstring(expectedErr))
+			}
+		},
+
+		// Owner matcher
+		Entry("owner reference matches owner", testCase{
+			matcher:             matchers.NewOwnerMatcher(owner, ownerGVK, false),
+			actual:              ownedBy(otherRef, ownerRef),
+			shouldMatch:         true,
+			expectedNegatedErrs: []string{"Expected actual not to have owner example.com/v1/TestResource test-owner (uid: owner-uid)"},
+		}),
+
+		Entry("controller reference matches owner", testCase{
+			matcher:     matchers.NewOwnerMatcher(owner, ownerGVK, false),
+			actual:      ownedBy(controllerRef),
+			shouldMatch: true,
+		}),
+
+		Entry("no owner reference matches owner", testCase{
+			matcher:     matchers.NewOwnerMatcher(owner, ownerGVK, false),
+			actual:      ownedBy(otherRef),
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				"Expected actual to have owner example.com/v1/TestResource test-owner (uid: owner-uid)",
+				"actual ownerReferences:\n* apps/v1/Deployment test-owner (uid: other-uid, controller: true)",
+			},
+		}),
+
+		Entry("no owner references", testCase{
+			matcher:           matchers.NewOwnerMatcher(owner, ownerGVK, false),
+			actual:            ownedBy(),
+			shouldMatch:       false,
+			expectedMatchErrs: []string{"actual has no ownerReferences"},
+		}),
+
+		// Controller matcher
+		Entry("controller reference matches controller", testCase{
+			matcher:             matchers.NewOwnerMatcher(owner, ownerGVK, true),
+			actual:              ownedBy(controllerRef),
+			shouldMatch:         true,
+			expectedNegatedErrs: []string{"Expected actual not to be controlled by example.com/v1/TestResource test-owner"},
+		}),
+
+		Entry("non-controller reference does not match controller", testCase{
+			matcher:     matchers.NewOwnerMatcher(owner, ownerGVK, true),
+			actual:      ownedBy(ownerRef),
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				"Expected actual to be controlled by example.com/v1/TestResource test-owner (uid: owner-uid)",
+				"* example.com/v1/TestResource test-owner (uid: owner-uid, controller: false)",
+			},
+		}),
+
+		Entry("controller reference to another owner", testCase{
+			matcher:     matchers.NewOwnerMatcher(owner, ownerGVK, true),
+			actual:      ownedBy(ownerRef, otherRef),
+			shouldMatch: false,
+		}),
+
+		// Finalizer matcher
+		Entry("finalizer present", testCase{
+			matcher: matchers.NewFinalizerMatcher("example.com/cleanup"),
+			actual: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-cm",
+				Finalizers: []string{"other", "example.com/cleanup"},
+			}},
+			shouldMatch:         true,
+			expectedNegatedErrs: []string{`Expected actual not to have finalizer "example.com/cleanup"`},
+		}),
+
+		Entry("finalizer absent", testCase{
+			matcher: matchers.NewFinalizerMatcher("example.com/cleanup"),
+			actual: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-cm",
+				Finalizers: []string{"other"},
+			}},
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				`Expected actual to have finalizer "example.com/cleanup"`,
+				"actual finalizers: other",
+			},
+		}),
+
+		Entry("no finalizers", testCase{
+			matcher:           matchers.NewFinalizerMatcher("example.com/cleanup"),
+			actual:            testutil.NewConfigMap("test-cm", "default", nil),
+			shouldMatch:       false,
+			expectedMatchErrs: []string{"actual has no finalizers"},
+		}),
+
+		// Error cases
+		Entry("owner matcher with nil actual", testCase{
+			matcher:             matchers.NewOwnerMatcher(owner, ownerGVK, false),
+			actual:              nil,
+			shouldMatch:         false,
+			expectedInternalErr: "actual must be a client.Object, not nil",
+		}),
+
+		Entry("owner matcher with invalid actual type", testCase{
+			matcher:             matchers.NewOwnerMatcher(owner, ownerGVK, false),
+			actual:              "not an object",
+			shouldMatch:         false,
+			expectedInternalErr: "actual must be a client.Object, not string",
+		}),
+
+		Entry("finalizer matcher with typed nil actual", testCase{
+			matcher:             matchers.NewFinalizerMatcher("example.com/cleanup"),
+			actual:              (*corev1.ConfigMap)(nil),
+			shouldMatch:         false,
+			expectedInternalErr: "actual must be a client.Object, not nil",
+		}),
+	)
+})
@@ -0,0 +1,68 @@
+package matchers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/readiness"
+	"github.com/guidewire-oss/sawchain/internal/util"
+)
+
+// readinessMatcher is a Gomega matcher that checks if a client.Object (or every element of
+// a slice of client.Objects) is ready according to kstatus-like readiness rules.
+type readinessMatcher struct {
+	// K8s client used for type conversions.
+	c client.Client
+	// Current readiness error (nil if every resource is ready).
+	notReadyErr error
+}
+
+func (m *readinessMatcher) Match(actual any) (bool, error) {
+	// Convert actual to unstructured elements
+	if util.IsNil(actual) {
+		return false, errors.New("actual must be a client.Object or a slice of client.Object, not nil")
+	}
+	var objs []client.Object
+	if obj, ok := util.AsObject(actual); ok {
+		objs = []client.Object{obj}
+	} else if objs, ok = util.AsSliceOfObjects(actual); ok {
+		if util.ContainsNil(objs) {
+			return false, errors.New("actual must not contain nil elements")
+		}
+	} else {
+		return false, fmt.Errorf("actual must be a client.Object or a slice of client.Object, not %T", actual)
+	}
+	elements := make([]unstructured.Unstructured, len(objs))
+	for i, obj := range objs {
+		element, err := util.UnstructuredFromObject(m.c, obj)
+		if err != nil {
+			return false, err
+		}
+		elements[i] = element
+	}
+
+	// Compute readiness
+	m.notReadyErr = readiness.Check(elements)
+	return m.notReadyErr == nil, nil
+}
+
+func (m *readinessMatcher) FailureMessage(actual any) string {
+	if m.notReadyErr == nil {
+		// Safety: should not happen, but handle gracefully
+		return "Expected actual to be ready\n\n(no readiness details recorded)"
+	}
+	return "Expected actual to be ready\n\n" + m.notReadyErr.Error()
+}
+
+func (m *readinessMatcher) NegatedFailureMessage(actual any) string {
+	return "Expected actual not to be ready"
+}
+
+// NewReadinessMatcher creates a new readinessMatcher.
+func NewReadinessMatcher(c client.Client) types.GomegaMatcher {
+	return &readinessMatcher{c: c}
+}
@@ -0,0 +1,158 @@
+package matchers_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/matchers"
+	"github.com/guidewire-oss/sawchain/internal/testutil"
+)
+
+var _ = Describe("Readiness Matcher", func() {
+	readyCondition := metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue}
+	notReadyCondition := metav1.Condition{
+		Type:    "Ready",
+		Status:  metav1.ConditionFalse,
+		Reason:  "Waiting",
+		Message: "dependency missing",
+	}
+
+	deployment := func(name string, replicas, readyReplicas int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(replicas)},
+			Status: appsv1.DeploymentStatus{
+				Replicas:          replicas,
+				UpdatedReplicas:   replicas,
+				ReadyReplicas:     readyReplicas,
+				AvailableReplicas: readyReplicas,
+			},
+		}
+	}
+
+	type testCase struct {
+		client              client.Client
+		actual              any
+		shouldMatch         bool
+		expectedInternalErr string
+		expectedMatchErrs   []string
+		expectedNegatedErrs []string
+	}
+
+	DescribeTable("checking resource readiness",
+		func(tc testCase) {
+			matcher := matchers.NewReadinessMatcher(tc.client)
+
+			// Test Match
+			match, err := matcher.Match(tc.actual)
+			Expect(match).To(Equal(tc.shouldMatch))
+			if tc.expectedInternalErr != "" {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(tc.expectedInternalErr))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			// Test FailureMessage
+			if !tc.shouldMatch {
+				failureMsg := matcher.FailureMessage(tc.actual)
+				for _, expectedErr := range tc.expectedMatchErrs {
+					Expect(failureMsg).To(ContainSubstring(expectedErr))
+				}
+			}
+
+			// Test NegatedFailureMessage
+			negatedFailureMsg := matcher.NegatedFailureMessage(tc.actual)
+			for _, expectedErr := range tc.expectedNegatedErrs {
+				Expect(negatedFailureMsg).To(ContainSubstring(expectedErr))
+			}
+		},
+
+		// Success cases
+		Entry("ready typed deployment", testCase{
+			client:              standardClient,
+			actual:              deployment("test-deployment", 2, 2),
+			shouldMatch:         true,
+			expectedNegatedErrs: []string{"Expected actual not to be ready"},
+		}),
+
+		Entry("custom resource with True Ready condition", testCase{
+			client:      clientWithTestResource,
+			actual:      testutil.NewTestResource("test-resource", "default", readyCondition),
+			shouldMatch: true,
+		}),
+
+		Entry("resource without status", testCase{
+			client:      standardClient,
+			actual:      testutil.NewUnstructuredConfigMap("test-cm", "default", nil),
+			shouldMatch: true,
+		}),
+
+		Entry("slice of ready resources", testCase{
+			client: clientWithTestResource,
+			actual: []client.Object{
+				deployment("test-deployment", 1, 1),
+				testutil.NewTestResource("test-resource", "default", readyCondition),
+			},
+			shouldMatch: true,
+		}),
+
+		// Failure cases
+		Entry("deployment with replicas not ready", testCase{
+			client:      standardClient,
+			actual:      deployment("test-deployment", 3, 1),
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				"Expected actual to be ready",
+				"1 of 1 resources not ready:",
+				"* apps/v1/Deployment/default/test-deployment: 1 of 3 updated replicas available",
+			},
+		}),
+
+		Entry("slice reports each resource that is not ready", testCase{
+			client: clientWithTestResource,
+			actual: []client.Object{
+				deployment("test-deployment", 1, 1),
+				testutil.NewTestResource("test-resource", "default", notReadyCondition),
+			},
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				"1 of 2 resources not ready:",
+				"* example.com/v1/TestResource/default/test-resource: Ready condition is False (Waiting: dependency missing)",
+			},
+		}),
+
+		// Error cases
+		Entry("nil actual", testCase{
+			client:              standardClient,
+			actual:              nil,
+			shouldMatch:         false,
+			expectedInternalErr: "actual must be a client.Object or a slice of client.Object, not nil",
+		}),
+
+		Entry("invalid actual type", testCase{
+			client:              standardClient,
+			actual:              "not an object",
+			shouldMatch:         false,
+			expectedInternalErr: "actual must be a client.Object or a slice of client.Object, not string",
+		}),
+
+		Entry("slice with nil element", testCase{
+			client:              standardClient,
+			actual:              []client.Object{deployment("test-deployment", 1, 1), nil},
+			shouldMatch:         false,
+			expectedInternalErr: "actual must not contain nil elements",
+		}),
+
+		Entry("unregistered typed object", testCase{
+			client:              standardClient,
+			actual:              testutil.NewTestResource("test-resource", "default", readyCondition),
+			shouldMatch:         false,
+			expectedInternalErr: "failed to convert object to unstructured",
+		}),
+	)
+})
@@ -0,0 +1,345 @@
+package matchers
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/guidewire-oss/sawchain/internal/chainsaw"
+	"github.com/guidewire-oss/sawchain/internal/options"
+	"github.com/guidewire-oss/sawchain/internal/util"
+)
+
+// UpdateSnapshotsEnv is the environment variable that, when set to a true value, makes
+// snapshot matchers rewrite their snapshot files from actual output instead of comparing.
+const UpdateSnapshotsEnv = "SAWCHAIN_UPDATE_SNAPSHOTS"
+
+const (
+	errSnapshotFieldMissing    = "field not found in the input object"
+	errSnapshotFieldUnexpected = "field is not present in snapshot"
+)
+
+// volatileFields are the metadata fields populated by the API server (or kubectl), which are
+// stripped from objects before they are compared with or written to snapshots.
+var volatileFields = [][]string{
+	{"metadata", "uid"},
+	{"metadata", "resourceVersion"},
+	{"metadata", "generation"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "deletionTimestamp"},
+	{"metadata", "deletionGracePeriodSeconds"},
+	{"metadata", "managedFields"},
+	{"metadata", "selfLink"},
+	{"metadata", "annotations", "kubectl.kubernetes.io/last-applied-configuration"},
+}
+
+// snapshotMatcher is a Gomega matcher that checks if a client.Object (or a slice of
+// client.Objects) is equal to the documents of a YAML snapshot file, ignoring volatile
+// metadata. In update mode, it rewrites the snapshot file from the actual value instead.
+type snapshotMatcher struct {
+	// K8s client used for type conversions.
+	c client.Client
+	// Snapshot file path.
+	path string
+	// Whether to rewrite the snapshot file instead of comparing.
+	update bool
+	// Verbosity level for error output.
+	verbosity options.Verbosity
+	// Whether the current actual value is a single object.
+	single bool
+	// Normalized elements of the current actual value.
+	elements []unstructured.Unstructured
+	// Current snapshot documents.
+	documents []unstructured.Unstructured
+	// Current mismatches, indexed by element (nil means matched or no document to compare).
+	attempts []*chainsaw.MatchAttempt
+}
+
+func (m *snapshotMatcher) Match(actual any) (bool, error) {
+	// Convert actual to unstructured elements
+	if util.IsNil(actual) {
+		return false, errors.New("actual must be a client.Object or a slice of client.Object, not nil")
+	}
+	var objs []client.Object
+	if obj, ok := util.AsObject(actual); ok {
+		m.single = true
+		objs = []client.Object{obj}
+	} else if objs, ok = util.AsSliceOfObjects(actual); ok {
+		m.single = false
+		if util.ContainsNil(objs) {
+			return false, errors.New("actual must not contain nil elements")
+		}
+	} else {
+		return false, fmt.Errorf("actual must be a client.Object or a slice of client.Object, not %T", actual)
+	}
+	m.elements = make([]unstructured.Unstructured, len(objs))
+	for i, obj := range objs {
+		element, err := util.UnstructuredFromObject(m.c, obj)
+		if err != nil {
+			return false, err
+		}
+		normalized, err := normalizeSnapshotObject(element)
+		if err != nil {
+			return false, err
+		}
+		m.elements[i] = normalized
+	}
+
+	// Rewrite snapshot in update mode
+	if m.update {
+		return true, m.write()
+	}
+
+	// Read snapshot documents
+	if !util.IsExistingFile(m.path) {
+		return false, fmt.Errorf("snapshot file %q does not exist; set %s=true to create it", m.path, UpdateSnapshotsEnv)
+	}
+	documents, err := readSnapshot(m.path)
+	if err != nil {
+		return false, err
+	}
+	m.documents = documents
+
+	// Compare elements with documents in order
+	m.attempts = make([]*chainsaw.MatchAttempt, len(m.elements))
+	matched := len(m.elements) == len(m.documents)
+	for i := range m.elements {
+		if i >= len(m.documents) {
+			break
+		}
+		fieldErrs := diffSnapshotValues(m.elements[i].Object, m.documents[i].Object, nil)
+		if len(fieldErrs) > 0 {
+			m.attempts[i] = &chainsaw.MatchAttempt{
+				Actual:    m.elements[i],
+				Expected:  m.documents[i],
+				FieldErrs: fieldErrs,
+			}
+			matched = false
+		}
+	}
+	return matched, nil
+}
+
+func (m *snapshotMatcher) String() string {
+	return fmt.Sprintf("snapshot %q", m.path)
+}
+
+func (m *snapshotMatcher) FailureMessage(actual any) string {
+	hint := fmt.Sprintf("If the change is intended, set %s=true to update the snapshot.", UpdateSnapshotsEnv)
+
+	if m.single && len(m.documents) == 1 && m.attempts[0] != nil {
+		me := &chainsaw.MatchError{Attempts: []chainsaw.MatchAttempt{*m.attempts[0]}, Mode: chainsaw.MatchModeVaryActual}
+		return fmt.Sprintf("Expected actual to match snapshot %q\n\n%s\n\n%s",
+			m.path, me.FormatDetail(m.verbosity, nil), hint)
+	}
+
+	var sections []string
+	if len(m.elements) != len(m.documents) {
+		sections = append(sections, fmt.Sprintf(
+			"Expected actual to match snapshot %q; actual has %d resources, but snapshot has %d documents",
+			m.path, len(m.elements), len(m.documents)))
+	} else {
+		var mismatched int
+		for _, a := range m.attempts {
+			if a != nil {
+				mismatched++
+			}
+		}
+		sections = append(sections, fmt.Sprintf(
+			"Expected actual to match snapshot %q; %d of %d resources did not match",
+			m.path, mismatched, len(m.elements)))
+	}
+	for i, a := range m.attempts {
+		if a == nil {
+			continue
+		}
+		me := &chainsaw.MatchError{Attempts: []chainsaw.MatchAttempt{*a}, Mode: chainsaw.MatchModeVaryActual}
+		sections = append(sections, fmt.Sprintf("[ELEMENT #%d] %s\n%s",
+			i+1, chainsaw.ResourceID(m.elements[i]), me.FormatDetail(m.verbosity, nil)))
+	}
+	sections = append(sections, hint)
+	return strings.Join(sections, "\n\n")
+}
+
+func (m *snapshotMatcher) NegatedFailureMessage(actual any) string {
+	return fmt.Sprintf("Expected actual not to match snapshot %q", m.path)
+}
+
+// write rewrites the snapshot file from the current elements, creating parent directories
+// as needed.
+func (m *snapshotMatcher) write() error {
+	var b strings.Builder
+	for i, element := range m.elements {
+		y, err := yaml.Marshal(element.Object)
+		if err != nil {
+			return fmt.Errorf("failed to marshal snapshot: %w", err)
+		}
+		if i > 0 {
+			b.WriteString("---\n")
+		}
+		b.Write(y)
+	}
+	if err := os.MkdirAll(filepath.Dir(m.path), 0755); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	if err := os.WriteFile(m.path, []byte(b.String()), 0644); err != nil {
+		return fmt.Errorf("failed to write snapshot: %w", err)
+	}
+	return nil
+}
+
+// readSnapshot reads and parses the documents of a snapshot file.
+func readSnapshot(path string) ([]unstructured.Unstructured, error) {
+	content, err := util.ReadFileContent(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot: %w", err)
+	}
+	docs, err := util.SplitYAML(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot %q: %w", path, err)
+	}
+	documents := make([]unstructured.Unstructured, len(docs))
+	for i, doc := range docs {
+		var obj map[string]any
+		if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+			return nil, fmt.Errorf("failed to parse snapshot %q: %w", path, err)
+		}
+		documents[i] = unstructured.Unstructured{Object: obj}
+	}
+	return documents, nil
+}
+
+// normalizeSnapshotObject strips volatile metadata and absent values (nulls and empty maps)
+// from the object, then round-trips it through YAML so that it compares equal to the
+// document it would be written as.
+func normalizeSnapshotObject(obj unstructured.Unstructured) (unstructured.Unstructured, error) {
+	obj = *obj.DeepCopy()
+	for _, fields := range volatileFields {
+		unstructured.RemoveNestedField(obj.Object, fields...)
+	}
+	pruned, _ := pruneAbsent(obj.Object).(map[string]any)
+	y, err := yaml.Marshal(pruned)
+	if err != nil {
+		return unstructured.Unstructured{}, fmt.Errorf("failed to marshal object: %w", err)
+	}
+	var normalized map[string]any
+	if err := yaml.Unmarshal(y, &normalized); err != nil {
+		return unstructured.Unstructured{}, fmt.Errorf("failed to unmarshal object: %w", err)
+	}
+	return unstructured.Unstructured{Object: normalized}, nil
+}
+
+// pruneAbsent recursively removes null values and empty maps from map values.
+func pruneAbsent(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, child := range v {
+			pruned := pruneAbsent(child)
+			if pruned == nil {
+				delete(v, key)
+				continue
+			}
+			if m, ok := pruned.(map[string]any); ok && len(m) == 0 {
+				delete(v, key)
+				continue
+			}
+			v[key] = pruned
+		}
+		return v
+	case []any:
+		for i := range v {
+			v[i] = pruneAbsent(v[i])
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// diffSnapshotValues returns a field error for every difference between actual and expected.
+func diffSnapshotValues(actual, expected any, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	actualMap, actualIsMap := actual.(map[string]any)
+	expectedMap, expectedIsMap := expected.(map[string]any)
+	if actualIsMap && expectedIsMap {
+		keys := make([]string, 0, len(actualMap)+len(expectedMap))
+		for key := range actualMap {
+			keys = append(keys, key)
+		}
+		for key := range expectedMap {
+			if _, ok := actualMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			childPath := chainsaw.ChildFieldPath(path, key)
+			actualChild, inActual := actualMap[key]
+			expectedChild, inExpected := expectedMap[key]
+			switch {
+			case !inActual:
+				errs = append(errs, field.Required(childPath, errSnapshotFieldMissing))
+			case !inExpected:
+				errs = append(errs, field.Forbidden(childPath, errSnapshotFieldUnexpected))
+			default:
+				errs = append(errs, diffSnapshotValues(actualChild, expectedChild, childPath)...)
+			}
+		}
+		return errs
+	}
+	actualList, actualIsList := actual.([]any)
+	expectedList, expectedIsList := expected.([]any)
+	if actualIsList && expectedIsList {
+		if len(actualList) != len(expectedList) {
+			return field.ErrorList{field.Invalid(path, len(actualList),
+				fmt.Sprintf("lengths of slices don't match; expected %d items", len(expectedList)))}
+		}
+		for i := range actualList {
+			errs = append(errs, diffSnapshotValues(actualList[i], expectedList[i], path.Index(i))...)
+		}
+		return errs
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		return field.ErrorList{field.Invalid(path, actual, expectedValueDetail(expected))}
+	}
+	return nil
+}
+
+// expectedValueDetail renders the expected value like Chainsaw field errors do
+// (based on kyverno-json's assertion.expectValueMessage).
+func expectedValueDetail(value any) string {
+	switch v := value.(type) {
+	case int64, float64, bool:
+		return fmt.Sprintf("Expected value: %v", v)
+	case string:
+		return fmt.Sprintf("Expected value: %q", v)
+	default:
+		return fmt.Sprintf("Expected value: %#v", v)
+	}
+}
+
+// NewSnapshotMatcher creates a new snapshotMatcher for the snapshot file at path. If update is
+// true, the matcher rewrites the snapshot file from the actual value and always succeeds.
+func NewSnapshotMatcher(
+	c client.Client,
+	path string,
+	update bool,
+	verbosity options.Verbosity,
+) types.GomegaMatcher {
+	return &snapshotMatcher{
+		c:         c,
+		path:      path,
+		update:    update,
+		verbosity: verbosity,
+	}
+}
@@ -0,0 +1,226 @@
+package matchers_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/matchers"
+	"github.com/guidewire-oss/sawchain/internal/options"
+	"github.com/guidewire-oss/sawchain/internal/testutil"
+)
+
+var _ = Describe("Snapshot Matcher", func() {
+	const cm1Snapshot = `apiVersion: v1
+data:
+  key: val1
+kind: ConfigMap
+metadata:
+  name: cm1
+  namespace: default
+`
+
+	const cm2Snapshot = `apiVersion: v1
+data:
+  key: val2
+kind: ConfigMap
+metadata:
+  name: cm2
+  namespace: default
+`
+
+	cm := func(name, value string) *corev1.ConfigMap {
+		return testutil.NewConfigMap(name, "default", map[string]string{"key": value})
+	}
+
+	// withServerMetadata sets the metadata fields populated by the API server.
+	withServerMetadata := func(obj *corev1.ConfigMap) *corev1.ConfigMap {
+		obj.UID = types.UID("1234")
+		obj.ResourceVersion = "42"
+		obj.Generation = 3
+		obj.CreationTimestamp = metav1.Now()
+		obj.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "sawchain"}}
+		return obj
+	}
+
+	type testCase struct {
+		actual              any
+		snapshot            string
+		noSnapshot          bool
+		verbosity           options.Verbosity
+		shouldMatch         bool
+		expectedInternalErr string
+		expectedMatchErrs   []string
+		expectedNegatedErrs []string
+	}
+
+	DescribeTable("matching objects against snapshot files",
+		func(tc testCase) {
+			path := filepath.Join(GinkgoT().TempDir(), "snapshot.yaml")
+			if !tc.noSnapshot {
+				Expect(os.WriteFile(path, []byte(tc.snapshot), 0644)).To(Succeed())
+			}
+			matcher := matchers.NewSnapshotMatcher(standardClient, path, false, tc.verbosity)
+
+			// Test Match
+			match, err := matcher.Match(tc.actual)
+			Expect(match).To(Equal(tc.shouldMatch))
+			if tc.expectedInternalErr != "" {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(tc.expectedInternalErr))
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			// Test FailureMessage
+			if !tc.shouldMatch {
+				failureMsg := matcher.FailureMessage(tc.actual)
+				Expect(failureMsg).To(ContainSubstring(path))
+				for _, expectedErr := range tc.expectedMatchErrs {
+					Expect(failureMsg).To(ContainSubstring(expectedErr))
+				}
+			}
+
+			// Test NegatedFailureMessage
+			negatedFailureMsg := matcher.NegatedFailureMessage(tc.actual)
+			for _, expectedErr := range tc.expectedNegatedErrs {
+				Expect(negatedFailureMsg).To(ContainSubstring(expectedErr))
+			}
+		},
+
+		// Success cases
+		Entry("single object matches snapshot", testCase{
+			actual:              cm("cm1", "val1"),
+			snapshot:            cm1Snapshot,
+			shouldMatch:         true,
+			expectedNegatedErrs: []string{"Expected actual not to match snapshot"},
+		}),
+
+		Entry("volatile metadata is ignored", testCase{
+			actual:      withServerMetadata(cm("cm1", "val1")),
+			snapshot:    cm1Snapshot,
+			shouldMatch: true,
+		}),
+
+		Entry("unstructured object matches snapshot", testCase{
+			actual:      testutil.NewUnstructuredConfigMap("cm1", "default", map[string]string{"key": "val1"}),
+			snapshot:    cm1Snapshot,
+			shouldMatch: true,
+		}),
+
+		Entry("slice of objects matches multi-document snapshot in order", testCase{
+			actual:      []client.Object{cm("cm1", "val1"), cm("cm2", "val2")},
+			snapshot:    cm1Snapshot + "---\n" + cm2Snapshot,
+			shouldMatch: true,
+		}),
+
+		// Failure cases
+		Entry("changed value", testCase{
+			actual:      cm("cm1", "changed"),
+			snapshot:    cm1Snapshot,
+			verbosity:   options.VerbosityNormal,
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				"Expected actual to match snapshot",
+				"data.key: Invalid value: \"changed\": Expected value: \"val1\"",
+				"set SAWCHAIN_UPDATE_SNAPSHOTS=true to update the snapshot",
+			},
+		}),
+
+		Entry("added and removed fields", testCase{
+			actual:      testutil.NewConfigMapWithLabels("cm1", "default", map[string]string{"a": "b"}, nil),
+			snapshot:    cm1Snapshot,
+			verbosity:   options.VerbosityMinimal,
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				"data: Required value: field not found in the input object",
+				"metadata.labels: Forbidden: field is not present in snapshot",
+			},
+		}),
+
+		Entry("slice reports each mismatched element", testCase{
+			actual:      []client.Object{cm("cm1", "val1"), cm("cm2", "changed")},
+			snapshot:    cm1Snapshot + "---\n" + cm2Snapshot,
+			verbosity:   options.VerbosityMinimal,
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				"1 of 2 resources did not match",
+				"[ELEMENT #2] v1/ConfigMap/default/cm2",
+				"data.key: Invalid value: \"changed\": Expected value: \"val2\"",
+			},
+		}),
+
+		Entry("slice with different number of resources", testCase{
+			actual:      []client.Object{cm("cm1", "val1")},
+			snapshot:    cm1Snapshot + "---\n" + cm2Snapshot,
+			shouldMatch: false,
+			expectedMatchErrs: []string{
+				"actual has 1 resources, but snapshot has 2 documents",
+			},
+		}),
+
+		// Error cases
+		Entry("missing snapshot file", testCase{
+			actual:              cm("cm1", "val1"),
+			noSnapshot:          true,
+			shouldMatch:         false,
+			expectedInternalErr: "does not exist; set SAWCHAIN_UPDATE_SNAPSHOTS=true to create it",
+		}),
+
+		Entry("nil actual", testCase{
+			actual:              nil,
+			snapshot:            cm1Snapshot,
+			shouldMatch:         false,
+			expectedInternalErr: "actual must be a client.Object or a slice of client.Object, not nil",
+		}),
+
+		Entry("invalid actual type", testCase{
+			actual:              "not an object",
+			snapshot:            cm1Snapshot,
+			shouldMatch:         false,
+			expectedInternalErr: "actual must be a client.Object or a slice of client.Object, not string",
+		}),
+
+		Entry("slice with nil element", testCase{
+			actual:              []client.Object{cm("cm1", "val1"), nil},
+			snapshot:            cm1Snapshot,
+			shouldMatch:         false,
+			expectedInternalErr: "actual must not contain nil elements",
+		}),
+	)
+
+	Describe("update mode", func() {
+		It("writes the snapshot without volatile metadata and matches it afterwards", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "nested", "snapshot.yaml")
+			actual := []client.Object{withServerMetadata(cm("cm1", "val1")), cm("cm2", "val2")}
+
+			updater := matchers.NewSnapshotMatcher(standardClient, path, true, options.VerbosityNormal)
+			Expect(updater.Match(actual)).To(BeTrue())
+
+			content, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(cm1Snapshot + "---\n" + cm2Snapshot))
+
+			matcher := matchers.NewSnapshotMatcher(standardClient, path, false, options.VerbosityNormal)
+			Expect(matcher.Match(actual)).To(BeTrue())
+		})
+
+		It("overwrites an outdated snapshot", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "snapshot.yaml")
+			Expect(os.WriteFile(path, []byte(cm2Snapshot), 0644)).To(Succeed())
+
+			updater := matchers.NewSnapshotMatcher(standardClient, path, true, options.VerbosityNormal)
+			Expect(updater.Match(cm("cm1", "val1"))).To(BeTrue())
+
+			content, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(cm1Snapshot))
+		})
+	})
+})
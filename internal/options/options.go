@@ -3,8 +3,12 @@ package options
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	gocel "github.com/google/cel-go/cel"
+	jpfunctions "github.com/jmespath-community/go-jmespath/pkg/functions"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/guidewire-oss/sawchain/internal/util"
@@ -41,15 +45,231 @@ func (v Verbosity) String() string {
 	}
 }
 
+// Flag is a set of opt-in behaviors enabled by passing flag values as arguments.
+// Individual flags are distinct bits and may be combined with bitwise OR.
+type Flag uint
+
+const (
+	// FlagAutoCleanup records resources created through Sawchain and deletes them on test cleanup.
+	FlagAutoCleanup Flag = 1 << iota
+	// FlagDecodeSecrets compares and renders the data of Secrets in decoded form, like stringData.
+	FlagDecodeSecrets
+	// FlagForceConflicts forces server-side apply requests to take ownership of conflicting fields.
+	FlagForceConflicts
+	// FlagJSONPatch interprets patch templates as RFC 6902 JSON Patch documents.
+	FlagJSONPatch
+	// FlagRetryOnConflict retries updates that fail with a conflict until the timeout elapses.
+	FlagRetryOnConflict
+	// FlagSkipNamespaceWait deletes namespaces on cleanup without waiting for them to terminate.
+	FlagSkipNamespaceWait
+	// FlagStrict rejects matches with fields present in the actual resource but absent in the expectation.
+	FlagStrict
+	// FlagUpdateSnapshots rewrites snapshot files from actual output instead of comparing with them.
+	FlagUpdateSnapshots
+)
+
+// flagNames maps each individual flag to its display name.
+var flagNames = []struct {
+	flag Flag
+	name string
+}{
+	{FlagAutoCleanup, "AutoCleanup"},
+	{FlagDecodeSecrets, "DecodeSecrets"},
+	{FlagForceConflicts, "ForceConflicts"},
+	{FlagJSONPatch, "JSONPatch"},
+	{FlagRetryOnConflict, "RetryOnConflict"},
+	{FlagSkipNamespaceWait, "SkipNamespaceWait"},
+	{FlagStrict, "Strict"},
+	{FlagUpdateSnapshots, "UpdateSnapshots"},
+}
+
+// Has reports whether all bits of other are set in f.
+func (f Flag) Has(other Flag) bool {
+	return other != 0 && f&other == other
+}
+
+func (f Flag) String() string {
+	if f == 0 {
+		return "none"
+	}
+	var names []string
+	rest := f
+	for _, fn := range flagNames {
+		if f&fn.flag != 0 {
+			names = append(names, fn.name)
+			rest &^= fn.flag
+		}
+	}
+	if rest != 0 {
+		names = append(names, fmt.Sprintf("Flag(%d)", uint(rest)))
+	}
+	return strings.Join(names, "|")
+}
+
+// FieldManager is the name of the actor making changes in server-side apply operations.
+type FieldManager string
+
+// Count is a constraint on a number of matches, with an inclusive lower bound and an optional
+// inclusive upper bound.
+type Count struct {
+	Min int // Minimum number of matches.
+	Max int // Maximum number of matches; negative means unbounded.
+}
+
+// Validate returns an error if the constraint cannot be satisfied by any count.
+func (c Count) Validate() error {
+	if c.Min < 0 {
+		return errors.New("count minimum is negative")
+	}
+	if c.Max >= 0 && c.Max < c.Min {
+		return fmt.Errorf("count maximum (%d) is less than minimum (%d)", c.Max, c.Min)
+	}
+	return nil
+}
+
+// Allows reports whether n satisfies the constraint.
+func (c Count) Allows(n int) bool {
+	return n >= c.Min && (c.Max < 0 || n <= c.Max)
+}
+
+func (c Count) String() string {
+	switch {
+	case c.Max < 0:
+		return fmt.Sprintf("at least %d", c.Min)
+	case c.Min == c.Max:
+		return fmt.Sprintf("exactly %d", c.Min)
+	case c.Min == 0:
+		return fmt.Sprintf("at most %d", c.Max)
+	default:
+		return fmt.Sprintf("between %d and %d", c.Min, c.Max)
+	}
+}
+
+// IgnorePaths are field paths (e.g. "status" or "spec.containers[*].image") excluded from strict
+// matching, along with all fields beneath them.
+type IgnorePaths []string
+
+// GracePeriod is the duration a resource is given to terminate gracefully before it is deleted.
+// Must be a whole number of seconds; zero deletes immediately.
+type GracePeriod time.Duration
+
+// RemoveFinalizersAfter is the window after which finalizers are removed from resources that
+// are still present while waiting for them to be deleted.
+type RemoveFinalizersAfter time.Duration
+
+// JMESPathFunction is a custom function made available to JMESPath expressions in templates.
+type JMESPathFunction = jpfunctions.FunctionEntry
+
+// CELFunction is a custom function (or other environment option) made available to CEL
+// expressions in templates.
+type CELFunction = gocel.EnvOption
+
+// Include selects the arguments accepted by an operation. Arguments providing settings that are
+// not included are rejected.
+type Include struct {
+	Verbosity     bool // Verbosity.
+	Durations     bool // Timeout and Interval, plus RemoveFinalizersAfter if DeleteOptions is also true.
+	Object        bool // Object.
+	Objects       bool // Objects.
+	Template      bool // Template.
+	FieldManager  bool // FieldManager.
+	DeleteOptions bool // PropagationPolicy and GracePeriod.
+	Functions     bool // JMESPathFunctions and CELFunctions.
+	Flags         Flag // Flags, plus IgnorePaths if FlagStrict is included.
+}
+
 // Options is a common struct for options used in Sawchain operations.
 type Options struct {
-	Timeout   time.Duration   // Timeout for eventual assertions.
-	Interval  time.Duration   // Polling interval for eventual assertions.
-	Template  string          // Template content for Chainsaw resource operations.
-	Bindings  map[string]any  // Template bindings for Chainsaw resource operations.
-	Object    client.Object   // Object to store state for single-resource operations.
-	Objects   []client.Object // Slice to store state for multi-resource operations.
-	Verbosity Verbosity       // Detail level of assertion error output and logging.
+	Timeout      time.Duration   // Timeout for eventual assertions.
+	Interval     time.Duration   // Polling interval for eventual assertions.
+	Template     string          // Template content for Chainsaw resource operations.
+	Bindings     map[string]any  // Template bindings for Chainsaw resource operations.
+	Object       client.Object   // Object to store state for single-resource operations.
+	Objects      []client.Object // Slice to store state for multi-resource operations.
+	Verbosity    Verbosity       // Detail level of assertion error output and logging.
+	FieldManager FieldManager    // Field manager for server-side apply operations.
+	Flags        Flag            // Opt-in behaviors.
+	IgnorePaths  []string        // Field paths excluded from strict matching.
+
+	PropagationPolicy     metav1.DeletionPropagation // Propagation policy for delete operations.
+	GracePeriod           *time.Duration             // Grace period for delete operations (nil if not provided).
+	RemoveFinalizersAfter time.Duration              // Window after which finalizers of resources pending deletion are removed.
+
+	JMESPathFunctions []JMESPathFunction // Custom functions for JMESPath expressions in templates.
+	CELFunctions      []CELFunction      // Custom functions for CEL expressions in templates.
+}
+
+// optionKind identifies the setting an Option provides.
+type optionKind int
+
+const (
+	optionBindings optionKind = iota + 1
+	optionTimeout
+	optionInterval
+	optionObject
+	optionObjects
+	optionTemplate
+	optionVerbosity
+)
+
+// optionNames maps each option kind to the name of the function creating it.
+var optionNames = map[optionKind]string{
+	optionBindings:  "WithBindings",
+	optionTimeout:   "WithTimeout",
+	optionInterval:  "WithInterval",
+	optionObject:    "WithObject",
+	optionObjects:   "WithObjects",
+	optionTemplate:  "WithTemplate",
+	optionVerbosity: "WithVerbosity",
+}
+
+// Option is an explicitly typed argument providing a single setting. Unlike the plain
+// argument it replaces, its meaning does not depend on its dynamic type or position.
+type Option struct {
+	kind  optionKind
+	value any
+}
+
+func (o Option) String() string {
+	if name, ok := optionNames[o.kind]; ok {
+		return name
+	}
+	return fmt.Sprintf("Option(%d)", int(o.kind))
+}
+
+// WithBindings returns an Option providing template bindings.
+func WithBindings(bindings map[string]any) Option {
+	return Option{kind: optionBindings, value: bindings}
+}
+
+// WithTimeout returns an Option providing the timeout for eventual assertions.
+func WithTimeout(timeout time.Duration) Option {
+	return Option{kind: optionTimeout, value: timeout}
+}
+
+// WithInterval returns an Option providing the polling interval for eventual assertions.
+func WithInterval(interval time.Duration) Option {
+	return Option{kind: optionInterval, value: interval}
+}
+
+// WithObject returns an Option providing the object for single-resource operations.
+func WithObject(obj client.Object) Option {
+	return Option{kind: optionObject, value: obj}
+}
+
+// WithObjects returns an Option providing the objects for multi-resource operations.
+func WithObjects(objs ...client.Object) Option {
+	return Option{kind: optionObjects, value: objs}
+}
+
+// WithTemplate returns an Option providing a template file path or content.
+func WithTemplate(template string) Option {
+	return Option{kind: optionTemplate, value: template}
+}
+
+// WithVerbosity returns an Option providing the detail level of error output and logging.
+func WithVerbosity(verbosity Verbosity) Option {
+	return Option{kind: optionVerbosity, value: verbosity}
 }
 
 // ProcessTemplate extracts content from the given template string or file and sanitizes it
@@ -79,41 +299,61 @@ func ProcessTemplate(template string) (string, error) {
 	return sanitized, nil
 }
 
-// parse parses variable arguments into an Options struct.
-//   - If includeVerbosity is true, checks for Verbosity; otherwise disallows it.
-//   - If includeDurations is true, checks for Timeout and Interval; otherwise disallows them.
-//   - If includeObject is true, checks for Object; otherwise disallows it.
-//   - If includeObjects is true, checks for Objects; otherwise disallows it.
-//   - If includeTemplate is true, checks for Template; otherwise disallows it.
-func parse(
-	includeVerbosity bool,
-	includeDurations bool,
-	includeObject bool,
-	includeObjects bool,
-	includeTemplate bool,
-	args ...any,
-) (*Options, error) {
+// parse parses variable arguments into an Options struct, checking for the settings selected by
+// include and disallowing all others. Bindings and Options providing included settings are
+// always allowed.
+func parse(include Include, args ...any) (*Options, error) {
 	opts := &Options{
 		Bindings: map[string]any{},
 	}
 
 	for _, arg := range args {
-		if includeVerbosity {
+		// Check for Options
+		if o, ok := arg.(Option); ok {
+			if err := opts.applyOption(o, include); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// Check for Flags
+		if f, ok := arg.(Flag); ok {
+			if f == 0 {
+				return nil, errors.New("provided flag is zero")
+			} else if unsupported := f &^ include.Flags; unsupported != 0 {
+				return nil, fmt.Errorf("unsupported flag argument: %s", unsupported)
+			}
+			opts.Flags |= f
+			continue
+		}
+
+		if include.Flags.Has(FlagStrict) {
+			// Check for IgnorePaths
+			if paths, ok := arg.(IgnorePaths); ok {
+				if len(paths) == 0 {
+					return nil, errors.New("provided ignore paths are empty")
+				}
+				for _, path := range paths {
+					if _, err := util.ParseFieldPath(path); err != nil {
+						return nil, fmt.Errorf("invalid ignore path: %w", err)
+					}
+				}
+				opts.IgnorePaths = append(opts.IgnorePaths, paths...)
+				continue
+			}
+		}
+
+		if include.Verbosity {
 			// Check for Verbosity
 			if v, ok := arg.(Verbosity); ok {
-				if v == 0 {
-					return nil, errors.New("provided verbosity is zero")
-				} else if v < 0 {
-					return nil, errors.New("provided verbosity is negative")
-				} else if opts.Verbosity != 0 {
-					return nil, errors.New("multiple verbosity arguments provided")
+				if err := opts.setVerbosity(v); err != nil {
+					return nil, err
 				}
-				opts.Verbosity = v
 				continue
 			}
 		}
 
-		if includeDurations {
+		if include.Durations {
 			// Check for Timeout and Interval
 			if d, ok := util.AsDuration(arg); ok {
 				if d == 0 {
@@ -133,52 +373,117 @@ func parse(
 			}
 		}
 
-		if includeObject {
+		if include.Object {
 			// Check for Object
 			if obj, ok := util.AsObject(arg); ok {
-				if opts.Object != nil {
-					return nil, errors.New("multiple client.Object arguments provided")
-				} else if opts.Objects != nil {
-					return nil, errors.New(errObjectAndObjects)
-				} else if util.IsNil(obj) {
-					return nil, errors.New(
-						"provided client.Object is nil or has a nil underlying value")
-				} else {
-					opts.Object = obj
+				if err := opts.setObject(obj); err != nil {
+					return nil, err
 				}
 				continue
 			}
 		}
 
-		if includeObjects {
+		if include.Objects {
 			// Check for Objects
 			if objs, ok := util.AsSliceOfObjects(arg); ok {
-				if opts.Objects != nil {
-					return nil, errors.New("multiple []client.Object arguments provided")
-				} else if opts.Object != nil {
-					return nil, errors.New(errObjectAndObjects)
-				} else if util.ContainsNil(objs) {
-					return nil, errors.New(
-						"provided []client.Object contains an element that is nil or has a nil underlying value")
-				} else {
-					opts.Objects = objs
+				if err := opts.setObjects(objs); err != nil {
+					return nil, err
 				}
 				continue
 			}
 		}
 
-		if includeTemplate {
+		if include.Template {
 			// Check for Template
 			if str, ok := arg.(string); ok {
-				if opts.Template != "" {
-					return nil, errors.New("multiple template arguments provided")
-				} else {
-					var err error
-					opts.Template, err = ProcessTemplate(str)
-					if err != nil {
-						return nil, err
+				if err := opts.setTemplate(str); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+
+		if include.FieldManager {
+			// Check for FieldManager
+			if fm, ok := arg.(FieldManager); ok {
+				if fm == "" {
+					return nil, errors.New("provided field manager is empty")
+				} else if opts.FieldManager != "" {
+					return nil, errors.New("multiple field manager arguments provided")
+				}
+				opts.FieldManager = fm
+				continue
+			}
+		}
+
+		if include.DeleteOptions {
+			// Check for PropagationPolicy
+			if p, ok := arg.(metav1.DeletionPropagation); ok {
+				switch p {
+				case metav1.DeletePropagationForeground, metav1.DeletePropagationBackground, metav1.DeletePropagationOrphan:
+				default:
+					return nil, fmt.Errorf("unsupported propagation policy: %q", p)
+				}
+				if opts.PropagationPolicy != "" {
+					return nil, errors.New("multiple propagation policy arguments provided")
+				}
+				opts.PropagationPolicy = p
+				continue
+			}
+
+			// Check for GracePeriod
+			if gp, ok := arg.(GracePeriod); ok {
+				d := time.Duration(gp)
+				if d < 0 {
+					return nil, errors.New("provided grace period is negative")
+				} else if d%time.Second != 0 {
+					return nil, errors.New("provided grace period is not a whole number of seconds")
+				} else if opts.GracePeriod != nil {
+					return nil, errors.New("multiple grace period arguments provided")
+				}
+				opts.GracePeriod = &d
+				continue
+			}
+		}
+
+		if include.DeleteOptions && include.Durations {
+			// Check for RemoveFinalizersAfter
+			if rfa, ok := arg.(RemoveFinalizersAfter); ok {
+				d := time.Duration(rfa)
+				if d == 0 {
+					return nil, errors.New("provided finalizer removal window is zero")
+				} else if d < 0 {
+					return nil, errors.New("provided finalizer removal window is negative")
+				} else if opts.RemoveFinalizersAfter != 0 {
+					return nil, errors.New("multiple finalizer removal window arguments provided")
+				}
+				opts.RemoveFinalizersAfter = d
+				continue
+			}
+		}
+
+		if include.Functions {
+			// Check for JMESPathFunctions
+			if fns, ok := asJMESPathFunctions(arg); ok {
+				for _, fn := range fns {
+					if fn.Name == "" {
+						return nil, errors.New("provided JMESPath function has no name")
+					} else if fn.Handler == nil {
+						return nil, fmt.Errorf("provided JMESPath function %s has no handler", fn.Name)
 					}
 				}
+				opts.JMESPathFunctions = append(opts.JMESPathFunctions, fns...)
+				continue
+			}
+
+			// Check for CELFunctions
+			if fns, ok := asCELFunctions(arg); ok {
+				for _, fn := range fns {
+					if fn == nil {
+						return nil, errors.New("provided CEL function is nil")
+					}
+				}
+				opts.CELFunctions = append(opts.CELFunctions, fns...)
 				continue
 			}
 		}
@@ -192,9 +497,158 @@ func parse(
 		return nil, fmt.Errorf("unexpected argument type: %T", arg)
 	}
 
+	if opts.Timeout != 0 && opts.Interval > opts.Timeout {
+		return nil, errors.New("provided interval is greater than timeout")
+	}
+
 	return opts, nil
 }
 
+// applyOption applies the setting provided by o, if included.
+func (opts *Options) applyOption(o Option, include Include) error {
+	switch o.kind {
+	case 0:
+		return errors.New("provided option is zero")
+	case optionBindings:
+		bindings, ok := o.value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("provided %s option has invalid value: %T", o, o.value)
+		}
+		opts.Bindings = util.MergeMaps(opts.Bindings, bindings)
+		return nil
+	case optionTimeout, optionInterval:
+		if !include.Durations {
+			break
+		}
+		d, ok := o.value.(time.Duration)
+		if !ok {
+			return fmt.Errorf("provided %s option has invalid value: %T", o, o.value)
+		}
+		name, target := "timeout", &opts.Timeout
+		if o.kind == optionInterval {
+			name, target = "interval", &opts.Interval
+		}
+		if d == 0 {
+			return fmt.Errorf("provided %s is zero", name)
+		} else if d < 0 {
+			return fmt.Errorf("provided %s is negative", name)
+		} else if *target != 0 {
+			return fmt.Errorf("multiple %s arguments provided", name)
+		}
+		*target = d
+		return nil
+	case optionObject:
+		if include.Object {
+			obj, ok := o.value.(client.Object)
+			if !ok {
+				return fmt.Errorf("provided %s option has a nil client.Object", o)
+			}
+			return opts.setObject(obj)
+		}
+	case optionObjects:
+		if include.Objects {
+			objs, ok := o.value.([]client.Object)
+			if !ok {
+				return fmt.Errorf("provided %s option has invalid value: %T", o, o.value)
+			}
+			return opts.setObjects(objs)
+		}
+	case optionTemplate:
+		if include.Template {
+			template, ok := o.value.(string)
+			if !ok {
+				return fmt.Errorf("provided %s option has invalid value: %T", o, o.value)
+			}
+			return opts.setTemplate(template)
+		}
+	case optionVerbosity:
+		if include.Verbosity {
+			v, ok := o.value.(Verbosity)
+			if !ok {
+				return fmt.Errorf("provided %s option has invalid value: %T", o, o.value)
+			}
+			return opts.setVerbosity(v)
+		}
+	}
+	return fmt.Errorf("unsupported option argument: %s", o)
+}
+
+// setVerbosity sets Verbosity, rejecting invalid and repeated values.
+func (opts *Options) setVerbosity(v Verbosity) error {
+	if v == 0 {
+		return errors.New("provided verbosity is zero")
+	} else if v < 0 {
+		return errors.New("provided verbosity is negative")
+	} else if opts.Verbosity != 0 {
+		return errors.New("multiple verbosity arguments provided")
+	}
+	opts.Verbosity = v
+	return nil
+}
+
+// setObject sets Object, rejecting nil and repeated values.
+func (opts *Options) setObject(obj client.Object) error {
+	if opts.Object != nil {
+		return errors.New("multiple client.Object arguments provided")
+	} else if opts.Objects != nil {
+		return errors.New(errObjectAndObjects)
+	} else if util.IsNil(obj) {
+		return errors.New("provided client.Object is nil or has a nil underlying value")
+	}
+	opts.Object = obj
+	return nil
+}
+
+// setObjects sets Objects, rejecting nil elements and repeated values.
+func (opts *Options) setObjects(objs []client.Object) error {
+	if opts.Objects != nil {
+		return errors.New("multiple []client.Object arguments provided")
+	} else if opts.Object != nil {
+		return errors.New(errObjectAndObjects)
+	} else if util.ContainsNil(objs) {
+		return errors.New("provided []client.Object contains an element that is nil or has a nil underlying value")
+	}
+	opts.Objects = objs
+	return nil
+}
+
+// setTemplate processes and sets Template, rejecting repeated values.
+func (opts *Options) setTemplate(template string) error {
+	if opts.Template != "" {
+		return errors.New("multiple template arguments provided")
+	}
+	processed, err := ProcessTemplate(template)
+	if err != nil {
+		return err
+	}
+	opts.Template = processed
+	return nil
+}
+
+// asJMESPathFunctions converts a JMESPathFunction or []JMESPathFunction argument to a slice.
+func asJMESPathFunctions(arg any) ([]JMESPathFunction, bool) {
+	switch v := arg.(type) {
+	case JMESPathFunction:
+		return []JMESPathFunction{v}, true
+	case []JMESPathFunction:
+		return v, true
+	default:
+		return nil, false
+	}
+}
+
+// asCELFunctions converts a CELFunction or []CELFunction argument to a slice.
+func asCELFunctions(arg any) ([]CELFunction, bool) {
+	switch v := arg.(type) {
+	case CELFunction:
+		return []CELFunction{v}, true
+	case []CELFunction:
+		return v, true
+	default:
+		return nil, false
+	}
+}
+
 // applyDefaults applies defaults to the given options where needed.
 func applyDefaults(defaults, opts *Options) *Options {
 	// Nil checks
@@ -217,28 +671,59 @@ func applyDefaults(defaults, opts *Options) *Options {
 		opts.Interval = defaults.Interval
 	}
 
+	// Default field manager
+	if opts.FieldManager == "" {
+		opts.FieldManager = defaults.FieldManager
+	}
+
+	// Default delete options
+	if opts.PropagationPolicy == "" {
+		opts.PropagationPolicy = defaults.PropagationPolicy
+	}
+	if opts.GracePeriod == nil {
+		opts.GracePeriod = defaults.GracePeriod
+	}
+	if opts.RemoveFinalizersAfter == 0 {
+		opts.RemoveFinalizersAfter = defaults.RemoveFinalizersAfter
+	}
+
+	// Combine flags and ignore paths
+	opts.Flags |= defaults.Flags
+	if len(defaults.IgnorePaths) > 0 {
+		opts.IgnorePaths = append(append([]string{}, defaults.IgnorePaths...), opts.IgnorePaths...)
+	}
+
 	// Merge bindings
 	opts.Bindings = util.MergeMaps(defaults.Bindings, opts.Bindings)
 
+	// Combine custom functions
+	if len(defaults.JMESPathFunctions) > 0 {
+		opts.JMESPathFunctions = append(append([]JMESPathFunction{}, defaults.JMESPathFunctions...), opts.JMESPathFunctions...)
+	}
+	if len(defaults.CELFunctions) > 0 {
+		opts.CELFunctions = append(append([]CELFunction{}, defaults.CELFunctions...), opts.CELFunctions...)
+	}
+
 	return opts
 }
 
-// ParseAndApplyDefaults parses variable arguments into an Options struct
-// and applies defaults where needed.
-func ParseAndApplyDefaults(
-	defaults *Options,
-	includeVerbosity bool,
-	includeDurations bool,
-	includeObject bool,
-	includeObjects bool,
-	includeTemplate bool,
-	args ...any,
-) (*Options, error) {
-	opts, err := parse(includeVerbosity, includeDurations, includeObject, includeObjects, includeTemplate, args...)
+// ParseAndApplyDefaults parses variable arguments into an Options struct, accepting only the
+// settings selected by include, and applies defaults where needed.
+func ParseAndApplyDefaults(defaults *Options, include Include, args ...any) (*Options, error) {
+	opts, err := parse(include, args...)
 	if err != nil {
 		return nil, err
 	}
-	return applyDefaults(defaults, opts), nil
+	opts = applyDefaults(defaults, opts)
+	if include.Durations {
+		if err := RequireIntervalWithinTimeout(opts); err != nil {
+			return nil, err
+		}
+	}
+	if opts.RemoveFinalizersAfter != 0 && opts.Timeout != 0 && opts.RemoveFinalizersAfter >= opts.Timeout {
+		return nil, errors.New("finalizer removal window must be less than timeout")
+	}
+	return opts, nil
 }
 
 // RequireVerbosity requires option Verbosity to be provided.
@@ -266,6 +751,17 @@ func RequireDurations(opts *Options) error {
 	return nil
 }
 
+// RequireIntervalWithinTimeout requires option Interval not to be greater than option Timeout.
+func RequireIntervalWithinTimeout(opts *Options) error {
+	if opts == nil {
+		return errors.New(errNil)
+	}
+	if opts.Interval > opts.Timeout {
+		return fmt.Errorf("interval %v is greater than timeout %v", opts.Interval, opts.Timeout)
+	}
+	return nil
+}
+
 // RequireTemplate requires option Template to be provided.
 func RequireTemplate(opts *Options) error {
 	if opts == nil {
@@ -277,6 +773,17 @@ func RequireTemplate(opts *Options) error {
 	return nil
 }
 
+// RequireObject requires option Object to be provided.
+func RequireObject(opts *Options) error {
+	if opts == nil {
+		return errors.New(errNil)
+	}
+	if opts.Object == nil {
+		return errors.New(errRequired + ": Object (client.Object)")
+	}
+	return nil
+}
+
 // RequireTemplateObject requires options Template or Object to be provided.
 func RequireTemplateObject(opts *Options) error {
 	if opts == nil {
@@ -309,3 +816,21 @@ func RequireTemplateObjectObjects(opts *Options) error {
 	}
 	return nil
 }
+
+// RequireTemplateWithRetryOnConflict requires option Template to be provided if flag RetryOnConflict
+// is among args, since objects cannot be reapplied to the latest resource state. The flag is looked up
+// in args rather than opts so that a RetryOnConflict default does not reject updates with objects.
+func RequireTemplateWithRetryOnConflict(opts *Options, args ...any) error {
+	if opts == nil {
+		return errors.New(errNil)
+	}
+	if len(opts.Template) > 0 {
+		return nil
+	}
+	for _, arg := range args {
+		if flag, ok := arg.(Flag); ok && flag.Has(FlagRetryOnConflict) {
+			return errors.New("RetryOnConflict requires a template (objects cannot be reapplied to the latest resource state)")
+		}
+	}
+	return nil
+}
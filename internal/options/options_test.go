@@ -2,11 +2,14 @@ package options_test
 
 import (
 	"errors"
+	"fmt"
 	"time"
 
+	gocel "github.com/google/cel-go/cel"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/guidewire-oss/sawchain/internal/options"
@@ -45,6 +48,76 @@ var _ = Describe("Options", func() {
 		)
 	})
 
+	Describe("Flag", func() {
+		DescribeTable("String representation",
+			func(f options.Flag, expected string) {
+				Expect(f.String()).To(Equal(expected))
+			},
+			Entry("none", options.Flag(0), "none"),
+			Entry("auto cleanup", options.FlagAutoCleanup, "AutoCleanup"),
+			Entry("decode secrets", options.FlagDecodeSecrets, "DecodeSecrets"),
+			Entry("force conflicts", options.FlagForceConflicts, "ForceConflicts"),
+			Entry("json patch", options.FlagJSONPatch, "JSONPatch"),
+			Entry("retry on conflict", options.FlagRetryOnConflict, "RetryOnConflict"),
+			Entry("skip namespace wait", options.FlagSkipNamespaceWait, "SkipNamespaceWait"),
+			Entry("strict", options.FlagStrict, "Strict"),
+			Entry("update snapshots", options.FlagUpdateSnapshots, "UpdateSnapshots"),
+			Entry("combined", options.FlagAutoCleanup|options.FlagForceConflicts, "AutoCleanup|ForceConflicts"),
+			Entry("unknown", options.Flag(1<<31), "Flag(2147483648)"),
+		)
+
+		DescribeTable("Has",
+			func(f, other options.Flag, expected bool) {
+				Expect(f.Has(other)).To(Equal(expected))
+			},
+			Entry("set", options.FlagAutoCleanup, options.FlagAutoCleanup, true),
+			Entry("unset", options.Flag(0), options.FlagAutoCleanup, false),
+			Entry("zero", options.FlagAutoCleanup, options.Flag(0), false),
+			Entry("partial", options.FlagAutoCleanup, options.FlagAutoCleanup|options.FlagForceConflicts, false),
+		)
+	})
+
+	Describe("Count", func() {
+		DescribeTable("String representation",
+			func(c options.Count, expected string) {
+				Expect(c.String()).To(Equal(expected))
+			},
+			Entry("exact", options.Count{Min: 3, Max: 3}, "exactly 3"),
+			Entry("minimum", options.Count{Min: 1, Max: -1}, "at least 1"),
+			Entry("maximum", options.Count{Min: 0, Max: 5}, "at most 5"),
+			Entry("range", options.Count{Min: 1, Max: 3}, "between 1 and 3"),
+			Entry("none", options.Count{}, "exactly 0"),
+		)
+
+		DescribeTable("Allows",
+			func(c options.Count, n int, expected bool) {
+				Expect(c.Allows(n)).To(Equal(expected))
+			},
+			Entry("exact match", options.Count{Min: 3, Max: 3}, 3, true),
+			Entry("exact below", options.Count{Min: 3, Max: 3}, 2, false),
+			Entry("exact above", options.Count{Min: 3, Max: 3}, 4, false),
+			Entry("unbounded above", options.Count{Min: 1, Max: -1}, 100, true),
+			Entry("unbounded below minimum", options.Count{Min: 1, Max: -1}, 0, false),
+			Entry("range lower bound", options.Count{Min: 1, Max: 3}, 1, true),
+			Entry("range upper bound", options.Count{Min: 1, Max: 3}, 3, true),
+		)
+
+		DescribeTable("Validate",
+			func(c options.Count, expectedErr error) {
+				err := c.Validate()
+				if expectedErr != nil {
+					Expect(err).To(MatchError(expectedErr))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			},
+			Entry("valid exact", options.Count{Min: 0, Max: 0}, nil),
+			Entry("valid unbounded", options.Count{Min: 2, Max: -1}, nil),
+			Entry("negative minimum", options.Count{Min: -1, Max: 3}, errors.New("count minimum is negative")),
+			Entry("maximum less than minimum", options.Count{Min: 3, Max: 2}, errors.New("count maximum (2) is less than minimum (3)")),
+		)
+	})
+
 	Describe("ProcessTemplate", func() {
 		DescribeTable("processing templates",
 			func(template string, expectedContent string, expectedErrs []string) {
@@ -120,22 +193,34 @@ var _ = Describe("Options", func() {
 
 	Describe("ParseAndApplyDefaults", func() {
 		type testCase struct {
-			defaults         *options.Options
-			includeVerbosity bool
-			includeDurations bool
-			includeObject    bool
-			includeObjects   bool
-			includeTemplate  bool
-			args             []any
-			expectedOpts     *options.Options
-			expectedErr      error
+			defaults          *options.Options
+			includeVerbosity  bool
+			includeDurations  bool
+			includeObject     bool
+			includeObjects    bool
+			includeTemplate   bool
+			includeFieldMgr   bool
+			includeDeleteOpts bool
+			includeFuncs      bool
+			includeFlags      options.Flag
+			args              []any
+			expectedOpts      *options.Options
+			expectedErr       error
 		}
 
 		DescribeTable("parsing and applying defaults",
 			func(tc testCase) {
-				opts, err := options.ParseAndApplyDefaults(
-					tc.defaults, tc.includeVerbosity, tc.includeDurations, tc.includeObject,
-					tc.includeObjects, tc.includeTemplate, tc.args...)
+				opts, err := options.ParseAndApplyDefaults(tc.defaults, options.Include{
+					Verbosity:     tc.includeVerbosity,
+					Durations:     tc.includeDurations,
+					Object:        tc.includeObject,
+					Objects:       tc.includeObjects,
+					Template:      tc.includeTemplate,
+					FieldManager:  tc.includeFieldMgr,
+					DeleteOptions: tc.includeDeleteOpts,
+					Functions:     tc.includeFuncs,
+					Flags:         tc.includeFlags,
+				}, tc.args...)
 				if tc.expectedErr != nil {
 					Expect(err).To(MatchError(tc.expectedErr))
 					Expect(opts).To(BeNil())
@@ -239,6 +324,170 @@ var _ = Describe("Options", func() {
 				},
 				expectedErr: nil,
 			}),
+			Entry("with typed options", testCase{
+				defaults:         nil,
+				includeVerbosity: true,
+				includeDurations: true,
+				includeObject:    true,
+				includeTemplate:  true,
+				args: []any{
+					options.WithVerbosity(options.VerbosityVerbose),
+					options.WithInterval(two),
+					options.WithTimeout(ten),
+					options.WithObject(typedObj),
+					options.WithTemplate(templateContent),
+					options.WithBindings(bindings),
+				},
+				expectedOpts: &options.Options{
+					Verbosity: options.VerbosityVerbose,
+					Timeout:   ten,
+					Interval:  two,
+					Object:    typedObj,
+					Template:  sanitizedTemplateContent,
+					Bindings:  bindings,
+				},
+				expectedErr: nil,
+			}),
+			Entry("with typed objects", testCase{
+				defaults:       nil,
+				includeObjects: true,
+				args:           []any{options.WithObjects(objs...)},
+				expectedOpts:   &options.Options{Objects: objs, Bindings: map[string]any{}},
+				expectedErr:    nil,
+			}),
+			Entry("mixing typed options with plain arguments", testCase{
+				defaults:         defaults,
+				includeDurations: true,
+				includeTemplate:  true,
+				args:             []any{options.WithTimeout(ten), two, templateContent, options.WithBindings(overrideBindings), moreBindings},
+				expectedOpts: &options.Options{
+					Timeout:  ten,
+					Interval: two,
+					Template: sanitizedTemplateContent,
+					Bindings: map[string]any{"key1": "override", "key2": "value2", "key3": "value3"},
+				},
+				expectedErr: nil,
+			}),
+			Entry("typed interval with default timeout", testCase{
+				defaults:         defaults,
+				includeDurations: true,
+				args:             []any{options.WithInterval(two)},
+				expectedOpts:     &options.Options{Timeout: ten, Interval: two, Bindings: bindings},
+				expectedErr:      nil,
+			}),
+			Entry("error with typed interval greater than timeout", testCase{
+				defaults:         nil,
+				includeDurations: true,
+				args:             []any{options.WithInterval(ten), options.WithTimeout(two)},
+				expectedOpts:     nil,
+				expectedErr:      errors.New("provided interval is greater than timeout"),
+			}),
+			Entry("error with typed interval greater than default timeout", testCase{
+				defaults:         defaults,
+				includeDurations: true,
+				args:             []any{options.WithInterval(ten + one)},
+				expectedOpts:     nil,
+				expectedErr:      errors.New("interval 11s is greater than timeout 10s"),
+			}),
+			Entry("error with timeout less than default interval", testCase{
+				defaults:         defaults,
+				includeDurations: true,
+				args:             []any{options.WithTimeout(one / 2)},
+				expectedOpts:     nil,
+				expectedErr:      errors.New("interval 1s is greater than timeout 500ms"),
+			}),
+			Entry("error with zero typed timeout", testCase{
+				defaults:         nil,
+				includeDurations: true,
+				args:             []any{options.WithTimeout(0)},
+				expectedOpts:     nil,
+				expectedErr:      errors.New("provided timeout is zero"),
+			}),
+			Entry("error with negative typed interval", testCase{
+				defaults:         nil,
+				includeDurations: true,
+				args:             []any{options.WithInterval(-one)},
+				expectedOpts:     nil,
+				expectedErr:      errors.New("provided interval is negative"),
+			}),
+			Entry("error with typed timeout after plain timeout", testCase{
+				defaults:         nil,
+				includeDurations: true,
+				args:             []any{ten, options.WithTimeout(ten)},
+				expectedOpts:     nil,
+				expectedErr:      errors.New("multiple timeout arguments provided"),
+			}),
+			Entry("error with typed object and plain objects", testCase{
+				defaults:       nil,
+				includeObject:  true,
+				includeObjects: true,
+				args:           []any{objs, options.WithObject(typedObj)},
+				expectedOpts:   nil,
+				expectedErr:    errors.New("client.Object and []client.Object arguments both provided"),
+			}),
+			Entry("error with nil typed object", testCase{
+				defaults:      nil,
+				includeObject: true,
+				args:          []any{options.WithObject(nilObj)},
+				expectedOpts:  nil,
+				expectedErr:   errors.New("provided client.Object is nil or has a nil underlying value"),
+			}),
+			Entry("error with nil interface typed object", testCase{
+				defaults:      nil,
+				includeObject: true,
+				args:          []any{options.WithObject(nil)},
+				expectedOpts:  nil,
+				expectedErr:   errors.New("provided WithObject option has a nil client.Object"),
+			}),
+			Entry("error with nil element in typed objects", testCase{
+				defaults:       nil,
+				includeObjects: true,
+				args:           []any{options.WithObjects(typedObj, nil)},
+				expectedOpts:   nil,
+				expectedErr:    errors.New("provided []client.Object contains an element that is nil or has a nil underlying value"),
+			}),
+			Entry("typed nil bindings", testCase{
+				defaults:     nil,
+				args:         []any{options.WithBindings(nil)},
+				expectedOpts: &options.Options{Bindings: map[string]any{}},
+				expectedErr:  nil,
+			}),
+			Entry("error with typed template and plain template", testCase{
+				defaults:        nil,
+				includeTemplate: true,
+				args:            []any{options.WithTemplate(templateContent), templateContent},
+				expectedOpts:    nil,
+				expectedErr:     errors.New("multiple template arguments provided"),
+			}),
+			Entry("error with zero typed verbosity", testCase{
+				defaults:         nil,
+				includeVerbosity: true,
+				args:             []any{options.WithVerbosity(0)},
+				expectedOpts:     nil,
+				expectedErr:      errors.New("provided verbosity is zero"),
+			}),
+			Entry("error with zero option", testCase{
+				defaults:     nil,
+				args:         []any{options.Option{}},
+				expectedOpts: nil,
+				expectedErr:  errors.New("provided option is zero"),
+			}),
+			Entry("error with typed options when not included", testCase{
+				defaults:     nil,
+				args:         []any{options.WithBindings(bindings), options.WithTimeout(ten)},
+				expectedOpts: nil,
+				expectedErr:  errors.New("unsupported option argument: WithTimeout"),
+			}),
+			Entry("error with typed verbosity when not included", testCase{
+				defaults:         nil,
+				includeDurations: true,
+				includeObject:    true,
+				includeObjects:   true,
+				includeTemplate:  true,
+				args:             []any{options.WithVerbosity(options.VerbosityMinimal)},
+				expectedOpts:     nil,
+				expectedErr:      errors.New("unsupported option argument: WithVerbosity"),
+			}),
 			Entry("error with multiple templates", testCase{
 				defaults:         nil,
 				includeDurations: false,
@@ -452,7 +701,384 @@ var _ = Describe("Options", func() {
 				expectedOpts:     nil,
 				expectedErr:      errors.New("unexpected argument type: options.Verbosity"),
 			}),
+			Entry("with flag", testCase{
+				defaults:     nil,
+				includeFlags: options.FlagAutoCleanup,
+				args:         []any{options.FlagAutoCleanup},
+				expectedOpts: &options.Options{Flags: options.FlagAutoCleanup, Bindings: map[string]any{}},
+				expectedErr:  nil,
+			}),
+			Entry("combining flags with defaults", testCase{
+				defaults:     &options.Options{Flags: options.FlagAutoCleanup},
+				includeFlags: 0,
+				args:         []any{},
+				expectedOpts: &options.Options{Flags: options.FlagAutoCleanup, Bindings: map[string]any{}},
+				expectedErr:  nil,
+			}),
+			Entry("error with zero flag", testCase{
+				defaults:     nil,
+				includeFlags: options.FlagAutoCleanup,
+				args:         []any{options.Flag(0)},
+				expectedOpts: nil,
+				expectedErr:  errors.New("provided flag is zero"),
+			}),
+			Entry("with multiple flags", testCase{
+				defaults:     nil,
+				includeFlags: options.FlagForceConflicts | options.FlagRetryOnConflict,
+				args:         []any{options.FlagRetryOnConflict, options.FlagForceConflicts},
+				expectedOpts: &options.Options{
+					Flags:    options.FlagForceConflicts | options.FlagRetryOnConflict,
+					Bindings: map[string]any{},
+				},
+				expectedErr: nil,
+			}),
+			Entry("error with partially supported flags", testCase{
+				defaults:     nil,
+				includeFlags: options.FlagRetryOnConflict,
+				args:         []any{options.FlagRetryOnConflict | options.FlagJSONPatch},
+				expectedOpts: nil,
+				expectedErr:  errors.New("unsupported flag argument: JSONPatch"),
+			}),
+			Entry("error with flag when not included", testCase{
+				defaults:     nil,
+				includeFlags: 0,
+				args:         []any{options.FlagAutoCleanup},
+				expectedOpts: nil,
+				expectedErr:  errors.New("unsupported flag argument: AutoCleanup"),
+			}),
+			Entry("retry on conflict with object without template", testCase{
+				defaults:      nil,
+				includeObject: true,
+				includeFlags:  options.FlagRetryOnConflict,
+				args:          []any{options.FlagRetryOnConflict, typedObj},
+				expectedOpts: &options.Options{
+					Object:   typedObj,
+					Flags:    options.FlagRetryOnConflict,
+					Bindings: map[string]any{},
+				},
+				expectedErr: nil,
+			}),
+			Entry("with ignore paths", testCase{
+				defaults:     nil,
+				includeFlags: options.FlagStrict,
+				args:         []any{options.FlagStrict, options.IgnorePaths{"status"}, options.IgnorePaths{"metadata.labels"}},
+				expectedOpts: &options.Options{
+					Flags:       options.FlagStrict,
+					IgnorePaths: []string{"status", "metadata.labels"},
+					Bindings:    map[string]any{},
+				},
+				expectedErr: nil,
+			}),
+			Entry("combining ignore paths with defaults", testCase{
+				defaults:     &options.Options{Flags: options.FlagStrict, IgnorePaths: []string{"metadata.managedFields"}},
+				includeFlags: options.FlagStrict,
+				args:         []any{options.IgnorePaths{"status"}},
+				expectedOpts: &options.Options{
+					Flags:       options.FlagStrict,
+					IgnorePaths: []string{"metadata.managedFields", "status"},
+					Bindings:    map[string]any{},
+				},
+				expectedErr: nil,
+			}),
+			Entry("error with empty ignore paths", testCase{
+				defaults:     nil,
+				includeFlags: options.FlagStrict,
+				args:         []any{options.IgnorePaths{}},
+				expectedOpts: nil,
+				expectedErr:  errors.New("provided ignore paths are empty"),
+			}),
+			Entry("error with invalid ignore path", testCase{
+				defaults:     nil,
+				includeFlags: options.FlagStrict,
+				args:         []any{options.IgnorePaths{"status", "spec..replicas"}},
+				expectedOpts: nil,
+				expectedErr:  fmt.Errorf("invalid ignore path: %w", errors.New(`field path "spec..replicas" has an empty segment`)),
+			}),
+			Entry("error with ignore paths when strict not included", testCase{
+				defaults:     nil,
+				includeFlags: options.FlagRetryOnConflict,
+				args:         []any{options.IgnorePaths{"status"}},
+				expectedOpts: nil,
+				expectedErr:  errors.New("unexpected argument type: options.IgnorePaths"),
+			}),
+			Entry("with field manager", testCase{
+				defaults:        nil,
+				includeFieldMgr: true,
+				args:            []any{options.FieldManager("test-manager")},
+				expectedOpts:    &options.Options{FieldManager: "test-manager", Bindings: map[string]any{}},
+				expectedErr:     nil,
+			}),
+			Entry("default field manager", testCase{
+				defaults:        &options.Options{FieldManager: "default-manager"},
+				includeFieldMgr: true,
+				args:            []any{},
+				expectedOpts:    &options.Options{FieldManager: "default-manager", Bindings: map[string]any{}},
+				expectedErr:     nil,
+			}),
+			Entry("overriding default field manager", testCase{
+				defaults:        &options.Options{FieldManager: "default-manager"},
+				includeFieldMgr: true,
+				args:            []any{options.FieldManager("test-manager")},
+				expectedOpts:    &options.Options{FieldManager: "test-manager", Bindings: map[string]any{}},
+				expectedErr:     nil,
+			}),
+			Entry("error with empty field manager", testCase{
+				defaults:        nil,
+				includeFieldMgr: true,
+				args:            []any{options.FieldManager("")},
+				expectedOpts:    nil,
+				expectedErr:     errors.New("provided field manager is empty"),
+			}),
+			Entry("error with multiple field manager arguments", testCase{
+				defaults:        nil,
+				includeFieldMgr: true,
+				args:            []any{options.FieldManager("a"), options.FieldManager("b")},
+				expectedOpts:    nil,
+				expectedErr:     errors.New("multiple field manager arguments provided"),
+			}),
+			Entry("error with field manager when not included", testCase{
+				defaults:        nil,
+				includeFieldMgr: false,
+				args:            []any{options.FieldManager("test-manager")},
+				expectedOpts:    nil,
+				expectedErr:     errors.New("unexpected argument type: options.FieldManager"),
+			}),
+			Entry("with delete options", testCase{
+				defaults:          nil,
+				includeDurations:  true,
+				includeDeleteOpts: true,
+				args: []any{
+					metav1.DeletePropagationForeground,
+					options.GracePeriod(ten),
+					options.RemoveFinalizersAfter(two),
+				},
+				expectedOpts: &options.Options{
+					PropagationPolicy:     metav1.DeletePropagationForeground,
+					GracePeriod:           &ten,
+					RemoveFinalizersAfter: two,
+					Bindings:              map[string]any{},
+				},
+				expectedErr: nil,
+			}),
+			Entry("with zero grace period", testCase{
+				defaults:          nil,
+				includeDeleteOpts: true,
+				args:              []any{options.GracePeriod(0)},
+				expectedOpts:      &options.Options{GracePeriod: new(time.Duration), Bindings: map[string]any{}},
+				expectedErr:       nil,
+			}),
+			Entry("default delete options", testCase{
+				defaults: &options.Options{
+					Timeout:               ten,
+					PropagationPolicy:     metav1.DeletePropagationBackground,
+					GracePeriod:           &one,
+					RemoveFinalizersAfter: two,
+				},
+				includeDurations:  true,
+				includeDeleteOpts: true,
+				args:              []any{},
+				expectedOpts: &options.Options{
+					Timeout:               ten,
+					PropagationPolicy:     metav1.DeletePropagationBackground,
+					GracePeriod:           &one,
+					RemoveFinalizersAfter: two,
+					Bindings:              map[string]any{},
+				},
+				expectedErr: nil,
+			}),
+			Entry("overriding default delete options", testCase{
+				defaults: &options.Options{
+					Timeout:               ten,
+					PropagationPolicy:     metav1.DeletePropagationBackground,
+					GracePeriod:           &one,
+					RemoveFinalizersAfter: two,
+				},
+				includeDurations:  true,
+				includeDeleteOpts: true,
+				args: []any{
+					metav1.DeletePropagationOrphan,
+					options.GracePeriod(two),
+					options.RemoveFinalizersAfter(one),
+				},
+				expectedOpts: &options.Options{
+					Timeout:               ten,
+					PropagationPolicy:     metav1.DeletePropagationOrphan,
+					GracePeriod:           &two,
+					RemoveFinalizersAfter: one,
+					Bindings:              map[string]any{},
+				},
+				expectedErr: nil,
+			}),
+			Entry("error with unsupported propagation policy", testCase{
+				defaults:          nil,
+				includeDeleteOpts: true,
+				args:              []any{metav1.DeletionPropagation("Eventually")},
+				expectedOpts:      nil,
+				expectedErr:       errors.New(`unsupported propagation policy: "Eventually"`),
+			}),
+			Entry("error with multiple propagation policy arguments", testCase{
+				defaults:          nil,
+				includeDeleteOpts: true,
+				args:              []any{metav1.DeletePropagationForeground, metav1.DeletePropagationOrphan},
+				expectedOpts:      nil,
+				expectedErr:       errors.New("multiple propagation policy arguments provided"),
+			}),
+			Entry("error with negative grace period", testCase{
+				defaults:          nil,
+				includeDeleteOpts: true,
+				args:              []any{options.GracePeriod(-one)},
+				expectedOpts:      nil,
+				expectedErr:       errors.New("provided grace period is negative"),
+			}),
+			Entry("error with fractional grace period", testCase{
+				defaults:          nil,
+				includeDeleteOpts: true,
+				args:              []any{options.GracePeriod(1500 * time.Millisecond)},
+				expectedOpts:      nil,
+				expectedErr:       errors.New("provided grace period is not a whole number of seconds"),
+			}),
+			Entry("error with multiple grace period arguments", testCase{
+				defaults:          nil,
+				includeDeleteOpts: true,
+				args:              []any{options.GracePeriod(one), options.GracePeriod(two)},
+				expectedOpts:      nil,
+				expectedErr:       errors.New("multiple grace period arguments provided"),
+			}),
+			Entry("error with zero finalizer removal window", testCase{
+				defaults:          nil,
+				includeDurations:  true,
+				includeDeleteOpts: true,
+				args:              []any{options.RemoveFinalizersAfter(0)},
+				expectedOpts:      nil,
+				expectedErr:       errors.New("provided finalizer removal window is zero"),
+			}),
+			Entry("error with negative finalizer removal window", testCase{
+				defaults:          nil,
+				includeDurations:  true,
+				includeDeleteOpts: true,
+				args:              []any{options.RemoveFinalizersAfter(-one)},
+				expectedOpts:      nil,
+				expectedErr:       errors.New("provided finalizer removal window is negative"),
+			}),
+			Entry("error with multiple finalizer removal window arguments", testCase{
+				defaults:          nil,
+				includeDurations:  true,
+				includeDeleteOpts: true,
+				args:              []any{options.RemoveFinalizersAfter(one), options.RemoveFinalizersAfter(two)},
+				expectedOpts:      nil,
+				expectedErr:       errors.New("multiple finalizer removal window arguments provided"),
+			}),
+			Entry("error with finalizer removal window not less than timeout", testCase{
+				defaults:          &options.Options{Timeout: ten},
+				includeDurations:  true,
+				includeDeleteOpts: true,
+				args:              []any{two, options.RemoveFinalizersAfter(two)},
+				expectedOpts:      nil,
+				expectedErr:       errors.New("finalizer removal window must be less than timeout"),
+			}),
+			Entry("error with finalizer removal window without durations", testCase{
+				defaults:          nil,
+				includeDeleteOpts: true,
+				args:              []any{options.RemoveFinalizersAfter(one)},
+				expectedOpts:      nil,
+				expectedErr:       errors.New("unexpected argument type: options.RemoveFinalizersAfter"),
+			}),
+			Entry("error with delete options when not included", testCase{
+				defaults:          nil,
+				includeDeleteOpts: false,
+				args:              []any{metav1.DeletePropagationForeground},
+				expectedOpts:      nil,
+				expectedErr:       errors.New("unexpected argument type: v1.DeletionPropagation"),
+			}),
 		)
+
+		Describe("custom functions", func() {
+			handler := func(args []any) (any, error) { return args[0], nil }
+			jpFunc := func(name string) options.JMESPathFunction {
+				return options.JMESPathFunction{Name: name, Handler: handler}
+			}
+			celFunc := options.CELFunction(gocel.Variable("x", gocel.StringType))
+
+			type testCase struct {
+				defaults          *options.Options
+				includeFuncs      bool
+				args              []any
+				expectedJPNames   []string
+				expectedCELLength int
+				expectedErr       error
+			}
+
+			DescribeTable("parsing custom functions",
+				func(tc testCase) {
+					opts, err := options.ParseAndApplyDefaults(tc.defaults, options.Include{Functions: tc.includeFuncs}, tc.args...)
+					if tc.expectedErr != nil {
+						Expect(err).To(MatchError(tc.expectedErr))
+						Expect(opts).To(BeNil())
+						return
+					}
+					Expect(err).NotTo(HaveOccurred())
+					var names []string
+					for _, fn := range opts.JMESPathFunctions {
+						names = append(names, fn.Name)
+					}
+					Expect(names).To(Equal(tc.expectedJPNames))
+					Expect(opts.CELFunctions).To(HaveLen(tc.expectedCELLength))
+				},
+				Entry("no functions", testCase{
+					includeFuncs: true,
+				}),
+				Entry("single functions", testCase{
+					includeFuncs:      true,
+					args:              []any{jpFunc("a"), celFunc},
+					expectedJPNames:   []string{"a"},
+					expectedCELLength: 1,
+				}),
+				Entry("slices of functions combined in order", testCase{
+					includeFuncs:      true,
+					args:              []any{[]options.JMESPathFunction{jpFunc("a"), jpFunc("b")}, jpFunc("c"), []options.CELFunction{celFunc, celFunc}},
+					expectedJPNames:   []string{"a", "b", "c"},
+					expectedCELLength: 2,
+				}),
+				Entry("default functions combined with provided functions", testCase{
+					defaults:          &options.Options{JMESPathFunctions: []options.JMESPathFunction{jpFunc("a")}, CELFunctions: []options.CELFunction{celFunc}},
+					includeFuncs:      true,
+					args:              []any{jpFunc("b")},
+					expectedJPNames:   []string{"a", "b"},
+					expectedCELLength: 1,
+				}),
+				Entry("default functions when not included", testCase{
+					defaults:          &options.Options{JMESPathFunctions: []options.JMESPathFunction{jpFunc("a")}},
+					includeFuncs:      false,
+					expectedJPNames:   []string{"a"},
+					expectedCELLength: 0,
+				}),
+				Entry("error with unnamed JMESPath function", testCase{
+					includeFuncs: true,
+					args:         []any{options.JMESPathFunction{Handler: handler}},
+					expectedErr:  errors.New("provided JMESPath function has no name"),
+				}),
+				Entry("error with JMESPath function without handler", testCase{
+					includeFuncs: true,
+					args:         []any{[]options.JMESPathFunction{jpFunc("a"), {Name: "b"}}},
+					expectedErr:  errors.New("provided JMESPath function b has no handler"),
+				}),
+				Entry("error with nil CEL function", testCase{
+					includeFuncs: true,
+					args:         []any{[]options.CELFunction{nil}},
+					expectedErr:  errors.New("provided CEL function is nil"),
+				}),
+				Entry("error with JMESPath function when not included", testCase{
+					includeFuncs: false,
+					args:         []any{jpFunc("a")},
+					expectedErr:  errors.New("unexpected argument type: functions.FunctionEntry"),
+				}),
+				Entry("error with CEL function when not included", testCase{
+					includeFuncs: false,
+					args:         []any{celFunc},
+					expectedErr:  errors.New("unexpected argument type: cel.EnvOption"),
+				}),
+			)
+		})
 	})
 
 	Describe("RequireVerbosity", func() {
@@ -508,6 +1134,31 @@ var _ = Describe("Options", func() {
 		)
 	})
 
+	Describe("RequireIntervalWithinTimeout", func() {
+		DescribeTable("requiring interval within timeout",
+			func(opts *options.Options, expectedErr error) {
+				err := options.RequireIntervalWithinTimeout(opts)
+				if expectedErr != nil {
+					Expect(err).To(MatchError(expectedErr))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			},
+			Entry("interval less than timeout",
+				&options.Options{Timeout: ten, Interval: one},
+				nil),
+			Entry("interval equal to timeout",
+				&options.Options{Timeout: one, Interval: one},
+				nil),
+			Entry("interval greater than timeout",
+				&options.Options{Timeout: one, Interval: ten},
+				fmt.Errorf("interval %v is greater than timeout %v", ten, one)),
+			Entry("nil options",
+				nil,
+				errors.New("options is nil")),
+		)
+	})
+
 	Describe("RequireTemplate", func() {
 		DescribeTable("requiring template",
 			func(opts *options.Options, expectedErr error) {
@@ -530,6 +1181,28 @@ var _ = Describe("Options", func() {
 		)
 	})
 
+	Describe("RequireObject", func() {
+		DescribeTable("requiring object",
+			func(opts *options.Options, expectedErr error) {
+				err := options.RequireObject(opts)
+				if expectedErr != nil {
+					Expect(err).To(MatchError(expectedErr))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			},
+			Entry("valid object",
+				&options.Options{Object: typedObj},
+				nil),
+			Entry("missing object",
+				&options.Options{Template: templateContent},
+				errors.New("required argument(s) not provided: Object (client.Object)")),
+			Entry("nil options",
+				nil,
+				errors.New("options is nil")),
+		)
+	})
+
 	Describe("RequireTemplateObject", func() {
 		DescribeTable("requiring template or object",
 			func(opts *options.Options, expectedErr error) {
@@ -616,4 +1289,40 @@ var _ = Describe("Options", func() {
 				errors.New("options is nil")),
 		)
 	})
+	Describe("RequireTemplateWithRetryOnConflict", func() {
+		DescribeTable("requiring template with retry on conflict",
+			func(opts *options.Options, args []any, expectedErr error) {
+				err := options.RequireTemplateWithRetryOnConflict(opts, args...)
+				if expectedErr != nil {
+					Expect(err).To(MatchError(expectedErr))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+				}
+			},
+			Entry("template with flag argument",
+				&options.Options{Template: templateContent, Flags: options.FlagRetryOnConflict},
+				[]any{options.FlagRetryOnConflict, templateContent},
+				nil),
+			Entry("object without flag argument",
+				&options.Options{Object: typedObj},
+				[]any{typedObj},
+				nil),
+			Entry("object with flag default only",
+				&options.Options{Object: typedObj, Flags: options.FlagRetryOnConflict},
+				[]any{typedObj},
+				nil),
+			Entry("object with flag argument",
+				&options.Options{Object: typedObj, Flags: options.FlagRetryOnConflict},
+				[]any{options.FlagRetryOnConflict, typedObj},
+				errors.New("RetryOnConflict requires a template (objects cannot be reapplied to the latest resource state)")),
+			Entry("objects with combined flag argument",
+				&options.Options{Objects: objs, Flags: options.FlagRetryOnConflict | options.FlagStrict},
+				[]any{options.FlagRetryOnConflict | options.FlagStrict, objs},
+				errors.New("RetryOnConflict requires a template (objects cannot be reapplied to the latest resource state)")),
+			Entry("nil options",
+				nil,
+				nil,
+				errors.New("options is nil")),
+		)
+	})
 })
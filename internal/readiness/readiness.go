@@ -0,0 +1,347 @@
+// Package readiness computes whether Kubernetes resources are ready, following the
+// semantics of the kstatus library (sigs.k8s.io/cli-utils/pkg/kstatus): built-in workload,
+// batch, storage, and API extension kinds have dedicated rules, and any other resource is
+// ready once it is reconciled and its Ready condition (if any) is True.
+package readiness
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/guidewire-oss/sawchain/internal/chainsaw"
+)
+
+// Result describes whether a resource is ready, and why not.
+type Result struct {
+	// Whether the resource is ready.
+	Ready bool
+	// Why the resource is not ready (empty if ready).
+	Reason string
+}
+
+// ready is the Result of a ready resource.
+var ready = Result{Ready: true}
+
+// notReady returns the Result of a resource that is not ready for the given reason.
+func notReady(format string, args ...any) Result {
+	return Result{Reason: fmt.Sprintf(format, args...)}
+}
+
+// kindRules maps group kinds with dedicated readiness rules to their rule.
+var kindRules = map[schema.GroupKind]func(obj unstructured.Unstructured) Result{
+	{Group: "apps", Kind: "Deployment"}:                               deploymentReadiness,
+	{Group: "apps", Kind: "StatefulSet"}:                              statefulSetReadiness,
+	{Group: "apps", Kind: "DaemonSet"}:                                daemonSetReadiness,
+	{Group: "apps", Kind: "ReplicaSet"}:                               replicaSetReadiness,
+	{Group: "batch", Kind: "Job"}:                                     jobReadiness,
+	{Group: "", Kind: "Pod"}:                                          podReadiness,
+	{Group: "", Kind: "PersistentVolumeClaim"}:                        pvcReadiness,
+	{Group: "", Kind: "Service"}:                                      serviceReadiness,
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}: crdReadiness,
+	{Group: "apiregistration.k8s.io", Kind: "APIService"}:             apiServiceReadiness,
+	{Group: "policy", Kind: "PodDisruptionBudget"}:                    podDisruptionBudgetReadiness,
+}
+
+// Compute returns the readiness of the resource. Resources being deleted, or whose
+// status.observedGeneration is behind metadata.generation, are never ready. Otherwise:
+//
+//   - Deployment, StatefulSet, DaemonSet, ReplicaSet: the rollout is complete, i.e. all desired
+//     replicas are updated, ready, and available, and no old replicas remain.
+//   - Job: the Complete condition is True.
+//   - Pod: the pod succeeded, or is running with a True Ready condition.
+//   - PersistentVolumeClaim: the claim is Bound.
+//   - Service: LoadBalancer services have an ingress assigned.
+//   - CustomResourceDefinition: the Established condition is True.
+//   - APIService: the Available condition is True.
+//   - PodDisruptionBudget: the current number of healthy pods reaches the desired number.
+//   - Any other resource: no Stalled or Reconciling condition is True, and the Ready condition (if
+//     present) is True and not older than the current generation.
+func Compute(obj unstructured.Unstructured) Result {
+	if obj.GetDeletionTimestamp() != nil {
+		return notReady("resource is being deleted")
+	}
+	if observed, found := nestedInt(obj.Object, "status", "observedGeneration"); found && observed < obj.GetGeneration() {
+		return notReady("status.observedGeneration %d is behind generation %d", observed, obj.GetGeneration())
+	}
+	if rule, ok := kindRules[obj.GroupVersionKind().GroupKind()]; ok {
+		return rule(obj)
+	}
+	return genericReadiness(obj)
+}
+
+// genericReadiness applies the standard condition conventions shared by most controllers.
+func genericReadiness(obj unstructured.Unstructured) Result {
+	if condition, ok := getCondition(obj, "Stalled"); ok && conditionStatus(condition) == "True" {
+		return notReady("Stalled condition is True%s", conditionDetail(condition))
+	}
+	if condition, ok := getCondition(obj, "Reconciling"); ok && conditionStatus(condition) == "True" {
+		return notReady("Reconciling condition is True%s", conditionDetail(condition))
+	}
+	condition, ok := getCondition(obj, "Ready")
+	if !ok {
+		return ready
+	}
+	if status := conditionStatus(condition); status != "True" {
+		return notReady("Ready condition is %s%s", status, conditionDetail(condition))
+	}
+	if observed, found := nestedInt(condition, "observedGeneration"); found && observed < obj.GetGeneration() {
+		return notReady("Ready condition observedGeneration %d is behind generation %d", observed, obj.GetGeneration())
+	}
+	return ready
+}
+
+func deploymentReadiness(obj unstructured.Unstructured) Result {
+	if condition, ok := getCondition(obj, "Progressing"); ok && condition["reason"] == "ProgressDeadlineExceeded" {
+		return notReady("progress deadline exceeded%s", conditionDetail(condition))
+	}
+	replicas := specReplicas(obj)
+	updated, _ := nestedInt(obj.Object, "status", "updatedReplicas")
+	total, _ := nestedInt(obj.Object, "status", "replicas")
+	available, _ := nestedInt(obj.Object, "status", "availableReplicas")
+	readyReplicas, _ := nestedInt(obj.Object, "status", "readyReplicas")
+	switch {
+	case updated < replicas:
+		return notReady("%d of %d replicas updated", updated, replicas)
+	case total > updated:
+		return notReady("%d old replicas pending termination", total-updated)
+	case available < updated:
+		return notReady("%d of %d updated replicas available", available, updated)
+	case readyReplicas < replicas:
+		return notReady("%d of %d replicas ready", readyReplicas, replicas)
+	}
+	return ready
+}
+
+func statefulSetReadiness(obj unstructured.Unstructured) Result {
+	replicas := specReplicas(obj)
+	readyReplicas, _ := nestedInt(obj.Object, "status", "readyReplicas")
+	if readyReplicas < replicas {
+		return notReady("%d of %d replicas ready", readyReplicas, replicas)
+	}
+	strategy, _, _ := unstructured.NestedString(obj.Object, "spec", "updateStrategy", "type")
+	if strategy == "OnDelete" {
+		return ready
+	}
+	partition, _ := nestedInt(obj.Object, "spec", "updateStrategy", "rollingUpdate", "partition")
+	updated, _ := nestedInt(obj.Object, "status", "updatedReplicas")
+	if expected := replicas - partition; updated < expected {
+		return notReady("%d of %d replicas updated", updated, expected)
+	}
+	if partition == 0 {
+		current, _, _ := unstructured.NestedString(obj.Object, "status", "currentRevision")
+		update, _, _ := unstructured.NestedString(obj.Object, "status", "updateRevision")
+		if current != update {
+			return notReady("rollout from revision %q to %q in progress", current, update)
+		}
+	}
+	return ready
+}
+
+func daemonSetReadiness(obj unstructured.Unstructured) Result {
+	desired, found := nestedInt(obj.Object, "status", "desiredNumberScheduled")
+	if !found {
+		return notReady("status.desiredNumberScheduled not yet reported")
+	}
+	updated, _ := nestedInt(obj.Object, "status", "updatedNumberScheduled")
+	available, _ := nestedInt(obj.Object, "status", "numberAvailable")
+	readyPods, _ := nestedInt(obj.Object, "status", "numberReady")
+	switch {
+	case updated < desired:
+		return notReady("%d of %d pods updated", updated, desired)
+	case available < desired:
+		return notReady("%d of %d pods available", available, desired)
+	case readyPods < desired:
+		return notReady("%d of %d pods ready", readyPods, desired)
+	}
+	return ready
+}
+
+func replicaSetReadiness(obj unstructured.Unstructured) Result {
+	replicas := specReplicas(obj)
+	readyReplicas, _ := nestedInt(obj.Object, "status", "readyReplicas")
+	available, _ := nestedInt(obj.Object, "status", "availableReplicas")
+	switch {
+	case readyReplicas < replicas:
+		return notReady("%d of %d replicas ready", readyReplicas, replicas)
+	case available < replicas:
+		return notReady("%d of %d replicas available", available, replicas)
+	}
+	return ready
+}
+
+func jobReadiness(obj unstructured.Unstructured) Result {
+	if condition, ok := getCondition(obj, "Failed"); ok && conditionStatus(condition) == "True" {
+		return notReady("job failed%s", conditionDetail(condition))
+	}
+	if condition, ok := getCondition(obj, "Complete"); ok && conditionStatus(condition) == "True" {
+		return ready
+	}
+	succeeded, _ := nestedInt(obj.Object, "status", "succeeded")
+	active, _ := nestedInt(obj.Object, "status", "active")
+	return notReady("job has not completed (%d active, %d succeeded)", active, succeeded)
+}
+
+func podReadiness(obj unstructured.Unstructured) Result {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	switch phase {
+	case "Succeeded":
+		return ready
+	case "Running":
+		if condition, ok := getCondition(obj, "Ready"); ok && conditionStatus(condition) == "True" {
+			return ready
+		}
+		return notReady("pod is running but not ready")
+	case "":
+		return notReady("pod phase not yet reported")
+	}
+	return notReady("pod phase is %s", phase)
+}
+
+func pvcReadiness(obj unstructured.Unstructured) Result {
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	if phase != "Bound" {
+		if phase == "" {
+			phase = "not yet reported"
+		}
+		return notReady("claim phase is %s", phase)
+	}
+	return ready
+}
+
+func serviceReadiness(obj unstructured.Unstructured) Result {
+	serviceType, _, _ := unstructured.NestedString(obj.Object, "spec", "type")
+	if serviceType != "LoadBalancer" {
+		return ready
+	}
+	ingress, _, _ := unstructured.NestedSlice(obj.Object, "status", "loadBalancer", "ingress")
+	if len(ingress) == 0 {
+		return notReady("load balancer ingress not yet assigned")
+	}
+	return ready
+}
+
+func crdReadiness(obj unstructured.Unstructured) Result {
+	if condition, ok := getCondition(obj, "NamesAccepted"); ok && conditionStatus(condition) == "False" {
+		return notReady("names not accepted%s", conditionDetail(condition))
+	}
+	if condition, ok := getCondition(obj, "Established"); ok && conditionStatus(condition) == "True" {
+		return ready
+	}
+	return notReady("Established condition is not True")
+}
+
+func apiServiceReadiness(obj unstructured.Unstructured) Result {
+	condition, ok := getCondition(obj, "Available")
+	if !ok || conditionStatus(condition) != "True" {
+		return notReady("Available condition is not True%s", conditionDetail(condition))
+	}
+	return ready
+}
+
+func podDisruptionBudgetReadiness(obj unstructured.Unstructured) Result {
+	desired, _ := nestedInt(obj.Object, "status", "desiredHealthy")
+	current, _ := nestedInt(obj.Object, "status", "currentHealthy")
+	if current < desired {
+		return notReady("%d of %d desired pods healthy", current, desired)
+	}
+	return ready
+}
+
+// specReplicas returns spec.replicas, which defaults to 1 for workload kinds.
+func specReplicas(obj unstructured.Unstructured) int64 {
+	if replicas, found := nestedInt(obj.Object, "spec", "replicas"); found {
+		return replicas
+	}
+	return 1
+}
+
+// nestedInt returns the integer at the given path, accepting any numeric representation.
+func nestedInt(obj map[string]any, fields ...string) (int64, bool) {
+	value, found, err := unstructured.NestedFieldNoCopy(obj, fields...)
+	if err != nil || !found {
+		return 0, false
+	}
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case int32:
+		return int64(v), true
+	case int:
+		return int64(v), true
+	case float64:
+		return int64(v), true
+	}
+	return 0, false
+}
+
+// getCondition returns the status condition of the given type.
+func getCondition(obj unstructured.Unstructured, conditionType string) (map[string]any, bool) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if ok && condition["type"] == conditionType {
+			return condition, true
+		}
+	}
+	return nil, false
+}
+
+// conditionStatus returns the status of the condition.
+func conditionStatus(condition map[string]any) string {
+	status, _ := condition["status"].(string)
+	return status
+}
+
+// conditionDetail renders the reason and message of the condition (if any) as a suffix.
+func conditionDetail(condition map[string]any) string {
+	var parts []string
+	if reason, _ := condition["reason"].(string); reason != "" {
+		parts = append(parts, reason)
+	}
+	if message, _ := condition["message"].(string); message != "" {
+		parts = append(parts, message)
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, ": ") + ")"
+}
+
+// NotReady records a resource that is not ready.
+type NotReady struct {
+	Resource unstructured.Unstructured
+	Reason   string
+}
+
+// NotReadyError is a structured error describing resources that are not ready.
+type NotReadyError struct {
+	NotReady  []NotReady
+	Resources int
+}
+
+// Error implements the error interface, listing each resource that is not ready with its reason.
+func (e *NotReadyError) Error() string {
+	lines := []string{fmt.Sprintf("%d of %d resources not ready:", len(e.NotReady), e.Resources)}
+	for _, nr := range e.NotReady {
+		lines = append(lines, fmt.Sprintf("* %s: %s", chainsaw.ResourceID(nr.Resource), nr.Reason))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Check computes the readiness of every resource and returns a *NotReadyError if any of them
+// is not ready.
+func Check(objs []unstructured.Unstructured) error {
+	var notReadyObjs []NotReady
+	for _, obj := range objs {
+		if result := Compute(obj); !result.Ready {
+			notReadyObjs = append(notReadyObjs, NotReady{Resource: obj, Reason: result.Reason})
+		}
+	}
+	if len(notReadyObjs) == 0 {
+		return nil
+	}
+	return &NotReadyError{NotReady: notReadyObjs, Resources: len(objs)}
+}
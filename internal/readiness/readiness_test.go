@@ -0,0 +1,616 @@
+package readiness_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/guidewire-oss/sawchain/internal/readiness"
+)
+
+// fromYAML parses a YAML manifest into an unstructured object.
+func fromYAML(manifest string) unstructured.Unstructured {
+	data, err := yaml.YAMLToJSON([]byte(manifest))
+	Expect(err).NotTo(HaveOccurred())
+	obj := unstructured.Unstructured{}
+	Expect(obj.UnmarshalJSON(data)).To(Succeed())
+	return obj
+}
+
+var _ = Describe("Compute", func() {
+	type testCase struct {
+		manifest       string
+		expectedReady  bool
+		expectedReason string
+	}
+
+	DescribeTable("computing readiness",
+		func(tc testCase) {
+			result := readiness.Compute(fromYAML(tc.manifest))
+			Expect(result.Ready).To(Equal(tc.expectedReady))
+			Expect(result.Reason).To(Equal(tc.expectedReason))
+		},
+
+		// Common rules
+		Entry("resource being deleted", testCase{
+			manifest: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+  deletionTimestamp: "2025-01-01T00:00:00Z"
+`,
+			expectedReason: "resource is being deleted",
+		}),
+		Entry("observedGeneration behind generation", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test
+  generation: 3
+status:
+  observedGeneration: 2
+`,
+			expectedReason: "status.observedGeneration 2 is behind generation 3",
+		}),
+
+		// Generic resources
+		Entry("resource without status", testCase{
+			manifest: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: test
+`,
+			expectedReady: true,
+		}),
+		Entry("custom resource with True Ready condition", testCase{
+			manifest: `
+apiVersion: example.com/v1
+kind: TestResource
+metadata:
+  name: test
+  generation: 2
+status:
+  conditions:
+  - type: Ready
+    status: "True"
+    observedGeneration: 2
+`,
+			expectedReady: true,
+		}),
+		Entry("custom resource with False Ready condition", testCase{
+			manifest: `
+apiVersion: example.com/v1
+kind: TestResource
+metadata:
+  name: test
+status:
+  conditions:
+  - type: Ready
+    status: "False"
+    reason: Waiting
+    message: dependency missing
+`,
+			expectedReason: "Ready condition is False (Waiting: dependency missing)",
+		}),
+		Entry("custom resource with stale Ready condition", testCase{
+			manifest: `
+apiVersion: example.com/v1
+kind: TestResource
+metadata:
+  name: test
+  generation: 2
+status:
+  conditions:
+  - type: Ready
+    status: "True"
+    observedGeneration: 1
+`,
+			expectedReason: "Ready condition observedGeneration 1 is behind generation 2",
+		}),
+		Entry("custom resource with True Reconciling condition", testCase{
+			manifest: `
+apiVersion: example.com/v1
+kind: TestResource
+metadata:
+  name: test
+status:
+  conditions:
+  - type: Reconciling
+    status: "True"
+    reason: Progressing
+`,
+			expectedReason: "Reconciling condition is True (Progressing)",
+		}),
+		Entry("custom resource with True Stalled condition", testCase{
+			manifest: `
+apiVersion: example.com/v1
+kind: TestResource
+metadata:
+  name: test
+status:
+  conditions:
+  - type: Stalled
+    status: "True"
+    message: invalid spec
+  - type: Ready
+    status: "True"
+`,
+			expectedReason: "Stalled condition is True (invalid spec)",
+		}),
+
+		// Deployment
+		Entry("deployment rollout complete", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test
+  generation: 1
+spec:
+  replicas: 2
+status:
+  observedGeneration: 1
+  replicas: 2
+  updatedReplicas: 2
+  readyReplicas: 2
+  availableReplicas: 2
+`,
+			expectedReady: true,
+		}),
+		Entry("deployment with replicas not yet updated", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test
+spec:
+  replicas: 3
+status:
+  replicas: 3
+  updatedReplicas: 1
+`,
+			expectedReason: "1 of 3 replicas updated",
+		}),
+		Entry("deployment with old replicas pending termination", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test
+spec:
+  replicas: 2
+status:
+  replicas: 3
+  updatedReplicas: 2
+`,
+			expectedReason: "1 old replicas pending termination",
+		}),
+		Entry("deployment with updated replicas not available", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test
+status:
+  replicas: 1
+  updatedReplicas: 1
+  readyReplicas: 1
+`,
+			expectedReason: "0 of 1 updated replicas available",
+		}),
+		Entry("deployment with exceeded progress deadline", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: test
+status:
+  conditions:
+  - type: Progressing
+    status: "False"
+    reason: ProgressDeadlineExceeded
+`,
+			expectedReason: "progress deadline exceeded (ProgressDeadlineExceeded)",
+		}),
+
+		// StatefulSet
+		Entry("statefulset rollout complete", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: test
+spec:
+  replicas: 2
+status:
+  readyReplicas: 2
+  updatedReplicas: 2
+  currentRevision: test-1
+  updateRevision: test-1
+`,
+			expectedReady: true,
+		}),
+		Entry("statefulset with replicas not ready", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: test
+spec:
+  replicas: 2
+status:
+  readyReplicas: 1
+`,
+			expectedReason: "1 of 2 replicas ready",
+		}),
+		Entry("statefulset with partitioned rollout", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: test
+spec:
+  replicas: 3
+  updateStrategy:
+    type: RollingUpdate
+    rollingUpdate:
+      partition: 2
+status:
+  readyReplicas: 3
+  updatedReplicas: 1
+  currentRevision: test-1
+  updateRevision: test-2
+`,
+			expectedReady: true,
+		}),
+		Entry("statefulset with revision rollout in progress", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: test
+spec:
+  replicas: 1
+status:
+  readyReplicas: 1
+  updatedReplicas: 1
+  currentRevision: test-1
+  updateRevision: test-2
+`,
+			expectedReason: `rollout from revision "test-1" to "test-2" in progress`,
+		}),
+		Entry("statefulset with OnDelete strategy", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: test
+spec:
+  replicas: 1
+  updateStrategy:
+    type: OnDelete
+status:
+  readyReplicas: 1
+  currentRevision: test-1
+  updateRevision: test-2
+`,
+			expectedReady: true,
+		}),
+
+		// DaemonSet
+		Entry("daemonset rollout complete", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: test
+status:
+  desiredNumberScheduled: 2
+  updatedNumberScheduled: 2
+  numberAvailable: 2
+  numberReady: 2
+`,
+			expectedReady: true,
+		}),
+		Entry("daemonset with pods not available", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: test
+status:
+  desiredNumberScheduled: 2
+  updatedNumberScheduled: 2
+  numberAvailable: 1
+`,
+			expectedReason: "1 of 2 pods available",
+		}),
+		Entry("daemonset without status", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: test
+`,
+			expectedReason: "status.desiredNumberScheduled not yet reported",
+		}),
+
+		// ReplicaSet
+		Entry("replicaset with replicas not available", testCase{
+			manifest: `
+apiVersion: apps/v1
+kind: ReplicaSet
+metadata:
+  name: test
+spec:
+  replicas: 2
+status:
+  readyReplicas: 2
+  availableReplicas: 1
+`,
+			expectedReason: "1 of 2 replicas available",
+		}),
+
+		// Job
+		Entry("completed job", testCase{
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: test
+status:
+  succeeded: 1
+  conditions:
+  - type: Complete
+    status: "True"
+`,
+			expectedReady: true,
+		}),
+		Entry("running job", testCase{
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: test
+status:
+  active: 1
+`,
+			expectedReason: "job has not completed (1 active, 0 succeeded)",
+		}),
+		Entry("failed job", testCase{
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: test
+status:
+  conditions:
+  - type: Failed
+    status: "True"
+    reason: BackoffLimitExceeded
+    message: Job has reached the specified backoff limit
+`,
+			expectedReason: "job failed (BackoffLimitExceeded: Job has reached the specified backoff limit)",
+		}),
+
+		// Pod
+		Entry("running and ready pod", testCase{
+			manifest: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: test
+status:
+  phase: Running
+  conditions:
+  - type: Ready
+    status: "True"
+`,
+			expectedReady: true,
+		}),
+		Entry("running pod that is not ready", testCase{
+			manifest: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: test
+status:
+  phase: Running
+  conditions:
+  - type: Ready
+    status: "False"
+`,
+			expectedReason: "pod is running but not ready",
+		}),
+		Entry("pending pod", testCase{
+			manifest: `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: test
+status:
+  phase: Pending
+`,
+			expectedReason: "pod phase is Pending",
+		}),
+
+		// PersistentVolumeClaim
+		Entry("bound claim", testCase{
+			manifest: `
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: test
+status:
+  phase: Bound
+`,
+			expectedReady: true,
+		}),
+		Entry("pending claim", testCase{
+			manifest: `
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata:
+  name: test
+status:
+  phase: Pending
+`,
+			expectedReason: "claim phase is Pending",
+		}),
+
+		// Service
+		Entry("cluster IP service", testCase{
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: test
+spec:
+  type: ClusterIP
+`,
+			expectedReady: true,
+		}),
+		Entry("load balancer service without ingress", testCase{
+			manifest: `
+apiVersion: v1
+kind: Service
+metadata:
+  name: test
+spec:
+  type: LoadBalancer
+`,
+			expectedReason: "load balancer ingress not yet assigned",
+		}),
+
+		// CustomResourceDefinition
+		Entry("established CRD", testCase{
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: tests.example.com
+status:
+  conditions:
+  - type: NamesAccepted
+    status: "True"
+  - type: Established
+    status: "True"
+`,
+			expectedReady: true,
+		}),
+		Entry("CRD with conflicting names", testCase{
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: tests.example.com
+status:
+  conditions:
+  - type: NamesAccepted
+    status: "False"
+    reason: PluralConflict
+`,
+			expectedReason: "names not accepted (PluralConflict)",
+		}),
+		Entry("CRD not yet established", testCase{
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: tests.example.com
+`,
+			expectedReason: "Established condition is not True",
+		}),
+
+		// APIService
+		Entry("unavailable APIService", testCase{
+			manifest: `
+apiVersion: apiregistration.k8s.io/v1
+kind: APIService
+metadata:
+  name: v1.example.com
+status:
+  conditions:
+  - type: Available
+    status: "False"
+    reason: MissingEndpoints
+`,
+			expectedReason: "Available condition is not True (MissingEndpoints)",
+		}),
+
+		// PodDisruptionBudget
+		Entry("PodDisruptionBudget with unhealthy pods", testCase{
+			manifest: `
+apiVersion: policy/v1
+kind: PodDisruptionBudget
+metadata:
+  name: test
+status:
+  desiredHealthy: 2
+  currentHealthy: 1
+`,
+			expectedReason: "1 of 2 desired pods healthy",
+		}),
+	)
+})
+
+var _ = Describe("Check", func() {
+	readyConfigMap := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: ready
+  namespace: default
+`
+	pendingPod := `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: pending
+  namespace: default
+status:
+  phase: Pending
+`
+	runningJob := `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: running
+  namespace: default
+status:
+  active: 1
+`
+
+	It("returns nil when all resources are ready", func() {
+		Expect(readiness.Check([]unstructured.Unstructured{fromYAML(readyConfigMap)})).To(Succeed())
+	})
+
+	It("returns nil for no resources", func() {
+		Expect(readiness.Check(nil)).To(Succeed())
+	})
+
+	It("reports every resource that is not ready with its reason", func() {
+		err := readiness.Check([]unstructured.Unstructured{
+			fromYAML(readyConfigMap),
+			fromYAML(pendingPod),
+			fromYAML(runningJob),
+		})
+		Expect(err).To(HaveOccurred())
+
+		var notReadyErr *readiness.NotReadyError
+		Expect(err).To(BeAssignableToTypeOf(notReadyErr))
+		notReadyErr = err.(*readiness.NotReadyError)
+		Expect(notReadyErr.Resources).To(Equal(3))
+		Expect(notReadyErr.NotReady).To(HaveLen(2))
+		Expect(notReadyErr.NotReady[0].Reason).To(Equal("pod phase is Pending"))
+
+		Expect(err.Error()).To(Equal(
+			"2 of 3 resources not ready:\n" +
+				"* v1/Pod/default/pending: pod phase is Pending\n" +
+				"* batch/v1/Job/default/running: job has not completed (1 active, 0 succeeded)"))
+	})
+})
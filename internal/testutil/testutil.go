@@ -106,6 +106,16 @@ func NewStandardFakeClientWithTestResource() client.Client {
 	return fake.NewClientBuilder().WithScheme(NewStandardSchemeWithTestResource()).Build()
 }
 
+// NewStandardFakeClientWithTestResourceStatus returns a new fake client with a
+// standard runtime.scheme supporting built-in APIs and the custom TestResource type,
+// with the status subresource enabled for TestResource.
+func NewStandardFakeClientWithTestResourceStatus() client.Client {
+	return fake.NewClientBuilder().
+		WithScheme(NewStandardSchemeWithTestResource()).
+		WithStatusSubresource(&TestResource{}).
+		Build()
+}
+
 // NewConfigMap returns a typed ConfigMap
 // with the given name, namespace, and data.
 func NewConfigMap(
@@ -1,6 +1,7 @@
 package testutil_test
 
 import (
+	"context"
 	"os"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -170,6 +171,42 @@ var _ = Describe("Testutil", func() {
 		Entry("creates standard fake client with TestResource"),
 	)
 
+	DescribeTable("NewStandardFakeClientWithTestResourceStatus",
+		func() {
+			c := testutil.NewStandardFakeClientWithTestResourceStatus()
+			Expect(c).NotTo(BeNil())
+
+			// Verify TestResource is registered
+			obj, err := c.Scheme().New(schema.GroupVersionKind{
+				Group:   "example.com",
+				Version: "v1",
+				Kind:    "TestResource",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj).To(BeAssignableToTypeOf(&testutil.TestResource{}))
+
+			// Verify status subresource is enabled
+			ctx := context.Background()
+			tr := testutil.NewTestResource("test-cr", "default", "original")
+			Expect(c.Create(ctx, tr)).To(Succeed())
+			tr.Data = "updated"
+			tr.Status.Conditions = []metav1.Condition{{
+				Type:               "Ready",
+				Status:             metav1.ConditionTrue,
+				Reason:             "Testing",
+				LastTransitionTime: metav1.Now(),
+			}}
+			Expect(c.Status().Update(ctx, tr)).To(Succeed())
+
+			// Verify only status was updated
+			actual := &testutil.TestResource{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(tr), actual)).To(Succeed())
+			Expect(actual.Data).To(Equal("original"))
+			Expect(actual.Status.Conditions).To(HaveLen(1))
+		},
+		Entry("creates standard fake client with TestResource status subresource"),
+	)
+
 	DescribeTable("NewConfigMap",
 		func(name, namespace string, data map[string]string) {
 			cm := testutil.NewConfigMap(name, namespace, data)
@@ -1,6 +1,7 @@
 package util
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,8 @@ import (
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
 	"gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -326,6 +329,91 @@ func GetResourceID(obj client.Object, scheme *runtime.Scheme) string {
 	return fmt.Sprintf("%s (%s)", kind, keyString)
 }
 
+// ReferencesOwner reports whether the owner reference points at the given owner with the given
+// GroupVersionKind. API versions are compared by group only, since owner references may record
+// any served version of the owner, and UIDs are compared only if both are set.
+func ReferencesOwner(ref metav1.OwnerReference, owner client.Object, ownerGVK schema.GroupVersionKind) bool {
+	refGV, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return false
+	}
+	if refGV.Group != ownerGVK.Group || ref.Kind != ownerGVK.Kind || ref.Name != owner.GetName() {
+		return false
+	}
+	return ref.UID == "" || owner.GetUID() == "" || ref.UID == owner.GetUID()
+}
+
+// ReferencesObject reports whether the object reference (e.g. the involvedObject of an Event) points at
+// the given object with the given GroupVersionKind. If both UIDs are set, they alone decide; otherwise API
+// versions are compared by group only, along with kind, namespace, and name.
+func ReferencesObject(ref corev1.ObjectReference, obj client.Object, objGVK schema.GroupVersionKind) bool {
+	if ref.UID != "" && obj.GetUID() != "" {
+		return ref.UID == obj.GetUID()
+	}
+	refGV, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return false
+	}
+	return refGV.Group == objGVK.Group && ref.Kind == objGVK.Kind &&
+		ref.Namespace == obj.GetNamespace() && ref.Name == obj.GetName()
+}
+
+// IsSecret reports whether the unstructured object is a core/v1 Secret.
+func IsSecret(obj unstructured.Unstructured) bool {
+	gvk := obj.GroupVersionKind()
+	return gvk.Group == "" && gvk.Version == "v1" && gvk.Kind == "Secret"
+}
+
+// DecodeSecretData returns a copy of the object with the values of its data field base64-decoded,
+// so that they read like stringData. Objects other than Secrets are returned unchanged.
+func DecodeSecretData(obj unstructured.Unstructured) (unstructured.Unstructured, error) {
+	return transformSecretData(obj, func(key string, value any) (any, error) {
+		encoded, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("data.%s: expected base64 string, got %T", key, value)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("data.%s: invalid base64: %w", key, err)
+		}
+		return string(decoded), nil
+	})
+}
+
+// EncodeSecretData returns a copy of the object with the values of its data field base64-encoded,
+// reversing DecodeSecretData. Objects other than Secrets are returned unchanged.
+func EncodeSecretData(obj unstructured.Unstructured) (unstructured.Unstructured, error) {
+	return transformSecretData(obj, func(key string, value any) (any, error) {
+		decoded, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("data.%s: expected string, got %T", key, value)
+		}
+		return base64.StdEncoding.EncodeToString([]byte(decoded)), nil
+	})
+}
+
+// transformSecretData returns a copy of the Secret with fn applied to each value of its data field.
+func transformSecretData(
+	obj unstructured.Unstructured,
+	fn func(key string, value any) (any, error),
+) (unstructured.Unstructured, error) {
+	data, ok := obj.Object["data"].(map[string]any)
+	if !IsSecret(obj) || !ok {
+		return obj, nil
+	}
+	transformed := make(map[string]any, len(data))
+	for key, value := range data {
+		v, err := fn(key, value)
+		if err != nil {
+			return unstructured.Unstructured{}, err
+		}
+		transformed[key] = v
+	}
+	result := *obj.DeepCopy()
+	result.Object["data"] = transformed
+	return result, nil
+}
+
 // DeindentYAML removes the common leading whitespace prefix from all non-empty lines of a YAML string,
 // and discards lines that are entirely empty or contain only whitespace.
 func DeindentYAML(yamlStr string) string {
@@ -429,3 +517,58 @@ func PruneYAML(yamlStr string) (string, error) {
 	}
 	return strings.Join(docs, "\n---\n"), nil
 }
+
+// ParseFieldPath splits a field path such as "spec.containers[0].image" or
+// "metadata.annotations[example.com/key]" into its segments. Dots separate
+// segments, and brackets enclose segments that are list indices or contain dots.
+func ParseFieldPath(path string) ([]string, error) {
+	if path == "" {
+		return nil, errors.New("field path is empty")
+	}
+	var segments []string
+	var current strings.Builder
+	// Whether the current segment must be followed by a separator (after a bracketed segment)
+	closed := false
+	for i := 0; i < len(path); i++ {
+		switch c := path[i]; c {
+		case '.':
+			if current.Len() == 0 && !closed {
+				return nil, fmt.Errorf("field path %q has an empty segment", path)
+			}
+			if !closed {
+				segments = append(segments, current.String())
+			}
+			current.Reset()
+			closed = false
+		case '[':
+			if current.Len() > 0 {
+				segments = append(segments, current.String())
+				current.Reset()
+			} else if i > 0 && !closed {
+				return nil, fmt.Errorf("field path %q has an empty segment", path)
+			}
+			end := strings.IndexByte(path[i+1:], ']')
+			if end < 0 {
+				return nil, fmt.Errorf("field path %q has an unclosed bracket", path)
+			} else if end == 0 {
+				return nil, fmt.Errorf("field path %q has an empty segment", path)
+			}
+			segments = append(segments, path[i+1:i+1+end])
+			i += end + 1
+			closed = true
+		case ']':
+			return nil, fmt.Errorf("field path %q has an unopened bracket", path)
+		default:
+			if closed {
+				return nil, fmt.Errorf("field path %q is missing a separator after a bracket", path)
+			}
+			current.WriteByte(c)
+		}
+	}
+	if current.Len() > 0 {
+		segments = append(segments, current.String())
+	} else if !closed {
+		return nil, fmt.Errorf("field path %q has an empty segment", path)
+	}
+	return segments, nil
+}
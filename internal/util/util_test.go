@@ -1341,6 +1341,217 @@ var _ = Describe("Util", func() {
 		)
 	})
 
+	Describe("ReferencesOwner", func() {
+		owner := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "owner", Namespace: "default", UID: "owner-uid"},
+		}
+		ownerGVK := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+
+		type testCase struct {
+			ref      metav1.OwnerReference
+			owner    client.Object
+			gvk      schema.GroupVersionKind
+			expected bool
+		}
+
+		DescribeTable("matching owner references",
+			func(tc testCase) {
+				Expect(util.ReferencesOwner(tc.ref, tc.owner, tc.gvk)).To(Equal(tc.expected))
+			},
+			Entry("matching reference", testCase{
+				ref:      metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "owner", UID: "owner-uid"},
+				owner:    owner,
+				gvk:      ownerGVK,
+				expected: true,
+			}),
+			Entry("reference without UID", testCase{
+				ref:      metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "owner"},
+				owner:    owner,
+				gvk:      ownerGVK,
+				expected: true,
+			}),
+			Entry("owner without UID", testCase{
+				ref:      metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "owner", UID: "other-uid"},
+				owner:    &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "owner"}},
+				gvk:      ownerGVK,
+				expected: true,
+			}),
+			Entry("reference to another version of the same group", testCase{
+				ref:      metav1.OwnerReference{APIVersion: "apps/v1beta2", Kind: "Deployment", Name: "owner"},
+				owner:    owner,
+				gvk:      schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"},
+				expected: true,
+			}),
+			Entry("different UID", testCase{
+				ref:      metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "owner", UID: "other-uid"},
+				owner:    owner,
+				gvk:      ownerGVK,
+				expected: false,
+			}),
+			Entry("different name", testCase{
+				ref:      metav1.OwnerReference{APIVersion: "v1", Kind: "ConfigMap", Name: "other"},
+				owner:    owner,
+				gvk:      ownerGVK,
+				expected: false,
+			}),
+			Entry("different kind", testCase{
+				ref:      metav1.OwnerReference{APIVersion: "v1", Kind: "Secret", Name: "owner"},
+				owner:    owner,
+				gvk:      ownerGVK,
+				expected: false,
+			}),
+			Entry("different group", testCase{
+				ref:      metav1.OwnerReference{APIVersion: "example.com/v1", Kind: "ConfigMap", Name: "owner"},
+				owner:    owner,
+				gvk:      ownerGVK,
+				expected: false,
+			}),
+			Entry("invalid API version", testCase{
+				ref:      metav1.OwnerReference{APIVersion: "a/b/c", Kind: "ConfigMap", Name: "owner"},
+				owner:    owner,
+				gvk:      ownerGVK,
+				expected: false,
+			}),
+		)
+	})
+
+	Describe("ReferencesObject", func() {
+		obj := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default", UID: "test-uid"},
+		}
+		objGVK := schema.GroupVersionKind{Version: "v1", Kind: "ConfigMap"}
+
+		type testCase struct {
+			ref      corev1.ObjectReference
+			obj      client.Object
+			expected bool
+		}
+
+		DescribeTable("matching object references",
+			func(tc testCase) {
+				Expect(util.ReferencesObject(tc.ref, tc.obj, objGVK)).To(Equal(tc.expected))
+			},
+			Entry("matching UID", testCase{
+				ref:      corev1.ObjectReference{UID: "test-uid"},
+				obj:      obj,
+				expected: true,
+			}),
+			Entry("different UID", testCase{
+				ref:      corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "test", UID: "other-uid"},
+				obj:      obj,
+				expected: false,
+			}),
+			Entry("matching reference without UID", testCase{
+				ref:      corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "test"},
+				obj:      obj,
+				expected: true,
+			}),
+			Entry("matching reference to object without UID", testCase{
+				ref:      corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "default", Name: "test", UID: "other-uid"},
+				obj:      &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"}},
+				expected: true,
+			}),
+			Entry("different namespace", testCase{
+				ref:      corev1.ObjectReference{APIVersion: "v1", Kind: "ConfigMap", Namespace: "other", Name: "test"},
+				obj:      obj,
+				expected: false,
+			}),
+			Entry("different kind", testCase{
+				ref:      corev1.ObjectReference{APIVersion: "v1", Kind: "Secret", Namespace: "default", Name: "test"},
+				obj:      obj,
+				expected: false,
+			}),
+			Entry("invalid API version", testCase{
+				ref:      corev1.ObjectReference{APIVersion: "a/b/c", Kind: "ConfigMap", Namespace: "default", Name: "test"},
+				obj:      obj,
+				expected: false,
+			}),
+		)
+	})
+
+	Describe("DecodeSecretData and EncodeSecretData", func() {
+		secret := func(data any) unstructured.Unstructured {
+			obj := unstructured.Unstructured{Object: map[string]any{
+				"apiVersion": "v1",
+				"kind":       "Secret",
+				"metadata":   map[string]any{"name": "test", "namespace": "default"},
+			}}
+			if data != nil {
+				obj.Object["data"] = data
+			}
+			return obj
+		}
+
+		type testCase struct {
+			obj         unstructured.Unstructured
+			decode      bool
+			expected    unstructured.Unstructured
+			expectedErr string
+		}
+
+		DescribeTable("transforming Secret data",
+			func(tc testCase) {
+				original := tc.obj.DeepCopy()
+				var result unstructured.Unstructured
+				var err error
+				if tc.decode {
+					result, err = util.DecodeSecretData(tc.obj)
+				} else {
+					result, err = util.EncodeSecretData(tc.obj)
+				}
+				if tc.expectedErr != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(tc.expectedErr))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result).To(Equal(tc.expected))
+				}
+				Expect(tc.obj).To(Equal(*original), "input object was modified")
+			},
+			Entry("decoding Secret data", testCase{
+				obj:      secret(map[string]any{"username": "YWRtaW4=", "password": "czNjcjN0"}),
+				decode:   true,
+				expected: secret(map[string]any{"username": "admin", "password": "s3cr3t"}),
+			}),
+			Entry("encoding Secret data", testCase{
+				obj:      secret(map[string]any{"username": "admin", "password": "s3cr3t"}),
+				expected: secret(map[string]any{"username": "YWRtaW4=", "password": "czNjcjN0"}),
+			}),
+			Entry("Secret without data", testCase{
+				obj:      secret(nil),
+				decode:   true,
+				expected: secret(nil),
+			}),
+			Entry("non-Secret object", testCase{
+				obj: unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"data":       map[string]any{"key": "not base64!"},
+				}},
+				decode: true,
+				expected: unstructured.Unstructured{Object: map[string]any{
+					"apiVersion": "v1",
+					"kind":       "ConfigMap",
+					"data":       map[string]any{"key": "not base64!"},
+				}},
+			}),
+			Entry("decoding invalid base64", testCase{
+				obj:         secret(map[string]any{"password": "not base64!"}),
+				decode:      true,
+				expectedErr: "data.password: invalid base64",
+			}),
+			Entry("decoding non-string value", testCase{
+				obj:         secret(map[string]any{"port": int64(8080)}),
+				decode:      true,
+				expectedErr: "data.port: expected base64 string, got int64",
+			}),
+			Entry("encoding non-string value", testCase{
+				obj:         secret(map[string]any{"port": int64(8080)}),
+				expectedErr: "data.port: expected string, got int64",
+			}),
+		)
+	})
+
 	Describe("DeindentYAML", func() {
 		type testCase struct {
 			input    string
@@ -1639,4 +1850,77 @@ data:
 			}),
 		)
 	})
+
+	Describe("ParseFieldPath", func() {
+		type testCase struct {
+			path             string
+			expectedSegments []string
+			expectedErr      string
+		}
+
+		DescribeTable("splitting field paths into segments",
+			func(tc testCase) {
+				segments, err := util.ParseFieldPath(tc.path)
+				if tc.expectedErr != "" {
+					Expect(err).To(HaveOccurred())
+					Expect(err.Error()).To(ContainSubstring(tc.expectedErr))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(segments).To(Equal(tc.expectedSegments))
+				}
+			},
+			Entry("single segment", testCase{
+				path:             "status",
+				expectedSegments: []string{"status"},
+			}),
+			Entry("dotted segments", testCase{
+				path:             "metadata.managedFields",
+				expectedSegments: []string{"metadata", "managedFields"},
+			}),
+			Entry("indexed segments", testCase{
+				path:             "spec.containers[0].image",
+				expectedSegments: []string{"spec", "containers", "0", "image"},
+			}),
+			Entry("wildcard segments", testCase{
+				path:             "spec.containers[*].env.*",
+				expectedSegments: []string{"spec", "containers", "*", "env", "*"},
+			}),
+			Entry("bracketed keys containing dots", testCase{
+				path:             "metadata.annotations[example.com/key]",
+				expectedSegments: []string{"metadata", "annotations", "example.com/key"},
+			}),
+			Entry("consecutive brackets", testCase{
+				path:             "matrix[0][1]",
+				expectedSegments: []string{"matrix", "0", "1"},
+			}),
+			Entry("empty path", testCase{
+				path:        "",
+				expectedErr: "field path is empty",
+			}),
+			Entry("empty segment", testCase{
+				path:        "metadata..name",
+				expectedErr: "has an empty segment",
+			}),
+			Entry("trailing separator", testCase{
+				path:        "metadata.",
+				expectedErr: "has an empty segment",
+			}),
+			Entry("empty brackets", testCase{
+				path:        "spec.containers[]",
+				expectedErr: "has an empty segment",
+			}),
+			Entry("unclosed bracket", testCase{
+				path:        "spec.containers[0",
+				expectedErr: "has an unclosed bracket",
+			}),
+			Entry("unopened bracket", testCase{
+				path:        "spec.containers]",
+				expectedErr: "has an unopened bracket",
+			}),
+			Entry("missing separator after bracket", testCase{
+				path:        "spec.containers[0]image",
+				expectedErr: "is missing a separator after a bracket",
+			}),
+		)
+	})
 })
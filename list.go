@@ -5,6 +5,7 @@ import (
 
 	"github.com/onsi/gomega"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/guidewire-oss/sawchain/internal/chainsaw"
@@ -34,7 +35,8 @@ import (
 //   - When the scheme supports the resource type, typed objects are returned.
 //     Otherwise, unstructured objects are returned.
 //
-//   - Use ListFunc if you need to create a List function for polling.
+//   - Use ListFunc if you need to create a List function for polling, or ListAndWait if you need to wait
+//     for a number of matches with Sawchain's durations.
 //
 // # Examples
 //
@@ -86,7 +88,7 @@ func (s *Sawchain) List(ctx context.Context, template string, bindings ...map[st
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
 
 	// Render template
-	expected, err := chainsaw.RenderTemplateSingle(ctx, template, b)
+	expected, err := chainsaw.RenderTemplateSingle(ctx, template, b, s.funcs)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 	// List candidates from cluster
@@ -102,7 +104,7 @@ func (s *Sawchain) List(ctx context.Context, template string, bindings ...map[st
 	}
 
 	// Match candidates against expectation
-	matches, err := chainsaw.MatchAll(ctx, candidates, expected, b)
+	matches, err := chainsaw.MatchAll(ctx, candidates, expected, b, s.funcs, s.selectOptions(&s.opts))
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedMatch)
 
 	// Convert matches to client.Object slice
@@ -134,7 +136,7 @@ func (s *Sawchain) ListFunc(ctx context.Context, template string, bindings ...ma
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
 
 	// Render template
-	expected, err := chainsaw.RenderTemplateSingle(ctx, template, b)
+	expected, err := chainsaw.RenderTemplateSingle(ctx, template, b, s.funcs)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 	return func() []client.Object {
@@ -153,7 +155,7 @@ func (s *Sawchain) ListFunc(ctx context.Context, template string, bindings ...ma
 		}
 
 		// Match candidates against expectation
-		matches, err := chainsaw.MatchAll(ctx, candidates, expected, b)
+		matches, err := chainsaw.MatchAll(ctx, candidates, expected, b, s.funcs, s.selectOptions(&s.opts))
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedMatch)
 
 		// Convert matches to client.Object slice
@@ -165,3 +167,106 @@ func (s *Sawchain) ListFunc(ctx context.Context, template string, bindings ...ma
 		return result
 	}
 }
+
+// ListAndWait retrieves all resources matching YAML expectations defined in a template once the number of
+// matches satisfies a count constraint within a configurable duration.
+//
+// # Arguments
+//
+// The count constraint is required and must be provided before any other arguments. Create it with Exactly,
+// AtLeast, AtMost, or Between.
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the count:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template
+//     containing type metadata and expectations of resources to list. Must contain exactly one resource
+//     expectation document.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Timeout (string or time.Duration): Duration within which the number of matches should satisfy the
+//     count constraint. If provided, must be before interval. Defaults to Sawchain's global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for listing the resources. If provided, must be
+//     after timeout. Defaults to Sawchain's global interval value.
+//
+// # Notes
+//
+//   - Invalid input (including an unsatisfiable count constraint) and timeout errors will result in
+//     immediate test failure.
+//
+//   - Matches are counted the same way as in CheckCount. On timeout, the failure message includes the
+//     count error from the last attempt, whose detail level follows the Sawchain instance's configured
+//     Verbosity.
+//
+//   - When the scheme supports the resource type, typed objects are returned.
+//     Otherwise, unstructured objects are returned.
+//
+// # Examples
+//
+// Wait for 3 Pods with specific labels to be Ready:
+//
+//	pods := sc.ListAndWait(ctx, sawchain.Exactly(3), `
+//	  apiVersion: v1
+//	  kind: Pod
+//	  metadata:
+//	    namespace: ($namespace)
+//	    labels:
+//	      app: myapp
+//	  status:
+//	    (conditions[?type == 'Ready']):
+//	    - status: 'True'
+//	  `, map[string]any{"namespace": "default"})
+//
+// Wait up to a minute for at least one warning Event:
+//
+//	events := sc.ListAndWait(ctx, sawchain.AtLeast(1), "1m", `
+//	  apiVersion: events.k8s.io/v1
+//	  kind: Event
+//	  metadata:
+//	    namespace: default
+//	  type: Warning
+//	`)
+func (s *Sawchain) ListAndWait(ctx context.Context, count Count, args ...any) []client.Object {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Template: true}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(count.Validate()).To(gomega.Succeed(), errInvalidArgs)
+
+	// Create bindings
+	bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+
+	// Validate template
+	_, err = chainsaw.RenderTemplateSingle(ctx, opts.Template, bindings, s.funcs)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+
+	// Wait for count to be satisfied
+	var matches []unstructured.Unstructured
+	countMatches := func() error {
+		var err error
+		matches, err = chainsaw.CheckCount(s.c, ctx, opts.Template, bindings, s.funcs, count, s.selectOptions(opts))
+		if err != nil {
+			return formatMatchError(err, s.opts.Verbosity, opts.Template, bindings)
+		}
+		return nil
+	}
+	s.g.Eventually(countMatches, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errListNotSatisfied)
+
+	// Convert matches to client.Object slice
+	result := make([]client.Object, len(matches))
+	for i, match := range matches {
+		result[i] = s.convertReturnObject(match)
+	}
+
+	return result
+}
@@ -2,6 +2,7 @@ package sawchain_test
 
 import (
 	"reflect"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -17,6 +18,7 @@ var _ = Describe("List and ListFunc", func() {
 		resourcesYaml       string           // Resources to create before test
 		client              client.Client    // K8s client (fake or mock)
 		globalBindings      map[string]any   // Sawchain global bindings
+		globalArgs          []any            // Sawchain global args
 		template            string           // Template arg for List/ListFunc
 		bindings            []map[string]any // Bindings args for List/ListFunc
 		expectedFailureLogs []string         // Expected test failure logs
@@ -33,7 +35,7 @@ var _ = Describe("List and ListFunc", func() {
 			BeforeEach(func() {
 				// Initialize Sawchain
 				t = &MockT{TB: GinkgoTB()}
-				sc = sawchain.New(t, tc.client, tc.globalBindings)
+				sc = sawchain.New(t, tc.client, append([]any{tc.globalBindings}, tc.globalArgs...)...)
 
 				// Create resources
 				if tc.resourcesYaml != "" {
@@ -152,6 +154,37 @@ var _ = Describe("List and ListFunc", func() {
 			},
 		}),
 
+		Entry("returns partial template matches with strict instance", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: cm-1
+				  namespace: default
+				data:
+				  key: value1
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: cm-2
+				  namespace: other-ns
+				data:
+				  key: value2
+				`,
+			client:     testutil.NewStandardFakeClient(),
+			globalArgs: []any{sawchain.Strict},
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  namespace: default
+				`,
+			expectedObjs: []client.Object{
+				testutil.NewConfigMap("cm-1", "default", map[string]string{"key": "value1"}),
+			},
+		}),
+
 		Entry("returns matching resources in specific namespace", testCase{
 			resourcesYaml: `
 				apiVersion: v1
@@ -748,3 +781,197 @@ metadata:
 		}),
 	)
 })
+
+var _ = Describe("ListAndWait", func() {
+	type testCase struct {
+		resourcesYaml       string
+		delayedYaml         string
+		client              client.Client
+		count               sawchain.Count
+		globalArgs          []any
+		methodArgs          []any
+		expectedFailureLogs []string
+		expectedNames       []string
+	}
+
+	const templateWithLabels = `
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  namespace: default
+		  labels:
+		    app: test
+	`
+
+	DescribeTable("waiting for a number of matching resources",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, append([]any{fastTimeout, fastInterval}, tc.globalArgs...)...)
+
+			// Create resources
+			if tc.resourcesYaml != "" {
+				sc.CreateAndWait(ctx, tc.resourcesYaml)
+			}
+
+			// Create delayed resources while waiting
+			if tc.delayedYaml != "" {
+				go func() {
+					defer GinkgoRecover()
+					time.Sleep(fastTimeout / 4)
+					sawchain.New(GinkgoTB(), tc.client).CreateAndWait(ctx, tc.delayedYaml)
+				}()
+			}
+
+			// Test ListAndWait
+			var matches []client.Object
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				matches = sc.ListAndWait(ctx, tc.count, tc.methodArgs...)
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+				return
+			}
+			Expect(t.Failed()).To(BeFalse(), "expected no failure")
+
+			// Verify matches
+			names := make([]string, len(matches))
+			for i, match := range matches {
+				names[i] = match.GetName()
+			}
+			Expect(names).To(ConsistOf(tc.expectedNames))
+		},
+
+		// Success cases
+		Entry("should return matches satisfying count immediately", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				  labels:
+				    app: test
+			`,
+			client:        testutil.NewStandardFakeClient(),
+			count:         sawchain.Exactly(1),
+			methodArgs:    []any{templateWithLabels},
+			expectedNames: []string{"test-cm1"},
+		}),
+
+		Entry("should return partial template matches with strict instance", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				  labels:
+				    app: test
+				data:
+				  key: value
+			`,
+			client:        testutil.NewStandardFakeClient(),
+			count:         sawchain.Exactly(1),
+			globalArgs:    []any{sawchain.Strict},
+			methodArgs:    []any{templateWithLabels},
+			expectedNames: []string{"test-cm1"},
+		}),
+
+		Entry("should return matches once count is satisfied while waiting", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				  labels:
+				    app: test
+			`,
+			delayedYaml: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm2
+				  namespace: default
+				  labels:
+				    app: test
+			`,
+			client:        testutil.NewStandardFakeClient(),
+			count:         sawchain.AtLeast(2),
+			methodArgs:    []any{templateWithLabels},
+			expectedNames: []string{"test-cm1", "test-cm2"},
+		}),
+
+		Entry("should return empty slice when zero matches are allowed", testCase{
+			client:        testutil.NewStandardFakeClient(),
+			count:         sawchain.AtMost(0),
+			methodArgs:    []any{templateWithLabels, fastTimeout},
+			expectedNames: []string{},
+		}),
+
+		// Failure cases
+		Entry("should fail with last count error when count is not satisfied within timeout", testCase{
+			resourcesYaml: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				  labels:
+				    app: test
+			`,
+			client:     testutil.NewStandardFakeClient(),
+			count:      sawchain.Exactly(2),
+			methodArgs: []any{templateWithLabels},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] list count not satisfied within timeout",
+				"expected exactly 2 matching resource(s), but 1 of 1 candidates matched expectation",
+				"* v1/ConfigMap/default/test-cm1",
+			},
+		}),
+
+		Entry("should fail with invalid count", testCase{
+			client:     testutil.NewStandardFakeClient(),
+			count:      sawchain.Between(2, 1),
+			methodArgs: []any{templateWithLabels},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"count maximum (1) is less than minimum (2)",
+			},
+		}),
+
+		Entry("should fail with multi-document template", testCase{
+			client: testutil.NewStandardFakeClient(),
+			count:  sawchain.AtLeast(1),
+			methodArgs: []any{`
+				apiVersion: v1
+				kind: ConfigMap
+				---
+				apiVersion: v1
+				kind: Secret
+			`},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid template",
+				"expected template to contain a single resource; found 2",
+			},
+		}),
+
+		Entry("should fail with no template", testCase{
+			client: testutil.NewStandardFakeClient(),
+			count:  sawchain.AtLeast(1),
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string)",
+			},
+		}),
+	)
+})
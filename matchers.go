@@ -1,8 +1,14 @@
 package sawchain
 
 import (
+	"context"
+	"os"
+	"regexp"
+	"strconv"
+
 	"github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/guidewire-oss/sawchain/internal/chainsaw"
 	"github.com/guidewire-oss/sawchain/internal/matchers"
@@ -32,19 +38,26 @@ import (
 //     whitespace prefix from non-empty lines) and pruning empty documents.
 //
 //   - Multi-document templates use "match any document" semantics: the matcher succeeds if the object
-//     matches at least one of the documents in the template.
+//     matches at least one of the documents in the template. Use MatchAllYAML for "match all documents"
+//     semantics.
 //
 //   - Because Chainsaw performs partial/subset matching on resource fields (expected fields must exist,
 //     extras are allowed), template expectations only have to include fields of interest, not necessarily
 //     complete resource definitions.
 //
+//   - If Sawchain was initialized with Strict, objects with fields absent in the matched document are
+//     rejected as well, except for fields under Sawchain's IgnorePaths. See Check for details on strict
+//     matching.
+//
+//   - If Sawchain was initialized with DecodeSecrets, Secret data is compared in decoded form, like
+//     stringData, and redacted in failure output below VerbosityVerbose.
+//
 //   - The detail level of the matcher's failure message follows the Sawchain instance's configured
 //     Verbosity.
 //
-//   - For optimal failure output, use individual assertions in a for-loop rather than collection
-//     matchers (e.g., HaveEach, ContainElement). Collection matchers work correctly but provide
-//     limited error details due to Gomega limitations. If collection matchers are necessary,
-//     enable format.UseStringerRepresentation for slightly better output.
+//   - For optimal failure output with slices of objects, use HaveEachMatchingYAML,
+//     ContainElementMatchingYAML, or ConsistOfYAML rather than wrapping MatchYAML in Gomega
+//     collection matchers (e.g., HaveEach, ContainElement), which provide limited error details.
 //
 // # Examples
 //
@@ -69,9 +82,7 @@ import (
 //
 // Match multiple objects with a multi-document template file:
 //
-//	for _, obj := range objs {
-//	    Expect(obj).To(sc.MatchYAML("path/to/expected-outputs.yaml"))
-//	}
+//	Expect(objs).To(sc.HaveEachMatchingYAML("path/to/expected-outputs.yaml"))
 //
 // For more Chainsaw examples, see https://github.com/guidewire-oss/sawchain/blob/main/docs/chainsaw-cheatsheet.md.
 func (s *Sawchain) MatchYAML(template string, bindings ...map[string]any) types.GomegaMatcher {
@@ -87,12 +98,337 @@ func (s *Sawchain) MatchYAML(template string, bindings ...map[string]any) types.
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
 
 	// Create matcher
-	matcher := matchers.NewChainsawMatcher(s.c, template, b, s.opts.Verbosity)
+	matcher := matchers.NewChainsawMatcher(s.c, template, b, s.funcs, s.matchOptions(&s.opts), s.opts.Verbosity)
 	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
 
 	return matcher
 }
 
+// MatchAllYAML returns a Gomega matcher that checks if a client.Object matches YAML expectations defined in a
+// template, including full support for Chainsaw JMESPath expressions, as well as multi-document matching
+// with "match all documents" semantics.
+//
+// # Arguments
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing the type
+//     metadata and expectations to match against.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Multi-document templates use "match all documents" semantics: the matcher succeeds only if the
+//     object matches every document in the template. This allows independently maintained expectations
+//     to be combined into one assertion. For single-document templates, it is equivalent to MatchYAML.
+//
+//   - On failure, every document the object does not match is detailed (not just the closest one), and
+//     the detail level of the matcher's failure message follows the Sawchain instance's configured
+//     Verbosity.
+//
+// # Examples
+//
+// Match an object against several expectations at once:
+//
+//	Expect(deployment).To(sc.MatchAllYAML(`
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  spec:
+//	    replicas: 3
+//	  ---
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    labels:
+//	      app: ($app)
+//	  `, map[string]any{"app": "myapp"}))
+//
+// Match an object against expectations combined from multiple files:
+//
+//	Expect(obj).To(sc.MatchAllYAML(sc.RenderToString("path/to/common.yaml") + "\n---\n" +
+//	    sc.RenderToString("path/to/specific.yaml")))
+func (s *Sawchain) MatchAllYAML(template string, bindings ...map[string]any) types.GomegaMatcher {
+	s.t.Helper()
+
+	// Process template
+	var err error
+	template, err = options.ProcessTemplate(template)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+
+	// Create bindings
+	b, err := chainsaw.BindingsFromMap(s.mergeBindings(bindings...))
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+
+	// Create matcher
+	matcher := matchers.NewChainsawAllMatcher(s.c, template, b, s.funcs, s.matchOptions(&s.opts), s.opts.Verbosity)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+
+	return matcher
+}
+
+// HaveEachMatchingYAML returns a Gomega matcher that checks if every element of a slice of client.Objects
+// matches YAML expectations defined in a template, with "match any document" semantics per element.
+//
+// # Arguments
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing the type
+//     metadata and expectations to match against.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - The actual value must be a slice of client.Objects (e.g. []client.Object returned by List or
+//     RenderMultiple, or a typed slice such as []*corev1.ConfigMap). When dealing with typed objects, the
+//     client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Every element is matched against every document, as with MatchYAML. An empty slice results in a
+//     matcher error, mirroring Gomega's HaveEach.
+//
+//   - On failure, each element that matches no document is reported with its best match attempt, and the
+//     detail level of the failure message follows the Sawchain instance's configured Verbosity.
+//
+// # Examples
+//
+// Assert every listed Pod is Ready:
+//
+//	Expect(sc.List(ctx, podTemplate)).To(sc.HaveEachMatchingYAML(`
+//	  apiVersion: v1
+//	  kind: Pod
+//	  status:
+//	    (conditions[?type == 'Ready']):
+//	    - status: 'True'
+//	`))
+func (s *Sawchain) HaveEachMatchingYAML(template string, bindings ...map[string]any) types.GomegaMatcher {
+	s.t.Helper()
+
+	// Process template
+	var err error
+	template, err = options.ProcessTemplate(template)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+
+	// Create bindings
+	b, err := chainsaw.BindingsFromMap(s.mergeBindings(bindings...))
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+
+	// Create matcher
+	matcher := matchers.NewHaveEachMatcher(s.c, template, b, s.funcs, s.matchOptions(&s.opts), s.opts.Verbosity)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+
+	return matcher
+}
+
+// ContainElementMatchingYAML returns a Gomega matcher that checks if at least one element of a slice of
+// client.Objects matches YAML expectations defined in a template, with "match any document" semantics.
+//
+// # Arguments
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing the type
+//     metadata and expectations to match against.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - The actual value must be a slice of client.Objects (e.g. []client.Object returned by List or
+//     RenderMultiple, or a typed slice such as []*corev1.ConfigMap). When dealing with typed objects, the
+//     client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Every element is matched against every document, as with MatchYAML.
+//
+//   - On failure, every element is reported with its best match attempt, and the detail level of the
+//     failure message follows the Sawchain instance's configured Verbosity. When negated, the failure
+//     message lists the matching elements.
+//
+// # Examples
+//
+// Assert a rendered manifest contains a Service exposing port 80:
+//
+//	Expect(sc.RenderMultiple("path/to/manifests.yaml")).To(sc.ContainElementMatchingYAML(`
+//	  apiVersion: v1
+//	  kind: Service
+//	  spec:
+//	    ports:
+//	    - port: 80
+//	`))
+func (s *Sawchain) ContainElementMatchingYAML(template string, bindings ...map[string]any) types.GomegaMatcher {
+	s.t.Helper()
+
+	// Process template
+	var err error
+	template, err = options.ProcessTemplate(template)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+
+	// Create bindings
+	b, err := chainsaw.BindingsFromMap(s.mergeBindings(bindings...))
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+
+	// Create matcher
+	matcher := matchers.NewContainElementMatcher(s.c, template, b, s.funcs, s.matchOptions(&s.opts), s.opts.Verbosity)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+
+	return matcher
+}
+
+// ConsistOfYAML returns a Gomega matcher that checks if the elements of a slice of client.Objects pair up
+// one-to-one, in any order, with the YAML expectation documents defined in a template.
+//
+// # Arguments
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing the type
+//     metadata and expectations to match against.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - The actual value must be a slice of client.Objects (e.g. []client.Object returned by List or
+//     RenderMultiple, or a typed slice such as []*corev1.ConfigMap). When dealing with typed objects, the
+//     client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - The matcher succeeds if the slice has exactly as many elements as the template has documents, and
+//     each element can be paired with a distinct document it matches. Documents may overlap; the pairing
+//     is searched exhaustively rather than greedily.
+//
+//   - On failure, each unpaired document is reported with its best match attempt among the unpaired
+//     elements, and each unpaired element with its best match attempt among the unpaired documents. The
+//     detail level of the failure message follows the Sawchain instance's configured Verbosity.
+//
+// # Examples
+//
+// Assert the listed ConfigMaps are exactly the expected ones, in any order:
+//
+//	Expect(sc.List(ctx, configMapTemplate)).To(sc.ConsistOfYAML(`
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: (concat($prefix, '-a'))
+//	  ---
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: (concat($prefix, '-b'))
+//	  `, map[string]any{"prefix": "test"}))
+func (s *Sawchain) ConsistOfYAML(template string, bindings ...map[string]any) types.GomegaMatcher {
+	s.t.Helper()
+
+	// Process template
+	var err error
+	template, err = options.ProcessTemplate(template)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+
+	// Create bindings
+	b, err := chainsaw.BindingsFromMap(s.mergeBindings(bindings...))
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+
+	// Create matcher
+	matcher := matchers.NewConsistOfMatcher(s.c, template, b, s.funcs, s.matchOptions(&s.opts), s.opts.Verbosity)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+
+	return matcher
+}
+
+// MatchSnapshot returns a Gomega matcher that checks if a client.Object (or a slice of client.Objects,
+// e.g. from RenderMultiple) is equal to the resources in a YAML snapshot (golden) file, ignoring
+// volatile metadata populated by the API server.
+//
+// # Arguments
+//
+//   - Path (string): File path of the snapshot. Single objects are compared with a single-document
+//     snapshot; slices are compared with a multi-document snapshot, element by element in order.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure. A missing snapshot file results in a match
+//     error outside of update mode.
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - Unlike MatchYAML, snapshots are compared literally: every field must be equal, fields absent in
+//     the snapshot are reported as unexpected, and snapshot content is never rendered as a Chainsaw
+//     template.
+//
+//   - Volatile metadata (uid, resourceVersion, generation, creationTimestamp, deletionTimestamp,
+//     deletionGracePeriodSeconds, managedFields, selfLink, and the kubectl last-applied-configuration
+//     annotation), null values, and empty maps are stripped from actual objects before comparing.
+//
+//   - In update mode, enabled by the UpdateSnapshots flag or by setting the SAWCHAIN_UPDATE_SNAPSHOTS
+//     environment variable to true, the matcher rewrites the snapshot file (creating parent directories
+//     as needed) from the stripped actual output and always succeeds. Review the resulting changes
+//     before committing them.
+//
+//   - Failures are rendered like MatchYAML failures, with field-level errors and YAML diffs following
+//     the Sawchain instance's configured Verbosity.
+//
+//   - When running tests in parallel, avoid updating the same snapshot file from multiple processes.
+//
+// # Examples
+//
+// Compare rendered Helm output with a snapshot:
+//
+//	objs := sc.RenderMultiple(renderedChart)
+//	Expect(objs).To(sc.MatchSnapshot("testdata/snapshots/chart.yaml"))
+//
+// Compare a fetched resource with a snapshot:
+//
+//	Expect(sc.FetchSingle(ctx, deployment)).To(sc.MatchSnapshot("testdata/snapshots/deployment.yaml"))
+//
+// Update snapshots from actual output:
+//
+//	SAWCHAIN_UPDATE_SNAPSHOTS=true go test ./...
+func (s *Sawchain) MatchSnapshot(path string) types.GomegaMatcher {
+	s.t.Helper()
+	s.g.Expect(path).NotTo(gomega.BeEmpty(), prefixErr+"snapshot path must not be empty")
+
+	// Determine update mode
+	update := s.opts.Flags.Has(options.FlagUpdateSnapshots)
+	if value := os.Getenv(matchers.UpdateSnapshotsEnv); value != "" {
+		fromEnv, err := strconv.ParseBool(value)
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidUpdateSnapshotsEnv)
+		update = update || fromEnv
+	}
+
+	// Create matcher
+	matcher := matchers.NewSnapshotMatcher(s.c, path, update, s.opts.Verbosity)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+
+	return matcher
+}
+
+// StatusCondition describes the expected state of a status condition for HaveStatusConditions.
+// Type and Status are required; the remaining criteria (MinGeneration, Reason, MessageSubstring,
+// MessagePattern, and TransitionedSince) are only checked if set.
+type StatusCondition = matchers.StatusCondition
+
 // HaveStatusCondition returns a Gomega matcher that uses Chainsaw matching to check if a client.Object
 // has a specific status condition.
 //
@@ -118,6 +454,9 @@ func (s *Sawchain) MatchYAML(template string, bindings ...map[string]any) types.
 //   - The detail level of the matcher's failure message follows the Sawchain instance's configured
 //     Verbosity.
 //
+//   - Use HaveStatusConditions to check the reason, message, or transition time of a condition, or
+//     to check several conditions at once.
+//
 //   - For optimal failure output, use individual assertions in a for-loop rather than collection
 //     matchers (e.g., HaveEach, ContainElement). Collection matchers work correctly but provide
 //     limited error details due to Gomega limitations. If collection matchers are necessary,
@@ -148,12 +487,301 @@ func (s *Sawchain) MatchYAML(template string, bindings ...map[string]any) types.
 func (s *Sawchain) HaveStatusCondition(conditionType, expectedStatus string, minGeneration ...int64) types.GomegaMatcher {
 	s.t.Helper()
 	s.g.Expect(len(minGeneration)).To(gomega.BeNumerically("<=", 1), prefixErr+"expected at most one minGeneration value")
-	var minGen int64
+	condition := StatusCondition{Type: conditionType, Status: expectedStatus}
 	if len(minGeneration) > 0 {
-		minGen = minGeneration[0]
-		s.g.Expect(minGen).To(gomega.BeNumerically(">", 0), prefixErr+"minGeneration must be greater than 0")
+		condition.MinGeneration = minGeneration[0]
+		s.g.Expect(condition.MinGeneration).To(gomega.BeNumerically(">", 0), prefixErr+"minGeneration must be greater than 0")
+	}
+	s.checkStatusCondition(condition)
+	matcher := matchers.NewStatusConditionMatcher(s.c, []StatusCondition{condition}, s.funcs, s.opts.Verbosity)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+	return matcher
+}
+
+// HaveStatusConditions returns a Gomega matcher that uses Chainsaw matching to check if a client.Object
+// has all of the given status conditions.
+//
+// # Arguments
+//
+//   - Conditions (sawchain.StatusCondition): The expected status conditions. At least one must be
+//     provided, each with a distinct Type and a non-empty Status. Besides Type and Status, each
+//     condition may set the following optional criteria:
+//
+//   - MinGeneration (int64): The minimum observedGeneration of the condition, with the same
+//     semantics as in HaveStatusCondition. Must not be negative; zero means unchecked.
+//
+//   - Reason (string): The expected reason of the condition.
+//
+//   - MessageSubstring (string): A substring the condition's message must contain.
+//
+//   - MessagePattern (string): A regular expression (RE2 syntax) the condition's message must match.
+//
+//   - TransitionedSince (time.Time): A time the condition's lastTransitionTime must not be before.
+//     Times are compared at second precision (the precision of lastTransitionTime), so a transition
+//     within the same second as the given time matches.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - All conditions are checked in a single generated Chainsaw template, and each criterion is
+//     expressed as an assertion on the matched condition, so one failure message reports every
+//     offending comparison directly.
+//
+//   - The detail level of the matcher's failure message follows the Sawchain instance's configured
+//     Verbosity.
+//
+// # Examples
+//
+// Assert a Crossplane managed resource is ready and synced:
+//
+//	Expect(obj).To(sc.HaveStatusConditions(
+//	    sawchain.StatusCondition{Type: "Ready", Status: "True"},
+//	    sawchain.StatusCondition{Type: "Synced", Status: "True", Reason: "ReconcileSuccess"},
+//	))
+//
+// Assert a resource failed to reconcile for a specific reason:
+//
+//	Expect(obj).To(sc.HaveStatusConditions(sawchain.StatusCondition{
+//	    Type:           "Ready",
+//	    Status:         "False",
+//	    Reason:         "ReconcileError",
+//	    MessagePattern: `^failed to pull image ".+"`,
+//	}))
+//
+// Assert a resource's Ready condition transitioned since an update:
+//
+//	updated := time.Now()
+//	sc.UpdateAndWait(ctx, obj)
+//	Eventually(sc.FetchSingleFunc(ctx, obj)).Should(sc.HaveStatusConditions(
+//	    sawchain.StatusCondition{Type: "Ready", Status: "True", TransitionedSince: updated},
+//	))
+//
+// Wait for a Deployment to finish rolling out the current generation:
+//
+//	Eventually(sc.FetchSingleFunc(ctx, deployment)).Should(sc.HaveStatusConditions(
+//	    sawchain.StatusCondition{Type: "Available", Status: "True"},
+//	    sawchain.StatusCondition{Type: "Progressing", Status: "True", Reason: "NewReplicaSetAvailable"},
+//	))
+func (s *Sawchain) HaveStatusConditions(conditions ...StatusCondition) types.GomegaMatcher {
+	s.t.Helper()
+	s.g.Expect(conditions).NotTo(gomega.BeEmpty(), prefixErr+"expected at least one status condition")
+	seen := make(map[string]bool, len(conditions))
+	for _, condition := range conditions {
+		s.g.Expect(seen).NotTo(gomega.HaveKey(condition.Type), prefixErr+"status condition types must be distinct")
+		seen[condition.Type] = true
+		s.g.Expect(condition.MinGeneration).To(gomega.BeNumerically(">=", 0), prefixErr+"minGeneration must not be negative")
+		s.checkStatusCondition(condition)
 	}
-	matcher := matchers.NewStatusConditionMatcher(s.c, conditionType, expectedStatus, minGen, s.opts.Verbosity)
+	matcher := matchers.NewStatusConditionMatcher(s.c, conditions, s.funcs, s.opts.Verbosity)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+	return matcher
+}
+
+// checkStatusCondition fails the test if the status condition is missing its type or status, or has
+// an invalid message pattern.
+func (s *Sawchain) checkStatusCondition(condition StatusCondition) {
+	s.t.Helper()
+	s.g.Expect(condition.Type).NotTo(gomega.BeEmpty(), prefixErr+"status condition type must not be empty")
+	s.g.Expect(condition.Status).NotTo(gomega.BeEmpty(), prefixErr+"status condition status must not be empty")
+	if condition.MessagePattern != "" {
+		_, err := regexp.Compile(condition.MessagePattern)
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), prefixErr+"invalid status condition message pattern")
+	}
+}
+
+// BeReady returns a Gomega matcher that checks if a client.Object, or every element of a slice of
+// client.Objects, is ready. Readiness is computed per kind, following the semantics of kstatus.
+//
+// # Notes
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - See WaitForReady for the readiness rules of each kind.
+//
+//   - The matcher's failure message lists each resource that is not ready together with the reason,
+//     e.g. "job failed (BackoffLimitExceeded: Job has reached the specified backoff limit)".
+//
+//   - Use WaitForReady to wait for resources to become ready with Sawchain's durations.
+//
+// # Examples
+//
+// Assert a Deployment is ready:
+//
+//	Expect(deployment).To(sc.BeReady())
+//
+// Assert a custom resource eventually becomes ready:
+//
+//	Eventually(sc.FetchSingleFunc(ctx, obj)).Should(sc.BeReady())
+//
+// Assert multiple resources are ready:
+//
+//	Expect([]client.Object{crd, deployment, job}).To(sc.BeReady())
+func (s *Sawchain) BeReady() types.GomegaMatcher {
+	s.t.Helper()
+	matcher := matchers.NewReadinessMatcher(s.c)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+	return matcher
+}
+
+// HaveOwner returns a Gomega matcher that checks if a client.Object has an owner reference pointing at
+// the given owner.
+//
+// # Arguments
+//
+//   - Owner (client.Object): Typed or unstructured object identifying the owner by type, name, and (if
+//     set) UID. Typed owners without type metadata are resolved through the client scheme.
+//
+// # Notes
+//
+//   - Invalid input (including an owner whose type cannot be resolved) will result in immediate test
+//     failure.
+//
+//   - Owner references are compared by API group, kind, and name, plus UID if both the reference and the
+//     owner have one. The API version is ignored, since references may record any served version.
+//
+//   - The matcher's failure message lists the actual object's owner references.
+//
+//   - Use BeControlledBy to also require the reference to have controller=true.
+//
+// # Examples
+//
+// Assert a ConfigMap is owned by a PodSet:
+//
+//	Expect(configMap).To(sc.HaveOwner(podSet))
+//
+// Assert every fetched Pod is owned by a Job:
+//
+//	Expect(pods).To(HaveEach(sc.HaveOwner(job)))
+func (s *Sawchain) HaveOwner(owner client.Object) types.GomegaMatcher {
+	s.t.Helper()
+	matcher := matchers.NewOwnerMatcher(owner, s.ownerGVK(owner), false)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+	return matcher
+}
+
+// BeControlledBy returns a Gomega matcher that checks if a client.Object has an owner reference
+// pointing at the given owner with controller=true.
+//
+// # Arguments
+//
+//   - Owner (client.Object): Typed or unstructured object identifying the controller by type, name, and
+//     (if set) UID. Typed owners without type metadata are resolved through the client scheme.
+//
+// # Notes
+//
+//   - Invalid input (including an owner whose type cannot be resolved) will result in immediate test
+//     failure.
+//
+//   - Owner references are compared the same way as in HaveOwner.
+//
+//   - The matcher's failure message lists the actual object's owner references, including their
+//     controller flags.
+//
+// # Examples
+//
+// Assert a ConfigMap is controlled by a PodSet:
+//
+//	Expect(configMap).To(sc.BeControlledBy(podSet))
+//
+// Wait for a ReplicaSet to be adopted by a Deployment:
+//
+//	Eventually(sc.FetchSingleFunc(ctx, replicaSet)).Should(sc.BeControlledBy(deployment))
+func (s *Sawchain) BeControlledBy(owner client.Object) types.GomegaMatcher {
+	s.t.Helper()
+	matcher := matchers.NewOwnerMatcher(owner, s.ownerGVK(owner), true)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+	return matcher
+}
+
+// HaveFinalizer returns a Gomega matcher that checks if a client.Object has the given finalizer.
+//
+// # Arguments
+//
+//   - Finalizer (string): The expected finalizer name.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - The matcher's failure message lists the actual object's finalizers.
+//
+// # Examples
+//
+// Assert a controller added its finalizer:
+//
+//	Eventually(sc.FetchSingleFunc(ctx, obj)).Should(sc.HaveFinalizer("example.com/cleanup"))
+//
+// Assert a finalizer was removed:
+//
+//	Eventually(sc.FetchSingleFunc(ctx, obj)).ShouldNot(sc.HaveFinalizer("example.com/cleanup"))
+func (s *Sawchain) HaveFinalizer(finalizer string) types.GomegaMatcher {
+	s.t.Helper()
+	s.g.Expect(finalizer).NotTo(gomega.BeEmpty(), prefixErr+"finalizer must not be empty")
+	matcher := matchers.NewFinalizerMatcher(finalizer)
 	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
 	return matcher
 }
+
+// HaveEmittedEvent returns a Gomega matcher that checks if the cluster holds an Event about a client.Object
+// matching YAML expectations defined in a template. Both core/v1 and events.k8s.io/v1 Events are supported.
+//
+// # Arguments
+//
+//   - Context (context.Context): Context used for listing Events whenever the matcher is evaluated.
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing type
+//     metadata (apiVersion "v1" or "events.k8s.io/v1" and kind "Event") and expectations of a single Event.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - Unlike other matchers, this matcher queries the cluster each time it is evaluated, so it can be used
+//     with Eventually on the involved object directly.
+//
+//   - Events are selected and matched the same way as in CheckEvent. Strict matching is never applied, even
+//     if Sawchain was initialized with Strict; verbosity follows the Sawchain instance's configuration.
+//
+// # Examples
+//
+// Wait for a PodSet to emit a warning Event:
+//
+//	Eventually(podSet).Should(sc.HaveEmittedEvent(ctx, `
+//	  apiVersion: v1
+//	  kind: Event
+//	  type: Warning
+//	  reason: ReconcileFailed
+//	`))
+//
+// Assert a Deployment never emitted an events.k8s.io/v1 Event with a bindings-driven note:
+//
+//	Consistently(deployment).ShouldNot(sc.HaveEmittedEvent(ctx, `
+//	  apiVersion: events.k8s.io/v1
+//	  kind: Event
+//	  (contains(note, $replicaSet)): true
+//	`, map[string]any{"replicaSet": "web-6f7c9"}))
+func (s *Sawchain) HaveEmittedEvent(ctx context.Context, template string, bindings ...map[string]any) types.GomegaMatcher {
+	s.t.Helper()
+
+	// Process template
+	var err error
+	template, err = options.ProcessTemplate(template)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+
+	// Create bindings
+	b, err := chainsaw.BindingsFromMap(s.mergeBindings(bindings...))
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+
+	// Create matcher
+	matcher := matchers.NewEventMatcher(s.c, ctx, template, b, s.funcs, s.matchOptions(s.eventDefaults()), s.opts.Verbosity)
+	s.g.Expect(matcher).NotTo(gomega.BeNil(), errCreatedMatcherIsNil)
+
+	return matcher
+}
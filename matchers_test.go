@@ -1,8 +1,17 @@
 package sawchain_test
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -14,6 +23,7 @@ import (
 var _ = Describe("MatchYAML", func() {
 	type testCase struct {
 		globalBindings      map[string]any
+		globalArgs          []any
 		actual              any
 		template            string
 		bindings            []map[string]any
@@ -24,7 +34,7 @@ var _ = Describe("MatchYAML", func() {
 		func(tc testCase) {
 			// Initialize Sawchain
 			t := &MockT{TB: GinkgoTB()}
-			sc := sawchain.New(t, testutil.NewStandardFakeClient(), tc.globalBindings)
+			sc := sawchain.New(t, testutil.NewStandardFakeClient(), append([]any{tc.globalBindings}, tc.globalArgs...)...)
 
 			// Test MatchYAML
 			done := make(chan struct{})
@@ -279,6 +289,30 @@ var _ = Describe("MatchYAML", func() {
 			`,
 		}),
 
+		Entry("semantic quantity match", testCase{
+			actual: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-pod", Namespace: "default"},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{{
+					Name: "app",
+					Resources: corev1.ResourceRequirements{Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("500m"),
+						corev1.ResourceMemory: resource.MustParse("1024Mi"),
+					}},
+				}}},
+			},
+			template: `
+				apiVersion: v1
+				kind: Pod
+				spec:
+				  containers:
+				  - name: app
+				    resources:
+				      requests:
+				        (quantity_equal(cpu, '0.5')): true
+				        (quantity_cmp(memory, '1Gi')): 0
+			`,
+		}),
+
 		// Failure cases
 		Entry("no match with different value", testCase{
 			actual: testutil.NewConfigMap("test-config", "default", map[string]string{
@@ -321,6 +355,27 @@ var _ = Describe("MatchYAML", func() {
 			},
 		}),
 
+		Entry("no match with unexpected fields in strict mode", testCase{
+			globalArgs: []any{sawchain.Strict},
+			actual: testutil.NewConfigMap("test-config", "default", map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-config
+				  namespace: default
+				data:
+				  key1: value1
+			`,
+			expectedFailureLogs: []string{
+				"Expected actual to match Chainsaw template",
+				"data.key2: Forbidden: field is not present in expectation",
+			},
+		}),
+
 		Entry("no match with multi-document template", testCase{
 			actual: testutil.NewConfigMap("cm-other", "default", map[string]string{
 				"key": "other",
@@ -354,6 +409,55 @@ var _ = Describe("MatchYAML", func() {
 		}),
 
 		// Edge cases
+		Entry("match in strict mode with ignore paths", testCase{
+			globalArgs: []any{sawchain.Strict, sawchain.IgnorePaths{"metadata"}},
+			actual: testutil.NewConfigMap("test-config", "default", map[string]string{
+				"key1": "value1",
+			}),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key1: value1
+			`,
+		}),
+
+		Entry("match with decoded Secret data", testCase{
+			globalArgs: []any{sawchain.DecodeSecrets},
+			actual: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "default"},
+				Data:       map[string][]byte{"password": []byte("s3cr3t")},
+			},
+			template: `
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				data:
+				  password: s3cr3t
+			`,
+		}),
+
+		Entry("no match with decoded Secret data redacts values", testCase{
+			globalArgs: []any{sawchain.DecodeSecrets},
+			actual: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "default"},
+				Data:       map[string][]byte{"password": []byte("s3cr3t")},
+			},
+			template: `
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				data:
+				  password: wrong
+			`,
+			expectedFailureLogs: []string{
+				"Expected actual to match Chainsaw template",
+				"data.password: Invalid value: \"<redacted>\": Expected value: <redacted>",
+			},
+		}),
+
 		Entry("match with metadata only", testCase{
 			actual: testutil.NewConfigMap("test-config", "default", map[string]string{
 				"key1": "value1",
@@ -589,38 +693,26 @@ var _ = Describe("MatchYAML verbosity", func() {
 	)
 })
 
-var _ = Describe("HaveStatusCondition", func() {
+var _ = Describe("MatchAllYAML", func() {
 	type testCase struct {
-		client              client.Client
+		globalBindings      map[string]any
 		actual              any
-		conditionType       string
-		expectedStatus      string
-		minGeneration       []int64
+		template            string
+		bindings            []map[string]any
 		expectedFailureLogs []string
 	}
 
-	var (
-		standardClient         = testutil.NewStandardFakeClient()
-		clientWithTestResource = testutil.NewStandardFakeClientWithTestResource()
-	)
-
-	DescribeTable("checking object status conditions",
+	DescribeTable("matching objects against every YAML expectation",
 		func(tc testCase) {
 			// Initialize Sawchain
 			t := &MockT{TB: GinkgoTB()}
-			sc := sawchain.New(t, tc.client)
-
-			// Pass minGeneration (if provided)
-			var genArgs []int64
-			if len(tc.minGeneration) > 0 {
-				genArgs = append(genArgs, tc.minGeneration...)
-			}
+			sc := sawchain.New(t, testutil.NewStandardFakeClient(), tc.globalBindings)
 
-			// Test HaveStatusCondition
+			// Test MatchAllYAML
 			done := make(chan struct{})
 			go func() {
 				defer close(done)
-				NewWithT(t).Expect(tc.actual).To(sc.HaveStatusCondition(tc.conditionType, tc.expectedStatus, genArgs...))
+				NewWithT(t).Expect(tc.actual).To(sc.MatchAllYAML(tc.template, tc.bindings...))
 			}()
 			<-done
 
@@ -635,136 +727,591 @@ var _ = Describe("HaveStatusCondition", func() {
 			}
 		},
 
-		// Success cases with typed objects
-		Entry("condition Ready=True match", testCase{
-			client: clientWithTestResource,
-			actual: testutil.NewTestResource("test-resource", "default",
-				metav1.Condition{
-					Type:   "Ready",
-					Status: metav1.ConditionTrue,
-				},
-			),
-			conditionType:  "Ready",
-			expectedStatus: "True",
-		}),
-
-		Entry("condition Ready=False match", testCase{
-			client: clientWithTestResource,
-			actual: testutil.NewTestResource("test-resource", "default",
-				metav1.Condition{
-					Type:   "Ready",
-					Status: metav1.ConditionFalse,
-				},
-			),
-			conditionType:  "Ready",
-			expectedStatus: "False",
-		}),
-
-		// Success cases with unstructured objects
-		Entry("condition Ready=Unknown match", testCase{
-			client: standardClient,
-			actual: testutil.NewUnstructuredTestResource("test-resource", "default",
-				metav1.Condition{
-					Type:   "Ready",
-					Status: metav1.ConditionUnknown,
-				},
-			),
-			conditionType:  "Ready",
-			expectedStatus: "Unknown",
+		// Success cases
+		Entry("match single document", testCase{
+			actual: testutil.NewConfigMap("test-config", "default", map[string]string{"key1": "value1"}),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key1: value1
+			`,
 		}),
 
-		Entry("match with multiple conditions", testCase{
-			client: standardClient,
-			actual: testutil.NewUnstructuredTestResource("test-resource", "default",
-				metav1.Condition{
-					Type:   "Available",
-					Status: metav1.ConditionTrue,
-				},
-				metav1.Condition{
-					Type:   "Ready",
-					Status: metav1.ConditionTrue,
-				},
-				metav1.Condition{
-					Type:   "Progressing",
-					Status: metav1.ConditionFalse,
-				},
-			),
-			conditionType:  "Ready",
-			expectedStatus: "True",
+		Entry("match every document with global and local bindings", testCase{
+			globalBindings: map[string]any{"name": "test-config"},
+			actual: testutil.NewConfigMap("test-config", "default", map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: ($name)
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key1: ($value1)
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key2: value2
+			`,
+			bindings: []map[string]any{{"value1": "value1"}},
 		}),
 
 		// Failure cases
-		Entry("no match with different status", testCase{
-			client: clientWithTestResource,
-			actual: testutil.NewTestResource("test-resource", "default",
-				metav1.Condition{
-					Type:   "Ready",
-					Status: metav1.ConditionFalse,
-				},
-			),
-			conditionType:  "Ready",
-			expectedStatus: "True",
+		Entry("no match when any document fails", testCase{
+			actual: testutil.NewConfigMap("test-config", "default", map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key1: value1
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key2: other
+			`,
 			expectedFailureLogs: []string{
-				"Expected actual to match Chainsaw template",
-				"[ERROR]",
-				"status.(conditions[?type == 'Ready'])[0].status: Invalid value: \"False\": Expected value: \"True\"",
+				"Expected actual to match all documents in Chainsaw template; 1 of 2 documents did not match",
+				"data.key2: Invalid value: \"value2\": Expected value: \"other\"",
 			},
 		}),
 
-		Entry("no match with missing condition", testCase{
-			client: clientWithTestResource,
-			actual: testutil.NewTestResource("test-resource", "default",
-				metav1.Condition{
-					Type:   "Available",
-					Status: metav1.ConditionTrue,
-				},
-			),
-			conditionType:  "Ready",
-			expectedStatus: "True",
+		Entry("no match lists every failing document", testCase{
+			actual: testutil.NewConfigMap("test-config", "default", map[string]string{"key1": "value1"}),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: other-config
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key1: other
+			`,
 			expectedFailureLogs: []string{
-				"Expected actual to match Chainsaw template",
-				"[ERROR]",
-				"status.(conditions[?type == 'Ready']): Invalid value: []: lengths of slices don't match",
+				"2 of 2 documents did not match",
+				"[ERROR #1]",
+				"metadata.name: Invalid value: \"test-config\": Expected value: \"other-config\"",
+				"[ERROR #2]",
+				"data.key1: Invalid value: \"value1\": Expected value: \"other\"",
 			},
 		}),
 
-		// Edge cases
-		Entry("no match with empty conditions", testCase{
-			client:         clientWithTestResource,
-			actual:         testutil.NewTestResource("test-resource", "default"),
-			conditionType:  "Ready",
-			expectedStatus: "True",
-			expectedFailureLogs: []string{
-				"Expected actual to match Chainsaw template",
-				"[ERROR]",
-				"status.(conditions[?type == 'Ready']): Invalid value: null: value is null",
-			},
+		// Error cases
+		Entry("error on nil input", testCase{
+			actual: nil,
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+			`,
+			expectedFailureLogs: []string{"actual must be a client.Object, not nil"},
 		}),
 
-		Entry("no match with missing status field", testCase{
-			client: standardClient,
-			actual: func() any {
-				obj := &unstructured.Unstructured{}
-				obj.SetAPIVersion("example.com/v1")
-				obj.SetKind("TestResource")
-				obj.SetName("test-resource")
-				obj.SetNamespace("default")
-				return obj
-			}(),
-			conditionType:  "Ready",
-			expectedStatus: "True",
+		Entry("error on invalid bindings", testCase{
+			actual: testutil.NewConfigMap("test-config", "default", nil),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+			`,
+			bindings: []map[string]any{{"invalid": make(chan int)}},
 			expectedFailureLogs: []string{
-				"Expected actual to match Chainsaw template",
-				"[ERROR]",
-				"status: Required value: field not found in the input object",
+				"[SAWCHAIN][ERROR] invalid bindings",
 			},
 		}),
+	)
+})
 
-		// Generation-aware cases
-		Entry("generation match when observedGeneration exceeds minGeneration", testCase{
-			client: clientWithTestResource,
-			actual: testutil.NewTestResource("test-resource", "default",
-				metav1.Condition{
+var _ = Describe("Collection YAML matchers", func() {
+	type testCase struct {
+		globalBindings      map[string]any
+		newMatcher          func(sc *sawchain.Sawchain, template string, bindings ...map[string]any) types.GomegaMatcher
+		actual              any
+		template            string
+		bindings            []map[string]any
+		expectedFailureLogs []string
+	}
+
+	var (
+		haveEach       = (*sawchain.Sawchain).HaveEachMatchingYAML
+		containElement = (*sawchain.Sawchain).ContainElementMatchingYAML
+		consistOf      = (*sawchain.Sawchain).ConsistOfYAML
+	)
+
+	newConfigMaps := func(values ...string) []client.Object {
+		objs := make([]client.Object, len(values))
+		for i, value := range values {
+			objs[i] = testutil.NewConfigMap(fmt.Sprintf("test-config-%d", i+1), "default", map[string]string{"key": value})
+		}
+		return objs
+	}
+
+	DescribeTable("matching slices of objects against YAML expectations",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, testutil.NewStandardFakeClient(), tc.globalBindings)
+
+			// Test matcher
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				NewWithT(t).Expect(tc.actual).To(tc.newMatcher(sc, tc.template, tc.bindings...))
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+		},
+
+		// HaveEachMatchingYAML
+		Entry("HaveEachMatchingYAML matches every element with bindings", testCase{
+			globalBindings: map[string]any{"value": "value1"},
+			newMatcher:     haveEach,
+			actual:         newConfigMaps("value1", "value1"),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key: ($value)
+			`,
+		}),
+
+		Entry("HaveEachMatchingYAML fails on any unmatched element", testCase{
+			newMatcher: haveEach,
+			actual:     newConfigMaps("value1", "value2"),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key: ($value)
+			`,
+			bindings: []map[string]any{{"value": "value1"}},
+			expectedFailureLogs: []string{
+				"Expected each element to match Chainsaw template; 1 of 2 elements did not match",
+				"[ELEMENT #2] v1/ConfigMap/default/test-config-2",
+				"data.key: Invalid value: \"value2\": Expected value: \"value1\"",
+			},
+		}),
+
+		// ContainElementMatchingYAML
+		Entry("ContainElementMatchingYAML matches when some element matches", testCase{
+			newMatcher: containElement,
+			actual:     newConfigMaps("value1", "value2"),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key: value2
+			`,
+		}),
+
+		Entry("ContainElementMatchingYAML fails when no element matches", testCase{
+			newMatcher: containElement,
+			actual:     newConfigMaps("value1", "value2"),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key: value3
+			`,
+			expectedFailureLogs: []string{
+				"Expected some element to match Chainsaw template; 0 of 2 elements matched",
+				"[ELEMENT #1] v1/ConfigMap/default/test-config-1",
+				"[ELEMENT #2] v1/ConfigMap/default/test-config-2",
+			},
+		}),
+
+		// ConsistOfYAML
+		Entry("ConsistOfYAML matches elements in any order", testCase{
+			newMatcher: consistOf,
+			actual:     newConfigMaps("value2", "value1"),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key: value1
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key: value2
+			`,
+		}),
+
+		Entry("ConsistOfYAML fails on unpaired elements and documents", testCase{
+			newMatcher: consistOf,
+			actual:     newConfigMaps("value1", "value3"),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key: value1
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				data:
+				  key: value2
+			`,
+			expectedFailureLogs: []string{
+				"1 of 2 elements and 1 of 2 documents were left unpaired",
+				"[DOCUMENT #2] v1/ConfigMap",
+				"[ELEMENT #2] v1/ConfigMap/default/test-config-2",
+				"data.key: Invalid value: \"value3\": Expected value: \"value2\"",
+			},
+		}),
+
+		// Error cases
+		Entry("error on single object input", testCase{
+			newMatcher: consistOf,
+			actual:     testutil.NewConfigMap("test-config", "default", nil),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+			`,
+			expectedFailureLogs: []string{"actual must be a slice of client.Object, not *v1.ConfigMap"},
+		}),
+
+		Entry("error on invalid bindings", testCase{
+			newMatcher: haveEach,
+			actual:     newConfigMaps("value1"),
+			template: `
+				apiVersion: v1
+				kind: ConfigMap
+			`,
+			bindings: []map[string]any{{"invalid": make(chan int)}},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid bindings",
+			},
+		}),
+	)
+})
+
+var _ = Describe("MatchSnapshot", func() {
+	const snapshot = `apiVersion: v1
+data:
+  key: value1
+kind: ConfigMap
+metadata:
+  name: test-config
+  namespace: default
+`
+
+	type testCase struct {
+		globalArgs          []any
+		env                 string
+		actual              any
+		snapshot            string
+		emptyPath           bool
+		expectedSnapshot    string
+		expectedFailureLogs []string
+	}
+
+	DescribeTable("matching objects against snapshot files",
+		func(tc testCase) {
+			path := filepath.Join(GinkgoT().TempDir(), "snapshot.yaml")
+			if tc.snapshot != "" {
+				Expect(os.WriteFile(path, []byte(tc.snapshot), 0644)).To(Succeed())
+			}
+			if tc.emptyPath {
+				path = ""
+			}
+			GinkgoT().Setenv("SAWCHAIN_UPDATE_SNAPSHOTS", tc.env)
+
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, testutil.NewStandardFakeClient(), tc.globalArgs...)
+
+			// Test matcher
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				NewWithT(t).Expect(tc.actual).To(sc.MatchSnapshot(path))
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+				return
+			}
+			Expect(t.Failed()).To(BeFalse(), "expected no failure")
+
+			// Verify snapshot content
+			if tc.expectedSnapshot != "" {
+				content, err := os.ReadFile(path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(content)).To(Equal(tc.expectedSnapshot))
+			}
+		},
+
+		Entry("object matches snapshot", testCase{
+			actual:   testutil.NewConfigMap("test-config", "default", map[string]string{"key": "value1"}),
+			snapshot: snapshot,
+		}),
+
+		Entry("slice of objects matches multi-document snapshot", testCase{
+			actual: []client.Object{
+				testutil.NewConfigMap("test-config", "default", map[string]string{"key": "value1"}),
+				testutil.NewConfigMap("test-config", "default", map[string]string{"key": "value1"}),
+			},
+			snapshot: snapshot + "---\n" + snapshot,
+		}),
+
+		Entry("object does not match snapshot", testCase{
+			actual:   testutil.NewConfigMap("test-config", "default", map[string]string{"key": "value2"}),
+			snapshot: snapshot,
+			expectedFailureLogs: []string{
+				"Expected actual to match snapshot",
+				"data.key: Invalid value: \"value2\": Expected value: \"value1\"",
+				"set SAWCHAIN_UPDATE_SNAPSHOTS=true to update the snapshot",
+			},
+		}),
+
+		Entry("UpdateSnapshots flag rewrites snapshot", testCase{
+			globalArgs:       []any{sawchain.UpdateSnapshots},
+			actual:           testutil.NewConfigMap("test-config", "default", map[string]string{"key": "value2"}),
+			snapshot:         snapshot,
+			expectedSnapshot: strings.Replace(snapshot, "value1", "value2", 1),
+		}),
+
+		Entry("environment variable creates missing snapshot", testCase{
+			env:              "true",
+			actual:           testutil.NewConfigMap("test-config", "default", map[string]string{"key": "value1"}),
+			expectedSnapshot: snapshot,
+		}),
+
+		Entry("environment variable set to false compares with snapshot", testCase{
+			env:      "false",
+			actual:   testutil.NewConfigMap("test-config", "default", map[string]string{"key": "value2"}),
+			snapshot: snapshot,
+			expectedFailureLogs: []string{
+				"data.key: Invalid value: \"value2\": Expected value: \"value1\"",
+			},
+		}),
+
+		Entry("failure - missing snapshot", testCase{
+			actual: testutil.NewConfigMap("test-config", "default", map[string]string{"key": "value1"}),
+			expectedFailureLogs: []string{
+				"does not exist; set SAWCHAIN_UPDATE_SNAPSHOTS=true to create it",
+			},
+		}),
+
+		Entry("failure - invalid environment variable", testCase{
+			env:      "sometimes",
+			actual:   testutil.NewConfigMap("test-config", "default", map[string]string{"key": "value1"}),
+			snapshot: snapshot,
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid SAWCHAIN_UPDATE_SNAPSHOTS value (must be a boolean)",
+			},
+		}),
+
+		Entry("failure - empty path", testCase{
+			actual:    testutil.NewConfigMap("test-config", "default", map[string]string{"key": "value1"}),
+			emptyPath: true,
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] snapshot path must not be empty",
+			},
+		}),
+	)
+})
+
+var _ = Describe("HaveStatusCondition", func() {
+	type testCase struct {
+		client              client.Client
+		actual              any
+		conditionType       string
+		expectedStatus      string
+		minGeneration       []int64
+		expectedFailureLogs []string
+	}
+
+	var (
+		standardClient         = testutil.NewStandardFakeClient()
+		clientWithTestResource = testutil.NewStandardFakeClientWithTestResource()
+	)
+
+	DescribeTable("checking object status conditions",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client)
+
+			// Pass minGeneration (if provided)
+			var genArgs []int64
+			if len(tc.minGeneration) > 0 {
+				genArgs = append(genArgs, tc.minGeneration...)
+			}
+
+			// Test HaveStatusCondition
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				NewWithT(t).Expect(tc.actual).To(sc.HaveStatusCondition(tc.conditionType, tc.expectedStatus, genArgs...))
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+		},
+
+		// Success cases with typed objects
+		Entry("condition Ready=True match", testCase{
+			client: clientWithTestResource,
+			actual: testutil.NewTestResource("test-resource", "default",
+				metav1.Condition{
+					Type:   "Ready",
+					Status: metav1.ConditionTrue,
+				},
+			),
+			conditionType:  "Ready",
+			expectedStatus: "True",
+		}),
+
+		Entry("condition Ready=False match", testCase{
+			client: clientWithTestResource,
+			actual: testutil.NewTestResource("test-resource", "default",
+				metav1.Condition{
+					Type:   "Ready",
+					Status: metav1.ConditionFalse,
+				},
+			),
+			conditionType:  "Ready",
+			expectedStatus: "False",
+		}),
+
+		// Success cases with unstructured objects
+		Entry("condition Ready=Unknown match", testCase{
+			client: standardClient,
+			actual: testutil.NewUnstructuredTestResource("test-resource", "default",
+				metav1.Condition{
+					Type:   "Ready",
+					Status: metav1.ConditionUnknown,
+				},
+			),
+			conditionType:  "Ready",
+			expectedStatus: "Unknown",
+		}),
+
+		Entry("match with multiple conditions", testCase{
+			client: standardClient,
+			actual: testutil.NewUnstructuredTestResource("test-resource", "default",
+				metav1.Condition{
+					Type:   "Available",
+					Status: metav1.ConditionTrue,
+				},
+				metav1.Condition{
+					Type:   "Ready",
+					Status: metav1.ConditionTrue,
+				},
+				metav1.Condition{
+					Type:   "Progressing",
+					Status: metav1.ConditionFalse,
+				},
+			),
+			conditionType:  "Ready",
+			expectedStatus: "True",
+		}),
+
+		// Failure cases
+		Entry("no match with different status", testCase{
+			client: clientWithTestResource,
+			actual: testutil.NewTestResource("test-resource", "default",
+				metav1.Condition{
+					Type:   "Ready",
+					Status: metav1.ConditionFalse,
+				},
+			),
+			conditionType:  "Ready",
+			expectedStatus: "True",
+			expectedFailureLogs: []string{
+				"Expected actual to match Chainsaw template",
+				"[ERROR]",
+				"status.(conditions[?type == 'Ready'])[0].status: Invalid value: \"False\": Expected value: \"True\"",
+			},
+		}),
+
+		Entry("no match with missing condition", testCase{
+			client: clientWithTestResource,
+			actual: testutil.NewTestResource("test-resource", "default",
+				metav1.Condition{
+					Type:   "Available",
+					Status: metav1.ConditionTrue,
+				},
+			),
+			conditionType:  "Ready",
+			expectedStatus: "True",
+			expectedFailureLogs: []string{
+				"Expected actual to match Chainsaw template",
+				"[ERROR]",
+				"status.(conditions[?type == 'Ready']): Invalid value: []: lengths of slices don't match",
+			},
+		}),
+
+		// Edge cases
+		Entry("no match with empty conditions", testCase{
+			client:         clientWithTestResource,
+			actual:         testutil.NewTestResource("test-resource", "default"),
+			conditionType:  "Ready",
+			expectedStatus: "True",
+			expectedFailureLogs: []string{
+				"Expected actual to match Chainsaw template",
+				"[ERROR]",
+				"status.(conditions[?type == 'Ready']): Invalid value: null: value is null",
+			},
+		}),
+
+		Entry("no match with missing status field", testCase{
+			client: standardClient,
+			actual: func() any {
+				obj := &unstructured.Unstructured{}
+				obj.SetAPIVersion("example.com/v1")
+				obj.SetKind("TestResource")
+				obj.SetName("test-resource")
+				obj.SetNamespace("default")
+				return obj
+			}(),
+			conditionType:  "Ready",
+			expectedStatus: "True",
+			expectedFailureLogs: []string{
+				"Expected actual to match Chainsaw template",
+				"[ERROR]",
+				"status: Required value: field not found in the input object",
+			},
+		}),
+
+		// Generation-aware cases
+		Entry("generation match when observedGeneration exceeds minGeneration", testCase{
+			client: clientWithTestResource,
+			actual: testutil.NewTestResource("test-resource", "default",
+				metav1.Condition{
 					Type:               "Ready",
 					Status:             metav1.ConditionTrue,
 					ObservedGeneration: 3,
@@ -802,117 +1349,629 @@ var _ = Describe("HaveStatusCondition", func() {
 			expectedStatus: "True",
 			minGeneration:  []int64{2},
 			expectedFailureLogs: []string{
-				"Expected actual to match Chainsaw template",
-				"[ERROR]",
-				"(observedGeneration >= `2`)",
-				"Expected value: true",
+				"Expected actual to match Chainsaw template",
+				"[ERROR]",
+				"(observedGeneration >= `2`)",
+				"Expected value: true",
+			},
+		}),
+
+		Entry("no generation match when observedGeneration is absent", testCase{
+			client: clientWithTestResource,
+			actual: testutil.NewTestResource("test-resource", "default",
+				metav1.Condition{
+					Type:   "Ready",
+					Status: metav1.ConditionTrue,
+				},
+			),
+			conditionType:  "Ready",
+			expectedStatus: "True",
+			minGeneration:  []int64{2},
+			expectedFailureLogs: []string{
+				"Expected actual to match Chainsaw template",
+				"[ERROR]",
+				"(observedGeneration >= `2`)",
+				"Expected value: true",
+			},
+		}),
+
+		// Error cases
+		Entry("error on too many minGeneration values", testCase{
+			client: clientWithTestResource,
+			actual: testutil.NewTestResource("test-resource", "default",
+				metav1.Condition{
+					Type:               "Ready",
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 3,
+				},
+			),
+			conditionType:  "Ready",
+			expectedStatus: "True",
+			minGeneration:  []int64{1, 2},
+			expectedFailureLogs: []string{
+				"expected at most one minGeneration value",
+			},
+		}),
+
+		Entry("error on minGeneration of 0", testCase{
+			client: clientWithTestResource,
+			actual: testutil.NewTestResource("test-resource", "default",
+				metav1.Condition{
+					Type:               "Ready",
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 3,
+				},
+			),
+			conditionType:  "Ready",
+			expectedStatus: "True",
+			minGeneration:  []int64{0},
+			expectedFailureLogs: []string{
+				"minGeneration must be greater than 0",
+			},
+		}),
+
+		Entry("error on negative minGeneration", testCase{
+			client: clientWithTestResource,
+			actual: testutil.NewTestResource("test-resource", "default",
+				metav1.Condition{
+					Type:               "Ready",
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 3,
+				},
+			),
+			conditionType:  "Ready",
+			expectedStatus: "True",
+			minGeneration:  []int64{-1},
+			expectedFailureLogs: []string{
+				"minGeneration must be greater than 0",
+			},
+		}),
+
+		Entry("error on empty condition type", testCase{
+			client:         clientWithTestResource,
+			actual:         testutil.NewTestResource("test-resource", "default"),
+			conditionType:  "",
+			expectedStatus: "True",
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] status condition type must not be empty",
+			},
+		}),
+
+		Entry("error on nil input", testCase{
+			client:         standardClient,
+			actual:         nil,
+			conditionType:  "Ready",
+			expectedStatus: "True",
+			expectedFailureLogs: []string{
+				"actual must be a client.Object, not nil",
+			},
+		}),
+
+		Entry("error on non-object input", testCase{
+			client:         standardClient,
+			actual:         "not an object",
+			conditionType:  "Ready",
+			expectedStatus: "True",
+			expectedFailureLogs: []string{
+				"actual must be a client.Object, not string",
+			},
+		}),
+
+		Entry("error on unrecognized type", testCase{
+			client: standardClient, // standardClient doesn't have TestResource
+			actual: testutil.NewTestResource("test-resource", "default",
+				metav1.Condition{
+					Type:   "Ready",
+					Status: metav1.ConditionTrue,
+				},
+			),
+			conditionType:  "Ready",
+			expectedStatus: "True",
+			expectedFailureLogs: []string{
+				"failed to convert object to unstructured: no kind is registered for the type testutil.TestResource in scheme",
+			},
+		}),
+	)
+})
+
+var _ = Describe("HaveStatusConditions", func() {
+	type testCase struct {
+		actual              any
+		conditions          []sawchain.StatusCondition
+		expectedFailureLogs []string
+	}
+
+	clientWithTestResource := testutil.NewStandardFakeClientWithTestResource()
+
+	actual := testutil.NewTestResource("test-resource", "default",
+		metav1.Condition{
+			Type:               "Ready",
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 2,
+		},
+		metav1.Condition{
+			Type:    "Synced",
+			Status:  metav1.ConditionFalse,
+			Reason:  "ReconcileError",
+			Message: "connection refused",
+		},
+	)
+
+	DescribeTable("checking several object status conditions",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, clientWithTestResource)
+
+			// Test HaveStatusConditions
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				NewWithT(t).Expect(tc.actual).To(sc.HaveStatusConditions(tc.conditions...))
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+		},
+
+		Entry("all conditions match", testCase{
+			actual: actual,
+			conditions: []sawchain.StatusCondition{
+				{Type: "Ready", Status: "True", MinGeneration: 2},
+				{Type: "Synced", Status: "False", Reason: "ReconcileError", MessageSubstring: "refused"},
+			},
+		}),
+
+		Entry("one condition does not match", testCase{
+			actual: actual,
+			conditions: []sawchain.StatusCondition{
+				{Type: "Ready", Status: "True"},
+				{Type: "Synced", Status: "True"},
+			},
+			expectedFailureLogs: []string{
+				"Expected actual to match Chainsaw template",
+				"status.(conditions[?type == 'Synced'])[0].status: Invalid value: \"False\": Expected value: \"True\"",
+			},
+		}),
+
+		Entry("several conditions do not match", testCase{
+			actual: actual,
+			conditions: []sawchain.StatusCondition{
+				{Type: "Ready", Status: "True", MinGeneration: 3},
+				{Type: "Synced", Status: "False", MessagePattern: "^timeout"},
+			},
+			expectedFailureLogs: []string{
+				"(observedGeneration >= `3`): Invalid value: false: Expected value: true",
+				"(regex_match('^timeout', message || '')): Invalid value: false: Expected value: true",
 			},
 		}),
 
-		Entry("no generation match when observedGeneration is absent", testCase{
-			client: clientWithTestResource,
+		Entry("reason, message, and transition time match", testCase{
 			actual: testutil.NewTestResource("test-resource", "default",
 				metav1.Condition{
-					Type:   "Ready",
-					Status: metav1.ConditionTrue,
+					Type:               "Ready",
+					Status:             metav1.ConditionFalse,
+					Reason:             "ReconcileError",
+					Message:            `failed to pull image "nginx:bad"`,
+					LastTransitionTime: metav1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)),
 				},
 			),
-			conditionType:  "Ready",
-			expectedStatus: "True",
-			minGeneration:  []int64{2},
+			conditions: []sawchain.StatusCondition{{
+				Type:              "Ready",
+				Status:            "False",
+				Reason:            "ReconcileError",
+				MessageSubstring:  "failed to pull",
+				MessagePattern:    `^failed to pull image ".+"$`,
+				TransitionedSince: time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC),
+			}},
+		}),
+
+		Entry("no match with different reason", testCase{
+			actual: actual,
+			conditions: []sawchain.StatusCondition{
+				{Type: "Synced", Status: "False", Reason: "Progressing"},
+			},
 			expectedFailureLogs: []string{
 				"Expected actual to match Chainsaw template",
-				"[ERROR]",
-				"(observedGeneration >= `2`)",
-				"Expected value: true",
+				"status.(conditions[?type == 'Synced'])[0].reason: Invalid value: \"ReconcileError\": Expected value: \"Progressing\"",
 			},
 		}),
 
-		// Error cases
-		Entry("error on too many minGeneration values", testCase{
-			client: clientWithTestResource,
+		Entry("no match with stale transition time", testCase{
 			actual: testutil.NewTestResource("test-resource", "default",
 				metav1.Condition{
 					Type:               "Ready",
 					Status:             metav1.ConditionTrue,
-					ObservedGeneration: 3,
+					LastTransitionTime: metav1.NewTime(time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)),
 				},
 			),
-			conditionType:  "Ready",
-			expectedStatus: "True",
-			minGeneration:  []int64{1, 2},
+			conditions: []sawchain.StatusCondition{
+				{Type: "Ready", Status: "True", TransitionedSince: time.Date(2025, 1, 1, 12, 0, 1, 0, time.UTC)},
+			},
 			expectedFailureLogs: []string{
-				"expected at most one minGeneration value",
+				"!time_before(lastTransitionTime, '2025-01-01T12:00:01Z')): Invalid value: false: Expected value: true",
 			},
 		}),
 
-		Entry("error on minGeneration of 0", testCase{
-			client: clientWithTestResource,
-			actual: testutil.NewTestResource("test-resource", "default",
-				metav1.Condition{
-					Type:               "Ready",
-					Status:             metav1.ConditionTrue,
-					ObservedGeneration: 3,
-				},
-			),
-			conditionType:  "Ready",
-			expectedStatus: "True",
-			minGeneration:  []int64{0},
+		Entry("error on invalid message pattern", testCase{
+			actual: actual,
+			conditions: []sawchain.StatusCondition{
+				{Type: "Ready", Status: "True", MessagePattern: "(unclosed"},
+			},
 			expectedFailureLogs: []string{
-				"minGeneration must be greater than 0",
+				"[SAWCHAIN][ERROR] invalid status condition message pattern",
 			},
 		}),
 
 		Entry("error on negative minGeneration", testCase{
-			client: clientWithTestResource,
-			actual: testutil.NewTestResource("test-resource", "default",
-				metav1.Condition{
-					Type:               "Ready",
-					Status:             metav1.ConditionTrue,
-					ObservedGeneration: 3,
-				},
-			),
-			conditionType:  "Ready",
-			expectedStatus: "True",
-			minGeneration:  []int64{-1},
+			actual: actual,
+			conditions: []sawchain.StatusCondition{
+				{Type: "Ready", Status: "True", MinGeneration: -1},
+			},
 			expectedFailureLogs: []string{
-				"minGeneration must be greater than 0",
+				"[SAWCHAIN][ERROR] minGeneration must not be negative",
 			},
 		}),
 
-		Entry("error on nil input", testCase{
-			client:         standardClient,
-			actual:         nil,
-			conditionType:  "Ready",
-			expectedStatus: "True",
+		Entry("error on no conditions", testCase{
+			actual: actual,
 			expectedFailureLogs: []string{
-				"actual must be a client.Object, not nil",
+				"[SAWCHAIN][ERROR] expected at least one status condition",
 			},
 		}),
 
-		Entry("error on non-object input", testCase{
-			client:         standardClient,
-			actual:         "not an object",
-			conditionType:  "Ready",
-			expectedStatus: "True",
+		Entry("error on duplicate condition types", testCase{
+			actual: actual,
+			conditions: []sawchain.StatusCondition{
+				{Type: "Ready", Status: "True"},
+				{Type: "Ready", Status: "False"},
+			},
 			expectedFailureLogs: []string{
-				"actual must be a client.Object, not string",
+				"[SAWCHAIN][ERROR] status condition types must be distinct",
 			},
 		}),
 
-		Entry("error on unrecognized type", testCase{
-			client: standardClient, // standardClient doesn't have TestResource
-			actual: testutil.NewTestResource("test-resource", "default",
-				metav1.Condition{
-					Type:   "Ready",
-					Status: metav1.ConditionTrue,
-				},
-			),
-			conditionType:  "Ready",
-			expectedStatus: "True",
+		Entry("error on missing status", testCase{
+			actual: actual,
+			conditions: []sawchain.StatusCondition{
+				{Type: "Ready"},
+			},
 			expectedFailureLogs: []string{
-				"failed to convert object to unstructured: no kind is registered for the type testutil.TestResource in scheme",
+				"[SAWCHAIN][ERROR] status condition status must not be empty",
+			},
+		}),
+	)
+})
+
+var _ = Describe("BeReady", func() {
+	type testCase struct {
+		actual              any
+		expectedFailureLogs []string
+	}
+
+	clientWithTestResource := testutil.NewStandardFakeClientWithTestResource()
+
+	ready := testutil.NewTestResource("ready-resource", "default",
+		metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue})
+	notReady := testutil.NewTestResource("not-ready-resource", "default",
+		metav1.Condition{Type: "Ready", Status: metav1.ConditionFalse, Reason: "Waiting"})
+
+	DescribeTable("checking object readiness",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, clientWithTestResource)
+
+			// Test BeReady
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				NewWithT(t).Expect(tc.actual).To(sc.BeReady())
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+		},
+
+		Entry("ready object", testCase{
+			actual: ready,
+		}),
+
+		Entry("object without status", testCase{
+			actual: testutil.NewConfigMap("test-cm", "default", nil),
+		}),
+
+		Entry("object that is not ready", testCase{
+			actual: notReady,
+			expectedFailureLogs: []string{
+				"Expected actual to be ready",
+				"* example.com/v1/TestResource/default/not-ready-resource: Ready condition is False (Waiting)",
+			},
+		}),
+
+		Entry("slice with an object that is not ready", testCase{
+			actual: []client.Object{ready, notReady},
+			expectedFailureLogs: []string{
+				"1 of 2 resources not ready:",
+				"* example.com/v1/TestResource/default/not-ready-resource: Ready condition is False (Waiting)",
+			},
+		}),
+
+		Entry("invalid actual type", testCase{
+			actual: "not an object",
+			expectedFailureLogs: []string{
+				"actual must be a client.Object or a slice of client.Object, not string",
+			},
+		}),
+	)
+})
+
+var _ = Describe("HaveOwner, BeControlledBy, and HaveFinalizer", func() {
+	type testCase struct {
+		client              client.Client
+		matcher             func(sc *sawchain.Sawchain) types.GomegaMatcher
+		actual              any
+		expectedFailureLogs []string
+	}
+
+	owner := testutil.NewTestResource("test-owner", "default")
+	owner.UID = "owner-uid"
+	untypedOwner := &testutil.TestResource{ObjectMeta: metav1.ObjectMeta{Name: "test-owner", UID: "owner-uid"}}
+
+	child := testutil.NewConfigMap("test-cm", "default", nil)
+	child.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: "example.com/v1",
+		Kind:       "TestResource",
+		Name:       "test-owner",
+		UID:        "owner-uid",
+	}}
+	child.Finalizers = []string{"example.com/cleanup"}
+
+	DescribeTable("checking object ownership and finalizers",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			c := tc.client
+			if c == nil {
+				c = testutil.NewStandardFakeClientWithTestResource()
+			}
+			sc := sawchain.New(t, c)
+
+			// Test matcher
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				NewWithT(t).Expect(tc.actual).To(tc.matcher(sc))
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+		},
+
+		// HaveOwner
+		Entry("HaveOwner with owner", testCase{
+			matcher: func(sc *sawchain.Sawchain) types.GomegaMatcher { return sc.HaveOwner(owner) },
+			actual:  child,
+		}),
+
+		Entry("HaveOwner resolves owner type through client scheme", testCase{
+			matcher: func(sc *sawchain.Sawchain) types.GomegaMatcher { return sc.HaveOwner(untypedOwner) },
+			actual:  child,
+		}),
+
+		Entry("HaveOwner with another owner", testCase{
+			matcher: func(sc *sawchain.Sawchain) types.GomegaMatcher {
+				return sc.HaveOwner(testutil.NewTestResource("other-owner", "default"))
+			},
+			actual: child,
+			expectedFailureLogs: []string{
+				"Expected actual to have owner example.com/v1/TestResource other-owner",
+				"* example.com/v1/TestResource test-owner (uid: owner-uid, controller: false)",
+			},
+		}),
+
+		Entry("HaveOwner with nil owner", testCase{
+			matcher:             func(sc *sawchain.Sawchain) types.GomegaMatcher { return sc.HaveOwner(nil) },
+			actual:              child,
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] owner must not be nil"},
+		}),
+
+		Entry("HaveOwner with unregistered owner type", testCase{
+			client:              testutil.NewStandardFakeClient(),
+			matcher:             func(sc *sawchain.Sawchain) types.GomegaMatcher { return sc.HaveOwner(untypedOwner) },
+			actual:              child,
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] failed to determine owner GroupVersionKind"},
+		}),
+
+		// BeControlledBy
+		Entry("BeControlledBy with non-controller owner", testCase{
+			matcher: func(sc *sawchain.Sawchain) types.GomegaMatcher { return sc.BeControlledBy(owner) },
+			actual:  child,
+			expectedFailureLogs: []string{
+				"Expected actual to be controlled by example.com/v1/TestResource test-owner (uid: owner-uid)",
+			},
+		}),
+
+		Entry("BeControlledBy with owner name missing", testCase{
+			matcher:             func(sc *sawchain.Sawchain) types.GomegaMatcher { return sc.BeControlledBy(&testutil.TestResource{}) },
+			actual:              child,
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] owner name must not be empty"},
+		}),
+
+		// HaveFinalizer
+		Entry("HaveFinalizer with finalizer", testCase{
+			matcher: func(sc *sawchain.Sawchain) types.GomegaMatcher { return sc.HaveFinalizer("example.com/cleanup") },
+			actual:  child,
+		}),
+
+		Entry("HaveFinalizer without finalizer", testCase{
+			matcher: func(sc *sawchain.Sawchain) types.GomegaMatcher { return sc.HaveFinalizer("example.com/other") },
+			actual:  child,
+			expectedFailureLogs: []string{
+				`Expected actual to have finalizer "example.com/other"`,
+				"actual finalizers: example.com/cleanup",
+			},
+		}),
+
+		Entry("HaveFinalizer with empty name", testCase{
+			matcher:             func(sc *sawchain.Sawchain) types.GomegaMatcher { return sc.HaveFinalizer("") },
+			actual:              child,
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] finalizer must not be empty"},
+		}),
+	)
+})
+
+var _ = Describe("HaveEmittedEvent", func() {
+	type testCase struct {
+		template            string
+		bindings            map[string]any
+		actual              any
+		globalArgs          []any
+		emitLater           bool
+		expectedFailureLogs []string
+	}
+
+	subject := testutil.NewConfigMap("test-cm", "default", nil)
+
+	newEvent := func() *corev1.Event {
+		return &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-cm.failed", Namespace: "default"},
+			InvolvedObject: corev1.ObjectReference{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+				Name:       "test-cm",
+				Namespace:  "default",
+			},
+			Reason:  "SyncFailed",
+			Type:    corev1.EventTypeWarning,
+			Message: "failed to sync ConfigMap test-cm",
+		}
+	}
+
+	DescribeTable("checking Events emitted for objects",
+		func(tc testCase) {
+			// Initialize Sawchain
+			c := testutil.NewStandardFakeClient()
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, c, append([]any{fastTimeout, fastInterval}, tc.globalArgs...)...)
+
+			// Emit Event now or while waiting
+			if tc.emitLater {
+				go func() {
+					defer GinkgoRecover()
+					time.Sleep(fastTimeout / 4)
+					Expect(c.Create(ctx, newEvent())).To(Succeed())
+				}()
+			} else {
+				Expect(c.Create(ctx, newEvent())).To(Succeed())
+			}
+
+			// Test HaveEmittedEvent
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				NewWithT(t).Eventually(tc.actual, fastTimeout, fastInterval).
+					Should(sc.HaveEmittedEvent(ctx, tc.template, tc.bindings))
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+		},
+
+		Entry("matching Event", testCase{
+			template: `
+				apiVersion: v1
+				kind: Event
+				type: Warning
+				(contains(message, $name)): true
+			`,
+			bindings: map[string]any{"name": "test-cm"},
+			actual:   subject,
+		}),
+
+		Entry("matching Event emitted while waiting", testCase{
+			template: `
+				apiVersion: v1
+				kind: Event
+				reason: SyncFailed
+			`,
+			actual:    subject,
+			emitLater: true,
+		}),
+
+		Entry("partial template with strict instance", testCase{
+			template: `
+				apiVersion: v1
+				kind: Event
+				reason: SyncFailed
+			`,
+			actual:     subject,
+			globalArgs: []any{sawchain.Strict},
+		}),
+
+		Entry("no Event with matching reason", testCase{
+			template: `
+				apiVersion: v1
+				kind: Event
+				reason: Synced
+			`,
+			actual: subject,
+			expectedFailureLogs: []string{
+				"Expected actual to have emitted an Event matching Chainsaw template",
+				`reason: Invalid value: "SyncFailed": Expected value: "Synced"`,
+			},
+		}),
+
+		Entry("no Events about the object", testCase{
+			template: `
+				apiVersion: v1
+				kind: Event
+			`,
+			actual: testutil.NewConfigMap("other-cm", "default", nil),
+			expectedFailureLogs: []string{
+				"no actual v1 Event found for v1/ConfigMap default/other-cm (1 candidate(s) about other objects)",
 			},
 		}),
+
+		Entry("invalid actual type", testCase{
+			template: `
+				apiVersion: v1
+				kind: Event
+			`,
+			actual:              "not an object",
+			expectedFailureLogs: []string{"actual must be a client.Object, not string"},
+		}),
 	)
 })
@@ -0,0 +1,122 @@
+package sawchain
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	"github.com/guidewire-oss/sawchain/internal/options"
+)
+
+// namespaceSuffixLength is the length of the random suffix appended to generated namespace names.
+const namespaceSuffixLength = 5
+
+// CreateNamespace creates a uniquely named Namespace, registers a test cleanup that deletes it, and
+// returns a derived Sawchain instance whose global bindings include the namespace name as $namespace,
+// along with the generated name.
+//
+// # Arguments
+//
+//   - Prefix (string): Required. Prefix of the generated namespace name. Must be a valid DNS label
+//     prefix (e.g. "test").
+//
+//   - Seed (int): Optional. Values appended to the prefix before the random suffix, such as the Ginkgo
+//     parallel process index, to make namespaces easy to attribute to a process.
+//
+// # Notes
+//
+//   - Invalid input or a failure to create the namespace will result in immediate test failure.
+//
+//   - Generated names have the form "<prefix>[-<seed>...]-<random suffix>", so namespaces remain
+//     unique across concurrent suite runs sharing the same cluster.
+//
+//   - The derived instance shares the testing.TB, Gomega instance, client, and all other settings of
+//     the original instance. The original instance's bindings are not modified.
+//
+//   - On test cleanup, the namespace is deleted and Sawchain waits for it to terminate using its timeout
+//     and interval. Namespaces that fail to terminate in time (e.g. due to finalizers on resources they
+//     contain) cause a test failure.
+//
+//   - Namespace termination requires a namespace controller, which envtest does not run. When testing
+//     against envtest, initialize Sawchain with SkipNamespaceWait to delete the namespace without waiting;
+//     it then remains Terminating, but the random suffix keeps later namespaces from colliding with it.
+//
+//   - This is the recommended way to isolate specs that share a cluster when running tests in parallel.
+//     See docs/parallel-tests.md for isolation strategies.
+//
+// # Examples
+//
+// Create a namespace for the current test:
+//
+//	nsc, namespace := sc.CreateNamespace(ctx, "test")
+//
+// Create a namespace seeded with the Ginkgo parallel process index and use it in a template:
+//
+//	nsc, _ := sc.CreateNamespace(ctx, "test", GinkgoParallelProcess())
+//	nsc.CreateAndWait(ctx, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: ($namespace)
+//	`)
+func (s *Sawchain) CreateNamespace(ctx context.Context, prefix string, seed ...int) (*Sawchain, string) {
+	s.t.Helper()
+
+	// Check prefix
+	s.g.Expect(prefix).NotTo(gomega.BeEmpty(), errInvalidArgs)
+
+	// Generate name
+	parts := []string{prefix}
+	for _, n := range seed {
+		parts = append(parts, fmt.Sprint(n))
+	}
+	parts = append(parts, rand.String(namespaceSuffixLength))
+	name := strings.Join(parts, "-")
+
+	// Create namespace
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	s.g.Expect(s.c.Create(ctx, namespace)).To(gomega.Succeed(), errFailedCreateNamespace)
+
+	// Wait for create to be reflected
+	s.g.Eventually(s.getF(ctx, namespace), s.opts.Timeout, s.opts.Interval).Should(gomega.Succeed(), errCreateNotReflected)
+
+	// Register cleanup
+	s.t.Cleanup(func() { s.deleteNamespace(name) })
+
+	// Derive Sawchain
+	derived := *s
+	derived.opts.Bindings = s.mergeBindings(map[string]any{"namespace": name})
+
+	return &derived, name
+}
+
+// deleteNamespace deletes the named namespace and, unless SkipNamespaceWait is set, waits for it to
+// terminate using the instance's timeout and interval. Namespaces that are already gone are ignored.
+func (s *Sawchain) deleteNamespace(name string) {
+	s.t.Helper()
+
+	// Use a fresh context since the test context may already be canceled
+	ctx := context.Background()
+
+	// Delete namespace
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if err := s.c.Delete(ctx, namespace); !apierrors.IsNotFound(err) {
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedDeleteNamespace)
+	}
+
+	// Skip waiting if requested (e.g. in envtest)
+	if s.opts.Flags.Has(options.FlagSkipNamespaceWait) {
+		return
+	}
+
+	// Wait for termination
+	s.g.Eventually(func() error { return s.checkGone(ctx, namespace) },
+		s.opts.Timeout, s.opts.Interval).Should(gomega.Succeed(), errNamespaceNotTerminated)
+}
@@ -0,0 +1,212 @@
+package sawchain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain"
+	"github.com/guidewire-oss/sawchain/internal/testutil"
+)
+
+var _ = Describe("CreateNamespace", func() {
+	// renderBinding renders the value of the given binding with the given Sawchain instance.
+	renderBinding := func(sc *sawchain.Sawchain, name string) string {
+		GinkgoT().Helper()
+		cm := &corev1.ConfigMap{}
+		sc.RenderSingle(cm, `
+			apiVersion: v1
+			kind: ConfigMap
+			metadata:
+			  name: test-cm
+			data:
+			  value: ($`+name+`)
+		`)
+		return cm.Data["value"]
+	}
+
+	type testCase struct {
+		client              client.Client
+		globalBindings      map[string]any
+		prefix              string
+		seed                []int
+		expectedName        string
+		expectedFailureLogs []string
+	}
+	DescribeTable("creating isolated namespaces",
+		func(tc testCase) {
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval, tc.globalBindings)
+
+			// Test CreateNamespace
+			var (
+				nsc  *sawchain.Sawchain
+				name string
+			)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				nsc, name = sc.CreateNamespace(ctx, tc.prefix, tc.seed...)
+			}()
+			<-done
+
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+				return
+			}
+			Expect(t.Failed()).To(BeFalse(), "expected no failure")
+
+			// Verify name and namespace
+			Expect(name).To(MatchRegexp(tc.expectedName))
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+			Expect(tc.client.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed(), "expected namespace to be created")
+
+			// Verify derived bindings
+			Expect(nsc).NotTo(BeNil())
+			Expect(nsc).NotTo(BeIdenticalTo(sc))
+			obj := nsc.RenderSingle(`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: ($namespace)
+			`)
+			Expect(obj.GetNamespace()).To(Equal(name))
+			for k, v := range tc.globalBindings {
+				if k != "namespace" {
+					Expect(renderBinding(nsc, k)).To(Equal(v))
+				}
+			}
+
+			// Verify cleanup
+			Expect(t.cleanups).To(HaveLen(1), "expected cleanup to be registered")
+			t.RunCleanups()
+			Expect(t.Failed()).To(BeFalse(), "expected no cleanup failure")
+			err := tc.client.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)
+			Expect(err).To(HaveOccurred(), "expected namespace to be deleted")
+		},
+
+		// Success cases
+		Entry("should create namespace with prefix", testCase{
+			client:       testutil.NewStandardFakeClient(),
+			prefix:       "test",
+			expectedName: `^test-[a-z0-9]{5}$`,
+		}),
+
+		Entry("should create namespace with prefix and seed", testCase{
+			client:       testutil.NewStandardFakeClient(),
+			prefix:       "test",
+			seed:         []int{3},
+			expectedName: `^test-3-[a-z0-9]{5}$`,
+		}),
+
+		Entry("should create namespace with prefix and multiple seeds", testCase{
+			client:       testutil.NewStandardFakeClient(),
+			prefix:       "test",
+			seed:         []int{42, 3},
+			expectedName: `^test-42-3-[a-z0-9]{5}$`,
+		}),
+
+		Entry("should preserve global bindings", testCase{
+			client:         testutil.NewStandardFakeClient(),
+			globalBindings: map[string]any{"foo": "bar"},
+			prefix:         "test",
+			expectedName:   `^test-[a-z0-9]{5}$`,
+		}),
+
+		Entry("should override global namespace binding", testCase{
+			client:         testutil.NewStandardFakeClient(),
+			globalBindings: map[string]any{"namespace": "default"},
+			prefix:         "test",
+			expectedName:   `^test-[a-z0-9]{5}$`,
+		}),
+
+		// Failure cases
+		Entry("should fail with empty prefix", testCase{
+			client:              testutil.NewStandardFakeClient(),
+			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] invalid arguments"},
+		}),
+
+		Entry("should fail when create fails", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient(), createFailFirstN: -1},
+			prefix: "test",
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to create namespace",
+				"simulated create failure",
+			},
+		}),
+	)
+
+	It("should generate unique names", func() {
+		sc := sawchain.New(GinkgoTB(), testutil.NewStandardFakeClient(), fastTimeout, fastInterval)
+		_, name1 := sc.CreateNamespace(ctx, "test", 1)
+		_, name2 := sc.CreateNamespace(ctx, "test", 1)
+		Expect(name1).NotTo(Equal(name2))
+	})
+
+	It("should not modify the original instance", func() {
+		sc := sawchain.New(GinkgoTB(), testutil.NewStandardFakeClient(), fastTimeout, fastInterval,
+			map[string]any{"namespace": "default"})
+		_, name := sc.CreateNamespace(ctx, "test")
+		Expect(name).NotTo(Equal("default"))
+		Expect(renderBinding(sc, "namespace")).To(Equal("default"))
+	})
+
+	It("should fail when namespace deletion fails on cleanup", func() {
+		t := &MockT{TB: GinkgoTB()}
+		c := &MockClient{Client: testutil.NewStandardFakeClient(), deleteFailFirstN: -1}
+		sc := sawchain.New(t, c, fastTimeout, fastInterval)
+		sc.CreateNamespace(ctx, "test")
+
+		t.RunCleanups()
+
+		Expect(t.Failed()).To(BeTrue(), "expected failure")
+		Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("[SAWCHAIN][ERROR] failed to delete namespace during cleanup")))
+		Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("simulated delete failure")))
+	})
+
+	It("should fail when namespace does not terminate", func() {
+		t := &MockT{TB: GinkgoTB()}
+		c := testutil.NewStandardFakeClient()
+		sc := sawchain.New(t, c, fastTimeout, fastInterval)
+		_, name := sc.CreateNamespace(ctx, "test")
+
+		// Add finalizer to block termination
+		namespace := &corev1.Namespace{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: name}, namespace)).To(Succeed())
+		namespace.Finalizers = []string{"example.com/finalizer"}
+		Expect(c.Update(ctx, namespace)).To(Succeed())
+
+		t.RunCleanups()
+
+		Expect(t.Failed()).To(BeTrue(), "expected failure")
+		Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+			"[SAWCHAIN][ERROR] namespace termination not reflected within timeout")))
+		Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("stuck on finalizers [example.com/finalizer]")))
+	})
+
+	It("should not wait for namespace to terminate with SkipNamespaceWait", func() {
+		t := &MockT{TB: GinkgoTB()}
+		c := testutil.NewStandardFakeClient()
+		sc := sawchain.New(t, c, fastTimeout, fastInterval, sawchain.SkipNamespaceWait)
+		_, name := sc.CreateNamespace(ctx, "test")
+
+		// Add finalizer to block termination, as in envtest (which has no namespace controller)
+		namespace := &corev1.Namespace{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: name}, namespace)).To(Succeed())
+		namespace.Finalizers = []string{"example.com/finalizer"}
+		Expect(c.Update(ctx, namespace)).To(Succeed())
+
+		t.RunCleanups()
+
+		Expect(t.Failed()).To(BeFalse(), "expected no failure")
+		Expect(c.Get(ctx, client.ObjectKey{Name: name}, namespace)).To(Succeed())
+		Expect(namespace.DeletionTimestamp).NotTo(BeNil(), "expected namespace to be terminating")
+	})
+})
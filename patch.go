@@ -0,0 +1,307 @@
+package sawchain
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/chainsaw"
+	"github.com/guidewire-oss/sawchain/internal/options"
+)
+
+// Patch patches resources with a strategic merge patch rendered from a manifest or Chainsaw template,
+// or with an RFC 6902 JSON Patch rendered from a Chainsaw template, and returns an error if any client
+// Patch operations fail.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template. By
+//     default, template documents are used as strategic merge patches and must contain type metadata,
+//     identifying metadata, and the fields to be patched. With JSONPatch, the template must contain a
+//     single list of JSON Patch operations (values may use template expressions). If provided with an
+//     object (and without JSONPatch), must contain exactly one resource definition matching the type
+//     of the object. If provided with a slice of objects, must contain resource definitions exactly
+//     matching the count, order, and types of the objects.
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. Required with JSONPatch, in which case it identifies the resource to patch (type
+//     metadata, name, and namespace). The patched state is written to the object.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for writing the patched states
+//     of multiple resources. Not supported with JSONPatch.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - JSONPatch (sawchain.Flag): If provided, the template is used as an RFC 6902 JSON Patch document
+//     for the resource identified by the object.
+//
+// An object and a slice of objects may not be provided together.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Unlike the JSON merge patches used by Update, strategic merge patches merge lists by key (e.g.
+//     containers by name) and support directives such as "$patch: delete". Strategic merge patches are
+//     only supported for built-in types; use JSONPatch for custom resources.
+//
+//   - When running tests in parallel, ensure resource names or namespaces are unique per process to
+//     prevent collisions. See docs/parallel-tests.md for isolation strategies.
+//
+//   - Use PatchAndWait instead of Patch if you need to ensure patches are successful and the client
+//     cache is synced.
+//
+// # Examples
+//
+// Patch one container of a Deployment with a strategic merge patch:
+//
+//	err := sc.Patch(ctx, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  spec:
+//	    template:
+//	      spec:
+//	        containers:
+//	        - name: app
+//	          image: ($image)
+//	  `, map[string]any{"name": "test-app", "namespace": "default", "image": "app:v2"})
+//
+// Remove one container from a Deployment with a strategic merge patch and save the patched state to an object:
+//
+//	deployment := &appsv1.Deployment{}
+//	err := sc.Patch(ctx, deployment, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: test-app
+//	    namespace: default
+//	  spec:
+//	    template:
+//	      spec:
+//	        containers:
+//	        - name: sidecar
+//	          $patch: delete
+//	`)
+//
+// Patch a resource with a JSON Patch and bindings:
+//
+//	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-cm", Namespace: "default"}}
+//	err := sc.Patch(ctx, configMap, sawchain.JSONPatch, `
+//	  - op: remove
+//	    path: /data/obsolete-key
+//	  - op: replace
+//	    path: /data/key
+//	    value: ($value)
+//	  `, map[string]any{"value": "patched-value"})
+func (s *Sawchain) Patch(ctx context.Context, args ...any) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true, Flags: options.FlagJSONPatch}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	if opts.Flags.Has(options.FlagJSONPatch) {
+		// Check required options
+		s.g.Expect(options.RequireObject(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+		// Render JSON patch
+		patch := s.renderJSONPatch(ctx, opts)
+
+		// Patch resource
+		return s.c.Patch(ctx, opts.Object, client.RawPatch(types.JSONPatchType, patch))
+	}
+
+	// Render template
+	unstructuredObjs := s.renderForObjects(ctx, opts)
+
+	// Patch resources
+	for i := range unstructuredObjs {
+		// Use index to update object in outer scope
+		if err := s.strategicMergePatch(ctx, &unstructuredObjs[i]); err != nil {
+			return err
+		}
+	}
+
+	// Save objects
+	s.saveToObjects(unstructuredObjs, opts)
+
+	return nil
+}
+
+// PatchAndWait patches resources with a strategic merge patch rendered from a manifest or Chainsaw
+// template, or with an RFC 6902 JSON Patch rendered from a Chainsaw template, and ensures client Get
+// operations for all resources reflect the patches within a configurable duration before returning.
+// If testing with a cached client, this ensures the client cache is synced and it is safe to make
+// assertions on the patched resources immediately after execution.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template. By
+//     default, template documents are used as strategic merge patches and must contain type metadata,
+//     identifying metadata, and the fields to be patched. With JSONPatch, the template must contain a
+//     single list of JSON Patch operations (values may use template expressions). If provided with an
+//     object (and without JSONPatch), must contain exactly one resource definition matching the type
+//     of the object. If provided with a slice of objects, must contain resource definitions exactly
+//     matching the count, order, and types of the objects.
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. Required with JSONPatch, in which case it identifies the resource to patch (type
+//     metadata, name, and namespace). The patched state is written to the object.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for writing the patched states
+//     of multiple resources. Not supported with JSONPatch.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - JSONPatch (sawchain.Flag): If provided, the template is used as an RFC 6902 JSON Patch document
+//     for the resource identified by the object.
+//
+//   - Timeout (string or time.Duration): Duration within which client Get operations for all resources
+//     should reflect the patches. If provided, must be before interval. Defaults to Sawchain's
+//     global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for checking the resources after patching.
+//     If provided, must be after timeout. Defaults to Sawchain's global interval value.
+//
+// An object and a slice of objects may not be provided together. All arguments except the template are
+// optional (unless noted otherwise).
+//
+// # Notes
+//
+//   - Invalid input, client errors, and timeout errors will result in immediate test failure.
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Unlike the JSON merge patches used by UpdateAndWait, strategic merge patches merge lists by key
+//     (e.g. containers by name) and support directives such as "$patch: delete". Strategic merge patches
+//     are only supported for built-in types; use JSONPatch for custom resources.
+//
+//   - When running tests in parallel, ensure resource names or namespaces are unique per process to
+//     prevent collisions. See docs/parallel-tests.md for isolation strategies.
+//
+//   - Use Patch instead of PatchAndWait if you need to patch resources without ensuring success.
+//
+// # Examples
+//
+// Patch one container of a Deployment with a strategic merge patch and override duration settings:
+//
+//	sc.PatchAndWait(ctx, `
+//	  apiVersion: apps/v1
+//	  kind: Deployment
+//	  metadata:
+//	    name: test-app
+//	    namespace: default
+//	  spec:
+//	    template:
+//	      spec:
+//	        containers:
+//	        - name: app
+//	          image: app:v2
+//	`, "10s", "2s")
+//
+// Patch a resource with a JSON Patch and bindings:
+//
+//	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "test-cm", Namespace: "default"}}
+//	sc.PatchAndWait(ctx, configMap, sawchain.JSONPatch, `
+//	  - op: remove
+//	    path: /data/obsolete-key
+//	  - op: replace
+//	    path: /data/key
+//	    value: ($value)
+//	  `, map[string]any{"value": "patched-value"})
+func (s *Sawchain) PatchAndWait(ctx context.Context, args ...any) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true, Flags: options.FlagJSONPatch}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	if opts.Flags.Has(options.FlagJSONPatch) {
+		// Check required options
+		s.g.Expect(options.RequireObject(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+		// Render JSON patch
+		patch := s.renderJSONPatch(ctx, opts)
+
+		// Patch resource
+		s.g.Expect(s.c.Patch(ctx, opts.Object, client.RawPatch(types.JSONPatchType, patch))).To(
+			gomega.Succeed(), errFailedJSONPatch)
+
+		// Wait for patch to be reflected
+		patchedResourceVersion := opts.Object.GetResourceVersion()
+		s.g.Eventually(s.checkResourceVersionF(ctx, opts.Object, patchedResourceVersion),
+			opts.Timeout, opts.Interval).Should(gomega.Succeed(), errPatchNotReflected)
+		return
+	}
+
+	// Render template
+	unstructuredObjs := s.renderForObjects(ctx, opts)
+
+	// Patch resources
+	for i := range unstructuredObjs {
+		// Use index to update object in outer scope
+		s.g.Expect(s.strategicMergePatch(ctx, &unstructuredObjs[i])).To(gomega.Succeed(), errFailedPatchWithTemplate)
+	}
+
+	// Wait for patch to be reflected
+	s.waitForResourceVersions(ctx, unstructuredRefs(unstructuredObjs), opts, errPatchNotReflected)
+
+	// Save objects
+	s.saveToObjects(unstructuredObjs, opts)
+}
+
+// HELPERS
+
+// renderJSONPatch renders the template in opts into a JSON Patch document.
+func (s *Sawchain) renderJSONPatch(ctx context.Context, opts *options.Options) []byte {
+	s.t.Helper()
+
+	bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+	patch, err := chainsaw.RenderJSONPatch(ctx, opts.Template, bindings, s.funcs)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+
+	return patch
+}
+
+// strategicMergePatch sends obj as a strategic merge patch for the resource it identifies,
+// writing the patched state back to obj.
+func (s *Sawchain) strategicMergePatch(ctx context.Context, obj *unstructured.Unstructured) error {
+	patch, err := json.Marshal(obj.Object)
+	if err != nil {
+		return err
+	}
+	return s.c.Patch(ctx, obj, client.RawPatch(types.StrategicMergePatchType, patch))
+}
@@ -0,0 +1,841 @@
+package sawchain_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain"
+	"github.com/guidewire-oss/sawchain/internal/testutil"
+)
+
+// newPod returns a typed Pod with the given name, namespace, and containers (name/image pairs).
+func newPod(name, namespace string, containers ...string) *corev1.Pod {
+	pod := &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Pod",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+	for i := 0; i+1 < len(containers); i += 2 {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: containers[i], Image: containers[i+1]})
+	}
+	return pod
+}
+
+var _ = Describe("Patch", func() {
+	type testCase struct {
+		originalObjs        []client.Object
+		client              client.Client
+		globalBindings      map[string]any
+		methodArgs          []any
+		expectedReturnErrs  []string
+		expectedFailureLogs []string
+		expectedObj         client.Object
+		expectedObjs        []client.Object
+	}
+	DescribeTable("patching test resources",
+		func(tc testCase) {
+			// Create original objects
+			for _, obj := range tc.originalObjs {
+				Expect(tc.client.Create(ctx, obj)).To(Succeed(), "failed to create original object")
+			}
+
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval, tc.globalBindings)
+
+			// Test Patch
+			var err error
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				err = sc.Patch(ctx, tc.methodArgs...)
+			}()
+			<-done
+
+			// Verify error
+			if len(tc.expectedReturnErrs) > 0 {
+				Expect(err).To(HaveOccurred(), "expected error")
+				for _, expectedErr := range tc.expectedReturnErrs {
+					Expect(err.Error()).To(ContainSubstring(expectedErr))
+				}
+			} else {
+				Expect(err).NotTo(HaveOccurred(), "expected no error")
+			}
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+
+			if tc.expectedObj != nil {
+				// Verify successful patch of single resource
+				key := client.ObjectKeyFromObject(tc.expectedObj)
+				actual := copy(tc.expectedObj)
+				Expect(tc.client.Get(ctx, key, actual)).To(Succeed(), "resource not found")
+				Expect(intent(tc.client, actual)).To(Equal(intent(tc.client, tc.expectedObj)), "resource not patched")
+
+				// Verify resource state
+				for _, arg := range tc.methodArgs {
+					if obj, ok := arg.(client.Object); ok {
+						Expect(intent(tc.client, obj)).To(Equal(intent(tc.client, tc.expectedObj)), "resource state not saved to provided object")
+						break
+					}
+				}
+			}
+
+			if len(tc.expectedObjs) > 0 {
+				// Verify successful patches of multiple resources
+				for _, expectedObj := range tc.expectedObjs {
+					key := client.ObjectKeyFromObject(expectedObj)
+					actual := copy(expectedObj)
+					Expect(tc.client.Get(ctx, key, actual)).To(Succeed(), "resource not found: %s", key)
+					Expect(intent(tc.client, actual)).To(Equal(intent(tc.client, expectedObj)), "resource not patched: %s", key)
+				}
+
+				// Verify resource states
+				for _, arg := range tc.methodArgs {
+					if objs, ok := arg.([]client.Object); ok {
+						Expect(objs).To(HaveLen(len(tc.expectedObjs)), "unexpected objects length")
+						for i, obj := range objs {
+							Expect(intent(tc.client, obj)).To(Equal(intent(tc.client, tc.expectedObjs[i])), "resource state not saved to provided object")
+						}
+						break
+					}
+				}
+			}
+		},
+
+		// Success cases - strategic merge patch
+		Entry("should patch ConfigMap with static template string", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+					"key3": "preserved",
+				}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key1: replaced
+				  key2: null
+				  key4: added
+				`,
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{
+				"key1": "replaced",
+				"key3": "preserved",
+				"key4": "added",
+			}),
+		}),
+
+		Entry("should patch ConfigMap with template string and bindings", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "test-ns", map[string]string{"key1": "value1"}),
+			},
+			client:         &MockClient{Client: testutil.NewStandardFakeClient()},
+			globalBindings: map[string]any{"namespace": "test-ns"},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: ($name)
+				  namespace: ($namespace)
+				data:
+				  key1: ($value)
+				`,
+				map[string]any{"name": "test-cm", "value": "patched"},
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "test-ns", map[string]string{"key1": "patched"}),
+		}),
+
+		Entry("should merge Pod containers by name", testCase{
+			originalObjs: []client.Object{
+				newPod("test-pod", "default", "app", "app:v1", "sidecar", "sidecar:v1"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: Pod
+				metadata:
+				  name: test-pod
+				  namespace: default
+				spec:
+				  containers:
+				  - name: app
+				    image: app:v2
+				`,
+			},
+			expectedObj: newPod("test-pod", "default", "app", "app:v2", "sidecar", "sidecar:v1"),
+		}),
+
+		Entry("should delete Pod container with patch directive and save to typed object", testCase{
+			originalObjs: []client.Object{
+				newPod("test-pod", "default", "app", "app:v1", "sidecar", "sidecar:v1"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				&corev1.Pod{},
+				`
+				apiVersion: v1
+				kind: Pod
+				metadata:
+				  name: test-pod
+				  namespace: default
+				spec:
+				  containers:
+				  - name: sidecar
+				    $patch: delete
+				`,
+			},
+			expectedObj: newPod("test-pod", "default", "app", "app:v1"),
+		}),
+
+		Entry("should patch ConfigMap with template string and save to unstructured object", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1"}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				&unstructured.Unstructured{},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key2: value2
+				`,
+			},
+			expectedObj: testutil.NewUnstructuredConfigMap("test-cm", "default", map[string]string{
+				"key1": "value1",
+				"key2": "value2",
+			}),
+		}),
+
+		Entry("should patch multiple resources with template string and save to typed objects", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", map[string]string{"key1": "value1"}),
+				testutil.NewConfigMap("test-cm2", "default", map[string]string{"key2": "value2"}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				[]client.Object{&corev1.ConfigMap{}, &corev1.ConfigMap{}},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				data:
+				  key1: patched1
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm2
+				  namespace: default
+				data:
+				  key2: patched2
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", map[string]string{"key1": "patched1"}),
+				testutil.NewConfigMap("test-cm2", "default", map[string]string{"key2": "patched2"}),
+			},
+		}),
+
+		// Success cases - JSON patch
+		Entry("should patch ConfigMap with JSON patch and typed object", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{
+					"key1": "value1",
+					"key2": "value2",
+				}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.JSONPatch,
+				`
+				- op: remove
+				  path: /data/key1
+				- op: replace
+				  path: /data/key2
+				  value: replaced
+				- op: add
+				  path: /data/key3
+				  value: added
+				`,
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{
+				"key2": "replaced",
+				"key3": "added",
+			}),
+		}),
+
+		Entry("should patch ConfigMap with JSON patch, bindings, and unstructured object", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1"}),
+			},
+			client:         &MockClient{Client: testutil.NewStandardFakeClient()},
+			globalBindings: map[string]any{"path": "/data/key1"},
+			methodArgs: []any{
+				testutil.NewUnstructuredConfigMap("test-cm", "default", nil),
+				sawchain.JSONPatch,
+				`
+				- op: test
+				  path: ($path)
+				  value: value1
+				- op: replace
+				  path: ($path)
+				  value: ($value)
+				`,
+				map[string]any{"value": "patched"},
+			},
+			expectedObj: testutil.NewUnstructuredConfigMap("test-cm", "default", map[string]string{"key1": "patched"}),
+		}),
+
+		Entry("should patch custom resource with JSON patch", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResource()},
+			methodArgs: []any{
+				testutil.NewTestResource("test-cr", "default"),
+				sawchain.JSONPatch,
+				`
+				- op: replace
+				  path: /data
+				  value: patched
+				`,
+			},
+			expectedObj: testutil.NewTestResource("test-cr", "default", "patched"),
+		}),
+
+		// Error cases
+		Entry("should return patch error for template", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{
+				Client:          testutil.NewStandardFakeClient(),
+				patchFailFirstN: 1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: patched
+				`,
+			},
+			expectedReturnErrs: []string{"simulated patch failure"},
+		}),
+
+		Entry("should return patch error for JSON patch", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{
+				Client:          testutil.NewStandardFakeClient(),
+				patchFailFirstN: 1,
+			},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.JSONPatch,
+				`
+				- op: replace
+				  path: /data/foo
+				  value: patched
+				`,
+			},
+			expectedReturnErrs: []string{"simulated patch failure"},
+		}),
+
+		Entry("should return not found error for missing resource", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: patched
+				`,
+			},
+			expectedReturnErrs: []string{"not found"},
+		}),
+
+		Entry("should return error for failed JSON patch test operation", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.JSONPatch,
+				`
+				- op: test
+				  path: /data/foo
+				  value: unexpected
+				`,
+			},
+			expectedReturnErrs: []string{"test failed"},
+		}),
+
+		// Failure cases
+		Entry("should fail with no template", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string)",
+			},
+		}),
+
+		Entry("should fail with JSON patch and no object", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				sawchain.JSONPatch,
+				`
+				- op: remove
+				  path: /data/foo
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Object (client.Object)",
+			},
+		}),
+
+		Entry("should fail with invalid JSON patch", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.JSONPatch,
+				`
+				- op: unknown
+				  path: /data/foo
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid template",
+				"invalid JSON patch: operation 0 must have a valid op",
+			},
+		}),
+
+		Entry("should fail with unsupported flag", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				sawchain.AutoCleanup,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unsupported flag argument: AutoCleanup",
+			},
+		}),
+
+		Entry("should fail with missing binding", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: ($missing)
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid template",
+				"failed to render template",
+				"variable not defined: $missing",
+			},
+		}),
+
+		Entry("should fail with multi-resource template and single object", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				&corev1.ConfigMap{},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm2
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] single object insufficient for multi-resource template",
+			},
+		}),
+	)
+})
+
+var _ = Describe("PatchAndWait", func() {
+	type testCase struct {
+		originalObjs        []client.Object
+		client              client.Client
+		globalBindings      map[string]any
+		methodArgs          []any
+		expectedFailureLogs []string
+		expectedObj         client.Object
+		expectedObjs        []client.Object
+		expectedDuration    time.Duration
+	}
+	DescribeTable("patching test resources and waiting",
+		func(tc testCase) {
+			// Create original objects
+			for _, obj := range tc.originalObjs {
+				Expect(tc.client.Create(ctx, obj)).To(Succeed(), "failed to create original object")
+			}
+
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval, tc.globalBindings)
+
+			// Test PatchAndWait
+			done := make(chan struct{})
+			start := time.Now()
+			go func() {
+				defer close(done)
+				sc.PatchAndWait(ctx, tc.methodArgs...)
+			}()
+			<-done
+			executionTime := time.Since(start)
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+
+			if tc.expectedObj != nil {
+				// Verify successful patch of single resource
+				key := client.ObjectKeyFromObject(tc.expectedObj)
+				actual := copy(tc.expectedObj)
+				Expect(tc.client.Get(ctx, key, actual)).To(Succeed(), "resource not found")
+				Expect(intent(tc.client, actual)).To(Equal(intent(tc.client, tc.expectedObj)), "resource not patched")
+
+				// Verify resource state
+				for _, arg := range tc.methodArgs {
+					if obj, ok := arg.(client.Object); ok {
+						Expect(intent(tc.client, obj)).To(Equal(intent(tc.client, tc.expectedObj)), "resource state not saved to provided object")
+						break
+					}
+				}
+			}
+
+			if len(tc.expectedObjs) > 0 {
+				// Verify successful patches of multiple resources
+				for _, expectedObj := range tc.expectedObjs {
+					key := client.ObjectKeyFromObject(expectedObj)
+					actual := copy(expectedObj)
+					Expect(tc.client.Get(ctx, key, actual)).To(Succeed(), "resource not found: %s", key)
+					Expect(intent(tc.client, actual)).To(Equal(intent(tc.client, expectedObj)), "resource not patched: %s", key)
+				}
+
+				// Verify resource states
+				for _, arg := range tc.methodArgs {
+					if objs, ok := arg.([]client.Object); ok {
+						Expect(objs).To(HaveLen(len(tc.expectedObjs)), "unexpected objects length")
+						for i, obj := range objs {
+							Expect(intent(tc.client, obj)).To(Equal(intent(tc.client, tc.expectedObjs[i])), "resource state not saved to provided object")
+						}
+						break
+					}
+				}
+			}
+
+			// Verify execution time
+			if tc.expectedDuration > 0 {
+				maxAllowedDuration := time.Duration(float64(tc.expectedDuration) * 1.2)
+				Expect(executionTime).To(BeNumerically("<", maxAllowedDuration),
+					"expected execution time %v to be less than %v",
+					executionTime, maxAllowedDuration)
+			}
+		},
+
+		// Success cases - strategic merge patch
+		Entry("should patch ConfigMap with template string and bindings", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{
+					"key1": "value1",
+					"key2": "preserved",
+				}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: ($name)
+				  namespace: default
+				data:
+				  key1: patched
+				`,
+				map[string]any{"name": "test-cm"},
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{
+				"key1": "patched",
+				"key2": "preserved",
+			}),
+		}),
+
+		Entry("should merge Pod containers by name and save to typed object", testCase{
+			originalObjs: []client.Object{
+				newPod("test-pod", "default", "app", "app:v1", "sidecar", "sidecar:v1"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				&corev1.Pod{},
+				`
+				apiVersion: v1
+				kind: Pod
+				metadata:
+				  name: test-pod
+				  namespace: default
+				spec:
+				  containers:
+				  - name: sidecar
+				    image: sidecar:v2
+				`,
+			},
+			expectedObj: newPod("test-pod", "default", "app", "app:v1", "sidecar", "sidecar:v2"),
+		}),
+
+		Entry("should patch multiple resources with template string and save to unstructured objects", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm1", "default", map[string]string{"key1": "value1"}),
+				testutil.NewConfigMap("test-cm2", "default", map[string]string{"key2": "value2"}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				[]client.Object{&unstructured.Unstructured{}, &unstructured.Unstructured{}},
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm1
+				  namespace: default
+				data:
+				  key1: patched1
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm2
+				  namespace: default
+				data:
+				  key2: patched2
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewUnstructuredConfigMap("test-cm1", "default", map[string]string{"key1": "patched1"}),
+				testutil.NewUnstructuredConfigMap("test-cm2", "default", map[string]string{"key2": "patched2"}),
+			},
+		}),
+
+		// Success cases - JSON patch
+		Entry("should patch ConfigMap with JSON patch and bindings", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1"}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.JSONPatch,
+				`
+				- op: replace
+				  path: /data/key1
+				  value: ($value)
+				`,
+				map[string]any{"value": "patched"},
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "patched"}),
+		}),
+
+		Entry("should respect custom timeout and interval", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.JSONPatch,
+				`
+				- op: replace
+				  path: /data/foo
+				  value: patched
+				`,
+				fastTimeout, fastInterval,
+			},
+			expectedObj:      testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "patched"}),
+			expectedDuration: fastTimeout,
+		}),
+
+		Entry("should handle transient get failures", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{
+				Client:        testutil.NewStandardFakeClient(),
+				getFailFirstN: 2, // Fail the first 2 get attempts
+			},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: patched
+				`,
+			},
+			expectedObj:      testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "patched"}),
+			expectedDuration: fastTimeout,
+		}),
+
+		// Failure cases
+		Entry("should fail when patch with template fails", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{
+				Client:          testutil.NewStandardFakeClient(),
+				patchFailFirstN: -1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: patched
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to patch with template",
+				"simulated patch failure",
+			},
+		}),
+
+		Entry("should fail when JSON patch fails", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{
+				Client:          testutil.NewStandardFakeClient(),
+				patchFailFirstN: -1,
+			},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", nil),
+				sawchain.JSONPatch,
+				`
+				- op: replace
+				  path: /data/foo
+				  value: patched
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to patch with JSON patch",
+				"simulated patch failure",
+			},
+		}),
+
+		Entry("should fail when get fails", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{
+				Client:        testutil.NewStandardFakeClient(),
+				getFailFirstN: -1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: patched
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] patch not reflected within timeout (client cache sync delay)",
+				"simulated get failure",
+			},
+		}),
+
+		Entry("should fail with JSON patch and objects", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				[]client.Object{&corev1.ConfigMap{}},
+				sawchain.JSONPatch,
+				`
+				- op: remove
+				  path: /data/foo
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Object (client.Object)",
+			},
+		}),
+	)
+})
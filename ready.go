@@ -0,0 +1,180 @@
+package sawchain
+
+import (
+	"context"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/chainsaw"
+	"github.com/guidewire-oss/sawchain/internal/options"
+	"github.com/guidewire-oss/sawchain/internal/readiness"
+	"github.com/guidewire-oss/sawchain/internal/util"
+)
+
+// WaitForReady retrieves resources with objects, a manifest, or a Chainsaw template until all of them are
+// ready within a configurable duration. Readiness is computed per kind, following the semantics of kstatus
+// (the library behind "kubectl wait" and kpt), so the same call works for workloads, jobs, CRDs, and custom
+// resources alike.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. If provided without a template, resource state will be read from the object for
+//     identification and written back to the object. If provided with a template, resource state
+//     will be read from the template for identification and written to the object.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
+//     multiple resources. If provided without a template, resource states will be read from the objects
+//     for identification and written back to the objects. If provided with a template, resource states
+//     will be read from the template for identification and written to the objects.
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing resource
+//     identifiers to be read for retrieval. If provided with an object, must contain exactly one resource
+//     identifier matching the type of the object. If provided with a slice of objects, must contain resource
+//     identifiers exactly matching the count, order, and types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Timeout (string or time.Duration): Duration within which resources should become ready. If
+//     provided, must be before interval. Defaults to Sawchain's global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for checking readiness. If provided, must
+//     be after timeout. Defaults to Sawchain's global interval value.
+//
+// A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
+// may not be provided together.
+//
+// # Notes
+//
+//   - Invalid input and timeout errors will result in immediate test failure.
+//
+//   - Resources are retrieved the same way as in Get. Resources that do not exist yet are not ready.
+//
+//   - A resource is never ready while it is being deleted or while its status.observedGeneration is
+//     behind its metadata.generation. Otherwise, Deployments, StatefulSets, DaemonSets, and ReplicaSets
+//     are ready once their rollout is complete (all desired replicas updated, ready, and available, with
+//     no old replicas remaining); Jobs once their Complete condition is True; Pods once they succeeded or
+//     are running with a True Ready condition; PersistentVolumeClaims once Bound; LoadBalancer Services
+//     once an ingress is assigned; CustomResourceDefinitions once Established; APIServices once Available;
+//     and PodDisruptionBudgets once enough pods are healthy.
+//
+//   - Any other resource is ready once no Stalled or Reconciling condition is True, and its Ready
+//     condition (if present) is True and its observedGeneration (if set) is not behind metadata.generation.
+//
+//   - On timeout, the failure message lists each resource that is not ready together with the reason,
+//     e.g. "1 of 3 replicas ready".
+//
+//   - Use BeReady to assert readiness of objects you already hold, e.g. with Eventually and FetchSingleFunc.
+//
+// # Examples
+//
+// Wait for a Deployment to finish rolling out and save its state to the object:
+//
+//	sc.WaitForReady(ctx, deployment)
+//
+// Wait up to five minutes for a CRD and a Job to become ready:
+//
+//	sc.WaitForReady(ctx, []client.Object{crd, job}, "5m")
+//
+// Wait for resources defined in a template to become ready:
+//
+//	sc.WaitForReady(ctx, "2m", "2s", `
+//	  apiVersion: apps/v1
+//	  kind: StatefulSet
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  ---
+//	  apiVersion: example.com/v1
+//	  kind: Database
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  `, map[string]any{"name": "test-db", "namespace": "default"})
+func (s *Sawchain) WaitForReady(ctx context.Context, args ...any) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Wait for resources to be ready
+	s.g.Eventually(s.readyFunc(ctx, opts), opts.Timeout, opts.Interval).Should(gomega.Succeed(), errReadyNotSatisfied)
+}
+
+// HELPERS
+
+// readyFunc validates opts and returns a function that retrieves the resources they describe and
+// returns a *readiness.NotReadyError if any of them is not ready.
+func (s *Sawchain) readyFunc(ctx context.Context, opts *options.Options) func() error {
+	s.t.Helper()
+
+	// Check required options
+	s.g.Expect(options.RequireTemplateObjectObjects(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	if len(opts.Template) > 0 {
+		// Render template
+		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+
+		// Validate objects length
+		if opts.Object != nil {
+			s.g.Expect(unstructuredObjs).To(gomega.HaveLen(1), errObjectInsufficient)
+		} else if opts.Objects != nil {
+			s.g.Expect(opts.Objects).To(gomega.HaveLen(len(unstructuredObjs)), errObjectsWrongLength)
+		}
+
+		return func() error {
+			s.t.Helper()
+
+			// Get resources
+			for i := range unstructuredObjs {
+				// Pass pointer to slice element to save to outer scope
+				if err := s.c.Get(ctx, client.ObjectKeyFromObject(&unstructuredObjs[i]), &unstructuredObjs[i]); err != nil {
+					return err
+				}
+			}
+			// Save objects
+			if opts.Object != nil {
+				s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObjs[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
+			} else if opts.Objects != nil {
+				for i, unstructuredObj := range unstructuredObjs {
+					s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObj, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
+				}
+			}
+			return readiness.Check(unstructuredObjs)
+		}
+	}
+
+	objs := opts.Objects
+	if opts.Object != nil {
+		objs = []client.Object{opts.Object}
+	}
+	return func() error {
+		s.t.Helper()
+
+		// Get resources
+		unstructuredObjs := make([]unstructured.Unstructured, len(objs))
+		for i, obj := range objs {
+			if err := s.c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+				return err
+			}
+			unstructuredObj, err := util.UnstructuredFromObject(s.c, obj)
+			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedConvertObject)
+			unstructuredObjs[i] = unstructuredObj
+		}
+		return readiness.Check(unstructuredObjs)
+	}
+}
@@ -0,0 +1,213 @@
+package sawchain_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain"
+	"github.com/guidewire-oss/sawchain/internal/testutil"
+)
+
+var _ = Describe("WaitForReady", func() {
+	readyCondition := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		Reason:             "Reconciled",
+		LastTransitionTime: metav1.Now(),
+	}
+	notReadyCondition := metav1.Condition{
+		Type:               "Ready",
+		Status:             metav1.ConditionFalse,
+		Reason:             "Waiting",
+		Message:            "dependency missing",
+		LastTransitionTime: metav1.Now(),
+	}
+
+	deployment := func(readyReplicas int32) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default"},
+			Spec:       appsv1.DeploymentSpec{Replicas: ptr.To(int32(2))},
+			Status: appsv1.DeploymentStatus{
+				Replicas:          2,
+				UpdatedReplicas:   2,
+				ReadyReplicas:     readyReplicas,
+				AvailableReplicas: readyReplicas,
+			},
+		}
+	}
+
+	type testCase struct {
+		objs                []client.Object
+		readyLater          client.Object
+		methodArgs          []any
+		expectedFailureLogs []string
+	}
+
+	DescribeTable("waiting for resources to be ready",
+		func(tc testCase) {
+			// Initialize Sawchain
+			c := testutil.NewStandardFakeClientWithTestResourceStatus()
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, c, fastTimeout, fastInterval)
+
+			// Create resources, preserving status
+			for _, obj := range tc.objs {
+				created := copy(obj)
+				Expect(c.Create(ctx, created)).To(Succeed(), "failed to create resource")
+				withStatus := copy(obj)
+				withStatus.SetResourceVersion(created.GetResourceVersion())
+				Expect(c.Status().Update(ctx, withStatus)).To(Succeed(), "failed to update resource status")
+			}
+
+			// Make resource ready while waiting
+			if tc.readyLater != nil {
+				go func() {
+					defer GinkgoRecover()
+					time.Sleep(fastTimeout / 4)
+					obj := copy(tc.readyLater)
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+					obj.(*testutil.TestResource).Status.Conditions = []metav1.Condition{readyCondition}
+					Expect(c.Status().Update(ctx, obj)).To(Succeed())
+				}()
+			}
+
+			// Test WaitForReady
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				sc.WaitForReady(ctx, tc.methodArgs...)
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+				return
+			}
+			Expect(t.Failed()).To(BeFalse(), "expected no failure")
+
+			// Verify saved state
+			for _, arg := range tc.methodArgs {
+				if obj, ok := arg.(client.Object); ok {
+					Expect(obj.GetResourceVersion()).NotTo(BeEmpty(), "resource state not saved to provided object")
+				}
+			}
+		},
+
+		// Success cases
+		Entry("should succeed with ready custom resource", testCase{
+			objs:       []client.Object{testutil.NewTestResource("test-resource", "default", readyCondition)},
+			methodArgs: []any{testutil.NewTestResource("test-resource", "default")},
+		}),
+
+		Entry("should succeed with ready deployment", testCase{
+			objs:       []client.Object{deployment(2)},
+			methodArgs: []any{&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default"}}},
+		}),
+
+		Entry("should succeed when resource becomes ready while waiting", testCase{
+			objs:       []client.Object{testutil.NewTestResource("test-resource", "default", notReadyCondition)},
+			readyLater: testutil.NewTestResource("test-resource", "default"),
+			methodArgs: []any{testutil.NewTestResource("test-resource", "default")},
+		}),
+
+		Entry("should succeed with template and objects", testCase{
+			objs: []client.Object{
+				deployment(2),
+				testutil.NewTestResource("test-resource", "default", readyCondition),
+			},
+			methodArgs: []any{
+				[]client.Object{&appsv1.Deployment{}, &testutil.TestResource{}},
+				`
+				apiVersion: apps/v1
+				kind: Deployment
+				metadata:
+				  name: test-deployment
+				  namespace: ($namespace)
+				---
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-resource
+				  namespace: ($namespace)
+				`,
+				map[string]any{"namespace": "default"},
+			},
+		}),
+
+		// Failure cases
+		Entry("should fail with reasons when resources are not ready within timeout", testCase{
+			objs: []client.Object{
+				deployment(1),
+				testutil.NewTestResource("test-resource", "default", notReadyCondition),
+			},
+			methodArgs: []any{
+				`
+				apiVersion: apps/v1
+				kind: Deployment
+				metadata:
+				  name: test-deployment
+				  namespace: default
+				---
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-resource
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] readiness not satisfied within timeout",
+				"2 of 2 resources not ready:",
+				"* apps/v1/Deployment/default/test-deployment: 1 of 2 updated replicas available",
+				"* example.com/v1/TestResource/default/test-resource: Ready condition is False (Waiting: dependency missing)",
+			},
+		}),
+
+		Entry("should fail with last client error when resource does not exist", testCase{
+			methodArgs: []any{testutil.NewTestResource("test-resource", "default")},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] readiness not satisfied within timeout",
+				"not found",
+			},
+		}),
+
+		Entry("should fail with no arguments", testCase{
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string), Object (client.Object), or Objects ([]client.Object)",
+			},
+		}),
+
+		Entry("should fail with single object for multi-resource template", testCase{
+			methodArgs: []any{
+				&appsv1.Deployment{},
+				`
+				apiVersion: apps/v1
+				kind: Deployment
+				metadata:
+				  name: test-deployment
+				  namespace: default
+				---
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-resource
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] single object insufficient for multi-resource template",
+			},
+		}),
+	)
+})
@@ -30,6 +30,10 @@ import (
 //
 //   - Object (client.Object): Typed or unstructured object to render into.
 //
+//   - DecodeSecrets (sawchain.Flag): If provided, the data of Secrets in the template is treated as plain
+//     values, like stringData, and base64-encoded when rendering. Enabled by default if Sawchain was initialized
+//     with DecodeSecrets.
+//
 // When no object is provided, RenderSingle attempts to return a typed object. If a typed object cannot be
 // created (i.e., if the client scheme does not support the necessary type), an unstructured object will be
 // returned instead.
@@ -83,7 +87,7 @@ func (s *Sawchain) RenderSingle(args ...any) client.Object {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, true, false, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Template: true, Flags: options.FlagDecodeSecrets}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
@@ -93,9 +97,15 @@ func (s *Sawchain) RenderSingle(args ...any) client.Object {
 	// Render template
 	bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-	unstructuredObj, err := chainsaw.RenderTemplateSingle(context.TODO(), opts.Template, bindings)
+	unstructuredObj, err := chainsaw.RenderTemplateSingle(context.TODO(), opts.Template, bindings, s.funcs)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
+	// Encode Secret data
+	if opts.Flags.Has(options.FlagDecodeSecrets) {
+		unstructuredObj, err = util.EncodeSecretData(unstructuredObj)
+		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedEncodeSecret)
+	}
+
 	// Save/return object
 	if opts.Object != nil {
 		s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObj, opts.Object)).To(gomega.Succeed(), errFailedSave)
@@ -122,6 +132,10 @@ func (s *Sawchain) RenderSingle(args ...any) client.Object {
 //
 //   - Objects ([]client.Object): Slice of typed or unstructured objects to render into.
 //
+//   - DecodeSecrets (sawchain.Flag): If provided, the data of Secrets in the template is treated as plain
+//     values, like stringData, and base64-encoded when rendering. Enabled by default if Sawchain was initialized
+//     with DecodeSecrets.
+//
 // When no objects are provided, RenderMultiple attempts to return typed objects. If typed objects cannot be
 // created (i.e., if the client scheme does not support the necessary types), unstructured objects will be
 // returned instead.
@@ -198,7 +212,7 @@ func (s *Sawchain) RenderMultiple(args ...any) []client.Object {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, false, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Objects: true, Template: true, Flags: options.FlagDecodeSecrets}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
@@ -208,9 +222,17 @@ func (s *Sawchain) RenderMultiple(args ...any) []client.Object {
 	// Render template
 	bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-	unstructuredObjs, err := chainsaw.RenderTemplate(context.TODO(), opts.Template, bindings)
+	unstructuredObjs, err := chainsaw.RenderTemplate(context.TODO(), opts.Template, bindings, s.funcs)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
+	// Encode Secret data
+	if opts.Flags.Has(options.FlagDecodeSecrets) {
+		for i := range unstructuredObjs {
+			unstructuredObjs[i], err = util.EncodeSecretData(unstructuredObjs[i])
+			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedEncodeSecret)
+		}
+	}
+
 	// Validate objects length
 	if opts.Objects != nil {
 		s.g.Expect(opts.Objects).To(gomega.HaveLen(len(unstructuredObjs)), errObjectsWrongLength)
@@ -286,7 +308,7 @@ func (s *Sawchain) RenderToString(template string, bindings ...map[string]any) s
 	// Render template
 	b, err := chainsaw.BindingsFromMap(s.mergeBindings(bindings...))
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-	objs, err := chainsaw.RenderTemplate(context.TODO(), template, b)
+	objs, err := chainsaw.RenderTemplate(context.TODO(), template, b, s.funcs)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 	// Marshal objects
@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -194,7 +195,46 @@ var _ = Describe("RenderSingle", func() {
 			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"key1": "value1", "key2": "value2"}),
 		}),
 
+		Entry("should encode plain Secret data with DecodeSecrets", testCase{
+			methodArgs: []any{
+				sawchain.DecodeSecrets,
+				`
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: s3cr3t
+				`,
+			},
+			expectedObj: &corev1.Secret{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+				ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "default"},
+				Data:       map[string][]byte{"password": []byte("s3cr3t")},
+			},
+		}),
+
 		// Error cases
+		Entry("should fail to encode non-string Secret data with DecodeSecrets", testCase{
+			methodArgs: []any{
+				sawchain.DecodeSecrets,
+				`
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  port: 8080
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to encode Secret data",
+				"data.port: expected string, got int64",
+			},
+		}),
+
 		Entry("should fail with no arguments", testCase{
 			expectedFailureLogs: []string{
 				"[SAWCHAIN][ERROR] invalid arguments",
@@ -711,6 +751,37 @@ var _ = Describe("RenderMultiple", func() {
 			},
 		}),
 
+		Entry("should encode plain Secret data with DecodeSecrets", testCase{
+			methodArgs: []any{
+				sawchain.DecodeSecrets,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  key: value
+				---
+				apiVersion: v1
+				kind: Secret
+				metadata:
+				  name: test-secret
+				  namespace: default
+				data:
+				  password: s3cr3t
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"key": "value"}),
+				&corev1.Secret{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+					ObjectMeta: metav1.ObjectMeta{Name: "test-secret", Namespace: "default"},
+					Data:       map[string][]byte{"password": []byte("s3cr3t")},
+				},
+			},
+		}),
+
 		// Error cases
 		Entry("should fail with no arguments", testCase{
 			expectedFailureLogs: []string{
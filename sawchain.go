@@ -8,7 +8,9 @@ import (
 
 	"github.com/onsi/gomega"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/guidewire-oss/sawchain/internal/chainsaw"
@@ -34,11 +36,171 @@ const (
 	VerbosityVerbose = options.VerbosityVerbose
 )
 
+// Flag is a set of opt-in behaviors enabled by passing flag values as arguments. See the
+// flag constants (e.g. AutoCleanup) for the supported flags and where they may be used.
+type Flag = options.Flag
+
+const (
+	// AutoCleanup records every resource created through Create and CreateAndWait and
+	// registers a test cleanup that deletes them in reverse creation order, waiting for
+	// them to disappear. Only valid as an argument to New and NewWithGomega.
+	AutoCleanup = options.FlagAutoCleanup
+	// DecodeSecrets makes Check and the YAML matchers compare the data of Secrets in decoded form,
+	// like stringData, so expectations use plain values instead of base64. Secret values are
+	// redacted in failure output below VerbosityVerbose. It also makes RenderSingle and
+	// RenderMultiple encode plain Secret data from templates. Valid as an argument to New,
+	// NewWithGomega, Check, CheckFunc, CheckAndWait, CheckConsistently, CheckOwnedBy,
+	// CheckOwnedByFunc, RenderSingle, and RenderMultiple; the YAML matchers follow the Sawchain
+	// instance's setting.
+	DecodeSecrets = options.FlagDecodeSecrets
+	// ForceConflicts makes server-side apply operations take ownership of fields managed
+	// by other field managers instead of failing with a conflict. Valid as an argument to
+	// New, NewWithGomega, Apply, and ApplyAndWait.
+	ForceConflicts = options.FlagForceConflicts
+	// JSONPatch makes Patch and PatchAndWait treat the template as an RFC 6902 JSON Patch
+	// document for the resource identified by the provided object, instead of a strategic
+	// merge patch. Only valid as an argument to Patch and PatchAndWait.
+	JSONPatch = options.FlagJSONPatch
+	// RetryOnConflict makes Update and UpdateAndWait retry template updates that fail with a
+	// conflict (e.g. because a controller updated the resource concurrently) until the timeout
+	// elapses. Valid as an argument to New, NewWithGomega, Update, and UpdateAndWait (with a
+	// template).
+	RetryOnConflict = options.FlagRetryOnConflict
+	// SkipNamespaceWait makes the cleanup registered by CreateNamespace delete the namespace without
+	// waiting for it to terminate, for clusters without a namespace controller (such as envtest).
+	// Only valid as an argument to New and NewWithGomega.
+	SkipNamespaceWait = options.FlagSkipNamespaceWait
+	// Strict makes Check and the YAML matchers reject resources with fields that are absent in
+	// the expectation, after the usual Chainsaw check passes. Useful for golden-output tests
+	// where an extra field is a bug. Fields under IgnorePaths are exempt. Valid as an argument
+	// to New, NewWithGomega, Check, CheckFunc, CheckAndWait, and CheckConsistently; the YAML
+	// matchers follow the Sawchain instance's setting. CheckEvent and CheckEventFunc only apply
+	// it when passed explicitly, and HaveEmittedEvent never does. Operations that select
+	// resources (List, DeleteAll, CheckNone, and CheckCount, and their variants) never apply it.
+	Strict = options.FlagStrict
+	// UpdateSnapshots makes MatchSnapshot rewrite snapshot files from actual output instead of
+	// comparing with them, the same as setting the SAWCHAIN_UPDATE_SNAPSHOTS environment variable
+	// to true. Only valid as an argument to New and NewWithGomega.
+	UpdateSnapshots = options.FlagUpdateSnapshots
+)
+
+// IgnorePaths are field paths exempt from Strict matching, along with all fields beneath them.
+// Segments are separated by dots, and brackets enclose list indices or keys containing dots
+// (e.g. "metadata.managedFields", "spec.containers[0].image", or
+// "metadata.annotations[example.com/key]"). A "*" segment matches any key or index. Paths
+// provided to New or NewWithGomega apply to every strict match, in addition to paths provided
+// to the operation itself.
+type IgnorePaths = options.IgnorePaths
+
+// FieldManager is the name of the actor making changes in server-side apply operations.
+// Defaults to "sawchain" if not provided to New, NewWithGomega, or the operation itself.
+type FieldManager = options.FieldManager
+
+// Count is a constraint on a number of matching resources, with an inclusive minimum and an
+// optional inclusive maximum (negative means unbounded). Use Exactly, AtLeast, AtMost, or
+// Between to create one.
+type Count = options.Count
+
+// Exactly returns a Count constraint satisfied only by n.
+func Exactly(n int) Count { return Count{Min: n, Max: n} }
+
+// AtLeast returns a Count constraint satisfied by n or more.
+func AtLeast(n int) Count { return Count{Min: n, Max: -1} }
+
+// AtMost returns a Count constraint satisfied by n or fewer (including zero).
+func AtMost(n int) Count { return Count{Min: 0, Max: n} }
+
+// Between returns a Count constraint satisfied by min through max, inclusive.
+func Between(min, max int) Count { return Count{Min: min, Max: max} }
+
+// PropagationPolicy controls whether and how dependents are garbage collected when a resource
+// is deleted. See the PropagationForeground, PropagationBackground, and PropagationOrphan
+// constants for the supported policies. Values of type metav1.DeletionPropagation are accepted
+// as well.
+type PropagationPolicy = metav1.DeletionPropagation
+
+const (
+	// PropagationForeground deletes dependents before the owner; the owner remains visible
+	// (with a deletion timestamp) until its dependents are gone.
+	PropagationForeground = metav1.DeletePropagationForeground
+	// PropagationBackground deletes the owner immediately and its dependents in the background.
+	PropagationBackground = metav1.DeletePropagationBackground
+	// PropagationOrphan deletes the owner and leaves its dependents in place.
+	PropagationOrphan = metav1.DeletePropagationOrphan
+)
+
+// GracePeriod is the duration a resource is given to terminate gracefully before it is deleted
+// (e.g. the termination grace period of a Pod). Must be a whole number of seconds; zero deletes
+// immediately. Defaults to the server-side default for the resource type if not provided.
+type GracePeriod = options.GracePeriod
+
+// RemoveFinalizersAfter enables force deletion: resources still present this long after being
+// deleted have their finalizers removed so they can disappear even when the controller that owns
+// the finalizers is not running. Must be less than the timeout. Valid as an argument to New,
+// NewWithGomega, and DeleteAndWait.
+type RemoveFinalizersAfter = options.RemoveFinalizersAfter
+
+// Option is an explicitly typed argument providing a single setting, created with one of the With
+// functions (e.g. WithTimeout). Options may be mixed with plain arguments and are accepted wherever the
+// setting they provide is: for example, WithTimeout wherever a timeout is, and WithTemplate wherever a
+// template is. Unlike plain arguments, their meaning does not depend on their type or position, so a
+// misplaced or mistyped setting is caught by the compiler or reported by name.
+type Option = options.Option
+
+// WithBindings provides template bindings, like a map[string]any argument.
+func WithBindings(bindings map[string]any) Option { return options.WithBindings(bindings) }
+
+// WithTimeout provides the timeout for eventual assertions, like the first duration argument.
+func WithTimeout(timeout time.Duration) Option { return options.WithTimeout(timeout) }
+
+// WithInterval provides the polling interval for eventual assertions, like the second duration argument.
+// Must not be greater than the timeout, if one is provided.
+func WithInterval(interval time.Duration) Option { return options.WithInterval(interval) }
+
+// WithObject provides the object for reading/writing the state of a single resource, like a
+// client.Object argument.
+func WithObject(obj client.Object) Option { return options.WithObject(obj) }
+
+// WithObjects provides the objects for reading/writing the states of multiple resources, like a
+// []client.Object argument.
+func WithObjects(objs ...client.Object) Option { return options.WithObjects(objs...) }
+
+// WithTemplate provides the file path or content of a static manifest or Chainsaw template, like a
+// string argument.
+func WithTemplate(template string) Option { return options.WithTemplate(template) }
+
+// WithVerbosity provides the detail level of assertion error output and logging, like a Verbosity
+// argument. Only valid as an argument to New, NewWithGomega, and With.
+func WithVerbosity(verbosity Verbosity) Option { return options.WithVerbosity(verbosity) }
+
+// JMESPathFunction is a custom function made available to JMESPath expressions in the templates of a
+// Sawchain instance, e.g. (my_func(spec.value)). Name and Handler are required, and Arguments declares
+// the accepted argument types, which are validated before the handler is called. Names of built-in
+// JMESPath, Kyverno, Chainsaw, and Sawchain functions may not be reused. Only valid as an argument to New
+// and NewWithGomega, individually or as a []JMESPathFunction.
+type JMESPathFunction = options.JMESPathFunction
+
+// CELFunction is a custom function (or any other environment option, e.g. a library or a constant)
+// made available to CEL expressions in the templates of a Sawchain instance, e.g. (cel;my_func(x)),
+// typically created with cel.Function from github.com/google/cel-go/cel. Only valid as an argument to
+// New and NewWithGomega, individually or as a []CELFunction.
+type CELFunction = options.CELFunction
+
 // MatchError is a structured assertion error describing why one or more match attempts
 // failed, exposing the attempts and their field errors for programmatic inspection. Errors
 // returned by Check and CheckFunc unwrap to a *MatchError via errors.As.
 type MatchError = chainsaw.MatchError
 
+// UnexpectedMatchError is a structured assertion error listing resources that matched an
+// expectation which should have had no matches. Errors returned by CheckNone and CheckNoneFunc
+// unwrap to an *UnexpectedMatchError via errors.As.
+type UnexpectedMatchError = chainsaw.UnexpectedMatchError
+
+// CountError is a structured assertion error describing a number of matches that does not
+// satisfy a count constraint, including the matches found and the non-matching attempts. Errors
+// returned by CheckCount and CheckCountFunc unwrap to a *CountError via errors.As.
+type CountError = chainsaw.CountError
+
 // MatchAttempt records the result of comparing one actual resource against one expected
 // resource, including the field-level errors found.
 type MatchAttempt = chainsaw.MatchAttempt
@@ -69,11 +231,32 @@ const (
 	errObjectInsufficient = prefixErr + "single object insufficient for multi-resource template"
 	errObjectsWrongLength = prefixErr + "objects slice length must match template resource count"
 
+	errInvalidUpdateSnapshotsEnv = prefixErr + "invalid SAWCHAIN_UPDATE_SNAPSHOTS value (must be a boolean)"
+
 	errCreateNotReflected = prefixErr + "create not reflected within timeout (client cache sync delay)"
 	errUpdateNotReflected = prefixErr + "update not reflected within timeout (client cache sync delay)"
+	errApplyNotReflected  = prefixErr + "apply not reflected within timeout (client cache sync delay)"
+	errPatchNotReflected  = prefixErr + "patch not reflected within timeout (client cache sync delay)"
+	errStatusNotReflected = prefixErr + "status update not reflected within timeout (client cache sync delay)"
 	errDeleteNotReflected = prefixErr + "delete not reflected within timeout (may be due to finalizers or client cache sync delay)"
 	errFailedSave         = prefixErr + "failed to save state to object"
 	errFailedWrite        = prefixErr + "failed to write file"
+	errFailedEncodeSecret = prefixErr + "failed to encode Secret data"
+
+	errNilOwner       = prefixErr + "owner must not be nil"
+	errOwnerNameEmpty = prefixErr + "owner name must not be empty"
+	errFailedOwnerGVK = prefixErr + "failed to determine owner GroupVersionKind (ensure the owner type is registered in the client scheme)"
+
+	errNilInvolvedObject       = prefixErr + "involved object must not be nil"
+	errInvolvedObjectNameEmpty = prefixErr + "involved object name must not be empty"
+	errFailedInvolvedObjectGVK = prefixErr + "failed to determine involved object GroupVersionKind (ensure the object type is registered in the client scheme)"
+
+	errFailedCleanup       = prefixErr + "failed to delete resources during cleanup"
+	errCleanupNotReflected = prefixErr + "cleanup not reflected within timeout (remaining resources are listed below)"
+
+	errFailedCreateNamespace  = prefixErr + "failed to create namespace"
+	errFailedDeleteNamespace  = prefixErr + "failed to delete namespace during cleanup"
+	errNamespaceNotTerminated = prefixErr + "namespace termination not reflected within timeout (may be due to finalizers)"
 
 	errFailedCreateWithObject   = prefixErr + "failed to create with object"
 	errFailedCreateWithTemplate = prefixErr + "failed to create with template"
@@ -83,16 +266,36 @@ const (
 	errFailedGetWithTemplate    = prefixErr + "failed to get with template"
 	errFailedUpdateWithObject   = prefixErr + "failed to update with object"
 	errFailedUpdateWithTemplate = prefixErr + "failed to update with template"
+	errFailedApplyWithTemplate  = prefixErr + "failed to apply with template"
+	errFailedPatchWithTemplate  = prefixErr + "failed to patch with template"
+	errFailedJSONPatch          = prefixErr + "failed to patch with JSON patch"
 	errFailedMergePatch         = prefixErr + "failed to merge patch from template"
 	errFailedList               = prefixErr + "failed to list candidates"
 	errFailedMatch              = prefixErr + "failed to match candidates"
 
+	errFailedDeleteAllWithTemplate = prefixErr + "failed to delete all matches with template"
+	errUnscopedDeleteAll           = prefixErr + "template documents for namespaced types must set metadata.name, metadata.namespace, or metadata.labels"
+
+	errCheckNotSatisfied      = prefixErr + "check not satisfied within timeout"
+	errGetNotSatisfied        = prefixErr + "get not satisfied within timeout"
+	errListNotSatisfied       = prefixErr + "list count not satisfied within timeout"
+	errReadyNotSatisfied      = prefixErr + "readiness not satisfied within timeout"
+	errCheckNotConsistent     = prefixErr + "check did not hold consistently"
+	errCheckNoneNotConsistent = prefixErr + "absence check did not hold consistently"
+	errGetNotConsistent       = prefixErr + "get did not hold consistently"
+
+	errFailedUpdateStatusWithObject   = prefixErr + "failed to update status with object"
+	errFailedUpdateStatusWithTemplate = prefixErr + "failed to update status with template"
+	errFailedPatchStatusWithTemplate  = prefixErr + "failed to patch status with template"
+
 	errNilOpts             = prefixErrInternal + "parsed options is nil"
 	errFailedMarshalObject = prefixErrInternal + "failed to marshal object"
+	errFailedConvertObject = prefixErrInternal + "failed to convert object to unstructured"
 	errFailedSplitYAML     = prefixErrInternal + "failed to split YAML documents"
 	errCreatedMatcherIsNil = prefixErrInternal + "created matcher is nil"
 
-	infoFailedConvert = prefixInfo + "failed to convert return object to typed; returning unstructured instead"
+	infoFailedConvert     = prefixInfo + "failed to convert return object to typed; returning unstructured instead"
+	infoRemovedFinalizers = prefixInfo + "removed finalizers from resource pending deletion"
 )
 
 // Sawchain provides utilities for K8s YAML-driven testing—powered by Chainsaw. It includes helpers to
@@ -102,10 +305,23 @@ const (
 //
 // More documentation is available at https://github.com/guidewire-oss/sawchain/tree/main/docs.
 type Sawchain struct {
-	t    testing.TB
-	g    gomega.Gomega
-	c    client.Client
-	opts options.Options
+	t       testing.TB
+	g       gomega.Gomega
+	c       client.Client
+	opts    options.Options
+	funcs   *chainsaw.Functions
+	cleanup *cleanupRegistry
+}
+
+// newInclude selects the arguments accepted when initializing Sawchain.
+var newInclude = options.Include{
+	Verbosity:     true,
+	Durations:     true,
+	FieldManager:  true,
+	DeleteOptions: true,
+	Functions:     true,
+	Flags: options.FlagAutoCleanup | options.FlagDecodeSecrets | options.FlagForceConflicts |
+		options.FlagRetryOnConflict | options.FlagSkipNamespaceWait | options.FlagStrict | options.FlagUpdateSnapshots,
 }
 
 // New creates a new Sawchain instance with the provided global settings, using an internal
@@ -131,6 +347,53 @@ type Sawchain struct {
 //     assertion error output and logging for this Sawchain instance. See the Verbosity constants
 //     for the behavior of each level.
 //
+//   - AutoCleanup (sawchain.Flag): Optional. If provided, every resource created through Create or
+//     CreateAndWait (with a template or objects) is recorded, and a testing.TB cleanup is registered
+//     that deletes the recorded resources in reverse creation order and waits for them to disappear
+//     using Sawchain's timeout and interval.
+//
+//   - FieldManager (sawchain.FieldManager): Optional. Defaults to "sawchain". Default field manager for
+//     server-side apply operations.
+//
+//   - ForceConflicts (sawchain.Flag): Optional. If provided, server-side apply operations force
+//     ownership of conflicting fields by default.
+//
+//   - RetryOnConflict (sawchain.Flag): Optional. If provided, template updates that fail with a
+//     conflict are retried within the timeout by default.
+//
+//   - Strict (sawchain.Flag): Optional. If provided, checks and YAML matchers reject resources with
+//     fields absent in the expectation by default.
+//
+//   - DecodeSecrets (sawchain.Flag): Optional. If provided, checks and YAML matchers compare Secret
+//     data in decoded form, and renders encode plain Secret data, by default.
+//
+//   - IgnorePaths (sawchain.IgnorePaths): Optional. Field paths exempt from strict matching in every
+//     check and YAML matcher. If multiple are provided, they will be combined.
+//
+//   - JMESPathFunctions (sawchain.JMESPathFunction or []sawchain.JMESPathFunction): Optional. Custom
+//     functions for JMESPath expressions in every template rendered or matched by this Sawchain
+//     instance, including its matchers. If multiple are provided, they will be combined.
+//
+//   - CELFunctions (sawchain.CELFunction or []sawchain.CELFunction): Optional. Custom functions for CEL
+//     expressions in every template rendered or matched by this Sawchain instance, including its
+//     matchers. If multiple are provided, they will be combined.
+//
+//   - UpdateSnapshots (sawchain.Flag): Optional. If provided, MatchSnapshot rewrites snapshot files
+//     from actual output instead of comparing with them.
+//
+//   - SkipNamespaceWait (sawchain.Flag): Optional. If provided, namespaces created with CreateNamespace
+//     are deleted on cleanup without waiting for them to terminate.
+//
+//   - PropagationPolicy (sawchain.PropagationPolicy): Optional. Default propagation policy for delete
+//     operations, including AutoCleanup.
+//
+//   - GracePeriod (sawchain.GracePeriod): Optional. Default grace period for delete operations,
+//     including AutoCleanup.
+//
+//   - RemoveFinalizersAfter (sawchain.RemoveFinalizersAfter): Optional. If provided, DeleteAndWait and
+//     AutoCleanup remove the finalizers of resources still present after this window by default, so
+//     teardown cannot hang on finalizers that will never be handled. Must be less than the timeout.
+//
 // # Notes
 //
 //   - Invalid input will result in immediate test failure.
@@ -138,6 +401,13 @@ type Sawchain struct {
 //   - Sawchain's timeout and interval settings control all internal eventual assertions. Gomega
 //     global or instance-level duration defaults are ignored within Sawchain operations.
 //
+//   - With AutoCleanup, resources that were already deleted are ignored during cleanup, and resources
+//     that remain after the timeout (e.g. stuck on finalizers) cause a test failure that lists them
+//     along with their pending finalizers. Use RemoveFinalizersAfter to force their removal instead.
+//
+//   - Templates that call an unknown function, or a function with the wrong number or types of
+//     arguments, fail with an error naming the function and the template document.
+//
 //   - Use NewWithGomega if you need to provide a custom Gomega instance with a custom fail handler.
 //
 // # Examples
@@ -154,9 +424,49 @@ type Sawchain struct {
 //
 //	sc := sawchain.New(t, k8sClient, "10s", "2s")
 //
+// Initialize Sawchain with typed options instead of plain arguments:
+//
+//	sc := sawchain.New(t, k8sClient, sawchain.WithTimeout(10*time.Second), sawchain.WithInterval(2*time.Second),
+//	    sawchain.WithBindings(map[string]any{"namespace": "test"}))
+//
 // Initialize Sawchain with verbose error output:
 //
 //	sc := sawchain.New(t, k8sClient, sawchain.VerbosityVerbose)
+//
+// Initialize Sawchain with automatic cleanup of created resources:
+//
+//	sc := sawchain.New(t, k8sClient, sawchain.AutoCleanup)
+//
+// Initialize Sawchain with a custom field manager for server-side apply:
+//
+//	sc := sawchain.New(t, k8sClient, sawchain.FieldManager("my-tests"))
+//
+// Initialize Sawchain to retry conflicting updates, e.g. when controllers reconcile the same resources:
+//
+//	sc := sawchain.New(t, k8sClient, sawchain.RetryOnConflict)
+//
+// Initialize Sawchain to reject unexpected fields, except those populated by the API server:
+//
+//	sc := sawchain.New(t, k8sClient, sawchain.Strict, sawchain.IgnorePaths{
+//	    "metadata.uid", "metadata.resourceVersion", "metadata.generation",
+//	    "metadata.creationTimestamp", "metadata.managedFields", "status",
+//	})
+//
+// Initialize Sawchain with automatic cleanup that strips stuck finalizers after 10 seconds:
+//
+//	sc := sawchain.New(t, k8sClient, "30s", sawchain.AutoCleanup, sawchain.RemoveFinalizersAfter(10*time.Second))
+//
+// Initialize Sawchain with a custom JMESPath function for use in templates, e.g. (parse_json(data.config)):
+//
+//	sc := sawchain.New(t, k8sClient, sawchain.JMESPathFunction{
+//	    Name:      "parse_json",
+//	    Arguments: []functions.ArgSpec{{Types: []functions.JpType{functions.JpString}}},
+//	    Handler: func(args []any) (any, error) {
+//	        var v any
+//	        err := json.Unmarshal([]byte(args[0].(string)), &v)
+//	        return v, err
+//	    },
+//	})
 func New(t testing.TB, c client.Client, args ...any) *Sawchain {
 	t.Helper()
 	// Initialize Gomega
@@ -165,17 +475,27 @@ func New(t testing.TB, c client.Client, args ...any) *Sawchain {
 	g.Expect(c).NotTo(gomega.BeNil(), errClientNil)
 	// Parse options
 	opts, err := options.ParseAndApplyDefaults(&options.Options{
-		Verbosity: options.VerbosityNormal,
-		Timeout:   time.Second * 5,
-		Interval:  time.Second,
-	}, true, true, false, false, false, args...)
+		Verbosity:    options.VerbosityNormal,
+		Timeout:      time.Second * 5,
+		Interval:     time.Second,
+		FieldManager: "sawchain",
+	}, newInclude, args...)
 	g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 	// Check required options
 	g.Expect(options.RequireVerbosity(opts)).To(gomega.Succeed(), errInvalidArgs)
 	g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+	// Build custom functions
+	funcs, err := newFunctions(opts)
+	g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	// Instantiate Sawchain
-	return &Sawchain{t: t, g: g, c: c, opts: *opts}
+	s := &Sawchain{t: t, g: g, c: c, opts: *opts, funcs: funcs}
+	// Register cleanup
+	if opts.Flags.Has(options.FlagAutoCleanup) {
+		s.cleanup = &cleanupRegistry{}
+		t.Cleanup(s.runCleanup)
+	}
+	return s
 }
 
 // NewWithGomega creates a new Sawchain instance with a custom Gomega instance and provided global settings.
@@ -204,6 +524,53 @@ func New(t testing.TB, c client.Client, args ...any) *Sawchain {
 //     assertion error output and logging for this Sawchain instance. See the Verbosity constants
 //     for the behavior of each level.
 //
+//   - AutoCleanup (sawchain.Flag): Optional. If provided, every resource created through Create or
+//     CreateAndWait (with a template or objects) is recorded, and a testing.TB cleanup is registered
+//     that deletes the recorded resources in reverse creation order and waits for them to disappear
+//     using Sawchain's timeout and interval.
+//
+//   - FieldManager (sawchain.FieldManager): Optional. Defaults to "sawchain". Default field manager for
+//     server-side apply operations.
+//
+//   - ForceConflicts (sawchain.Flag): Optional. If provided, server-side apply operations force
+//     ownership of conflicting fields by default.
+//
+//   - RetryOnConflict (sawchain.Flag): Optional. If provided, template updates that fail with a
+//     conflict are retried within the timeout by default.
+//
+//   - Strict (sawchain.Flag): Optional. If provided, checks and YAML matchers reject resources with
+//     fields absent in the expectation by default.
+//
+//   - DecodeSecrets (sawchain.Flag): Optional. If provided, checks and YAML matchers compare Secret
+//     data in decoded form, and renders encode plain Secret data, by default.
+//
+//   - IgnorePaths (sawchain.IgnorePaths): Optional. Field paths exempt from strict matching in every
+//     check and YAML matcher. If multiple are provided, they will be combined.
+//
+//   - JMESPathFunctions (sawchain.JMESPathFunction or []sawchain.JMESPathFunction): Optional. Custom
+//     functions for JMESPath expressions in every template rendered or matched by this Sawchain
+//     instance, including its matchers. If multiple are provided, they will be combined.
+//
+//   - CELFunctions (sawchain.CELFunction or []sawchain.CELFunction): Optional. Custom functions for CEL
+//     expressions in every template rendered or matched by this Sawchain instance, including its
+//     matchers. If multiple are provided, they will be combined.
+//
+//   - UpdateSnapshots (sawchain.Flag): Optional. If provided, MatchSnapshot rewrites snapshot files
+//     from actual output instead of comparing with them.
+//
+//   - SkipNamespaceWait (sawchain.Flag): Optional. If provided, namespaces created with CreateNamespace
+//     are deleted on cleanup without waiting for them to terminate.
+//
+//   - PropagationPolicy (sawchain.PropagationPolicy): Optional. Default propagation policy for delete
+//     operations, including AutoCleanup.
+//
+//   - GracePeriod (sawchain.GracePeriod): Optional. Default grace period for delete operations,
+//     including AutoCleanup.
+//
+//   - RemoveFinalizersAfter (sawchain.RemoveFinalizersAfter): Optional. If provided, DeleteAndWait and
+//     AutoCleanup remove the finalizers of resources still present after this window by default, so
+//     teardown cannot hang on finalizers that will never be handled. Must be less than the timeout.
+//
 // # Notes
 //
 //   - Invalid input will result in immediate test failure.
@@ -211,6 +578,10 @@ func New(t testing.TB, c client.Client, args ...any) *Sawchain {
 //   - Sawchain's timeout and interval settings control all internal eventual assertions. Gomega
 //     global or instance-level duration defaults are ignored within Sawchain operations.
 //
+//   - With AutoCleanup, resources that were already deleted are ignored during cleanup, and resources
+//     that remain after the timeout (e.g. stuck on finalizers) cause a test failure that lists them
+//     along with their pending finalizers. Use RemoveFinalizersAfter to force their removal instead.
+//
 // # Examples
 //
 // Initialize Sawchain with a custom fail handler:
@@ -236,23 +607,124 @@ func New(t testing.TB, c client.Client, args ...any) *Sawchain {
 //
 //	g := gomega.NewGomega(customFailHandler)
 //	sc := sawchain.NewWithGomega(t, g, k8sClient, sawchain.VerbosityMinimal)
+//
+// Initialize Sawchain with a custom Gomega instance and automatic cleanup of created resources:
+//
+//	g := gomega.NewGomega(customFailHandler)
+//	sc := sawchain.NewWithGomega(t, g, k8sClient, sawchain.AutoCleanup)
+//
+// Initialize Sawchain with a custom Gomega instance and foreground deletion by default:
+//
+//	g := gomega.NewGomega(customFailHandler)
+//	sc := sawchain.NewWithGomega(t, g, k8sClient, sawchain.PropagationForeground)
 func NewWithGomega(t testing.TB, g gomega.Gomega, c client.Client, args ...any) *Sawchain {
 	t.Helper()
 	// Check client
 	g.Expect(c).NotTo(gomega.BeNil(), errClientNil)
 	// Parse options
 	opts, err := options.ParseAndApplyDefaults(&options.Options{
-		Verbosity: options.VerbosityNormal,
-		Timeout:   time.Second * 5,
-		Interval:  time.Second,
-	}, true, true, false, false, false, args...)
+		Verbosity:    options.VerbosityNormal,
+		Timeout:      time.Second * 5,
+		Interval:     time.Second,
+		FieldManager: "sawchain",
+	}, newInclude, args...)
 	g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 	// Check required options
 	g.Expect(options.RequireVerbosity(opts)).To(gomega.Succeed(), errInvalidArgs)
 	g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+	// Build custom functions
+	funcs, err := newFunctions(opts)
+	g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	// Instantiate Sawchain
-	return &Sawchain{t: t, g: g, c: c, opts: *opts}
+	s := &Sawchain{t: t, g: g, c: c, opts: *opts, funcs: funcs}
+	// Register cleanup
+	if opts.Flags.Has(options.FlagAutoCleanup) {
+		s.cleanup = &cleanupRegistry{}
+		t.Cleanup(s.runCleanup)
+	}
+	return s
+}
+
+// With returns a derived Sawchain instance that layers the provided settings on top of the settings of
+// this instance.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise):
+//
+//   - Bindings (map[string]any): Optional. Global bindings to be added to (or override) the global
+//     bindings of this instance. If multiple maps are provided, they will be merged in natural order.
+//
+//   - Timeout (string or time.Duration): Optional. Defaults to the timeout of this instance. Default
+//     timeout for eventual assertions. If provided, must be before interval.
+//
+//   - Interval (string or time.Duration): Optional. Defaults to the interval of this instance. Default
+//     polling interval for eventual assertions. If provided, must be after timeout.
+//
+//   - Verbosity (sawchain.Verbosity): Optional. Defaults to the verbosity of this instance. Detail level
+//     of assertion error output and logging for the derived instance.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure, including a timeout or interval that leaves
+//     the effective interval greater than the effective timeout (e.g. a timeout shorter than the interval
+//     inherited from this instance).
+//
+//   - The derived instance shares the testing.TB, Gomega instance, client, custom functions, and all
+//     other settings of this instance. This instance is not modified.
+//
+//   - The derived instance shares the AutoCleanup registry of this instance rather than registering its
+//     own. Resources created through either instance are deleted together when the test of this instance
+//     ends, using the cleanup settings (including timeout and interval) of this instance.
+//
+//   - Derived instances may be derived from again, e.g. to narrow bindings further in nested blocks.
+//
+//   - Use Bindings to inspect the effective global bindings of the derived instance.
+//
+// # Examples
+//
+// Derive an instance with an additional binding:
+//
+//	nsc := sc.With(map[string]any{"namespace": "test"})
+//
+// Derive an instance with a longer timeout for slow resources:
+//
+//	slow := sc.With("2m", "5s")
+//
+// Derive an instance with typed options and verbose error output:
+//
+//	debug := sc.With(sawchain.WithBindings(map[string]any{"env": "dev"}), sawchain.WithVerbosity(sawchain.VerbosityVerbose))
+func (s *Sawchain) With(args ...any) *Sawchain {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Verbosity: true, Durations: true}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireVerbosity(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Derive Sawchain
+	derived := *s
+	derived.opts = *opts
+
+	return &derived
+}
+
+// Bindings returns a copy of the effective global bindings of this Sawchain instance, including bindings
+// inherited from the instance it was derived from (with With or CreateNamespace). Useful for debugging
+// templates that resolve bindings unexpectedly.
+//
+// # Examples
+//
+// Log the effective global bindings:
+//
+//	GinkgoWriter.Printf("bindings: %v\n", sc.Bindings())
+func (s *Sawchain) Bindings() map[string]any {
+	return util.MergeMaps(s.opts.Bindings)
 }
 
 // HELPERS
@@ -268,6 +740,62 @@ func (s *Sawchain) mergeBindings(bindings ...map[string]any) map[string]any {
 	return util.MergeMaps(append([]map[string]any{s.opts.Bindings}, bindings...)...)
 }
 
+// selectOptions returns the matching options described by opts for selecting resources (as in List,
+// DeleteAll, CheckNone, and CheckCount) rather than asserting on them. Strict matching is never enabled,
+// since partial templates almost never match live resources strictly.
+func (s *Sawchain) selectOptions(opts *options.Options) *chainsaw.MatchOptions {
+	return &chainsaw.MatchOptions{DecodeSecrets: opts.Flags.Has(options.FlagDecodeSecrets)}
+}
+
+// eventDefaults returns the defaults for Event lookups (as in CheckEvent, CheckEventFunc, and
+// HaveEmittedEvent), which never inherit Strict, since Events carry many fields that templates rarely
+// spell out.
+func (s *Sawchain) eventDefaults() *options.Options {
+	defaults := s.opts
+	defaults.Flags &^= options.FlagStrict
+	return &defaults
+}
+
+// matchOptions returns the matching options described by opts, enabling strict matching and
+// Secret decoding if the corresponding flags are set.
+func (s *Sawchain) matchOptions(opts *options.Options) *chainsaw.MatchOptions {
+	matchOpts := &chainsaw.MatchOptions{DecodeSecrets: opts.Flags.Has(options.FlagDecodeSecrets)}
+	if opts.Flags.Has(options.FlagStrict) {
+		matchOpts.Strict = &chainsaw.Strictness{IgnorePaths: opts.IgnorePaths}
+	}
+	return matchOpts
+}
+
+// newFunctions returns the custom functions described by opts, or nil if none are provided.
+func newFunctions(opts *options.Options) (*chainsaw.Functions, error) {
+	if len(opts.JMESPathFunctions) == 0 && len(opts.CELFunctions) == 0 {
+		return nil, nil
+	}
+	return chainsaw.NewFunctions(opts.JMESPathFunctions, opts.CELFunctions)
+}
+
+// ownerGVK validates the owner and returns its GroupVersionKind, resolved through the client scheme
+// if the owner has no type metadata.
+func (s *Sawchain) ownerGVK(owner client.Object) schema.GroupVersionKind {
+	s.t.Helper()
+	s.g.Expect(util.IsNil(owner)).To(gomega.BeFalse(), errNilOwner)
+	s.g.Expect(owner.GetName()).NotTo(gomega.BeEmpty(), errOwnerNameEmpty)
+	gvk, err := util.GetGroupVersionKind(owner, s.c.Scheme())
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedOwnerGVK)
+	return gvk
+}
+
+// involvedObjectGVK validates the object Events are expected about and returns its GroupVersionKind,
+// resolved through the client scheme if the object has no type metadata.
+func (s *Sawchain) involvedObjectGVK(obj client.Object) schema.GroupVersionKind {
+	s.t.Helper()
+	s.g.Expect(util.IsNil(obj)).To(gomega.BeFalse(), errNilInvolvedObject)
+	s.g.Expect(obj.GetName()).NotTo(gomega.BeEmpty(), errInvolvedObjectNameEmpty)
+	gvk, err := util.GetGroupVersionKind(obj, s.c.Scheme())
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedInvolvedObjectGVK)
+	return gvk
+}
+
 func (s *Sawchain) id(obj client.Object) string {
 	return util.GetResourceID(obj, s.c.Scheme())
 }
@@ -311,6 +839,94 @@ func (s *Sawchain) checkNotFoundF(ctx context.Context, obj client.Object) func()
 	return func() error { return s.checkNotFound(ctx, obj) }
 }
 
+// renderForObjects renders the template in opts and validates the resource count against the
+// object(s) in opts, if provided.
+func (s *Sawchain) renderForObjects(ctx context.Context, opts *options.Options) []unstructured.Unstructured {
+	s.t.Helper()
+
+	// Render template
+	bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
+	unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
+
+	// Validate objects length
+	if opts.Object != nil {
+		s.g.Expect(unstructuredObjs).To(gomega.HaveLen(1), errObjectInsufficient)
+	} else if opts.Objects != nil {
+		s.g.Expect(opts.Objects).To(gomega.HaveLen(len(unstructuredObjs)), errObjectsWrongLength)
+	}
+
+	return unstructuredObjs
+}
+
+// saveToObjects copies the given states to the object(s) in opts, if provided.
+func (s *Sawchain) saveToObjects(unstructuredObjs []unstructured.Unstructured, opts *options.Options) {
+	s.t.Helper()
+
+	if opts.Object != nil {
+		s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObjs[0], opts.Object)).To(gomega.Succeed(), errFailedSave)
+	} else if opts.Objects != nil {
+		for i, unstructuredObj := range unstructuredObjs {
+			s.g.Expect(util.CopyUnstructuredToObject(s.c, unstructuredObj, opts.Objects[i])).To(gomega.Succeed(), errFailedSave)
+		}
+	}
+}
+
+// waitForResourceVersions waits until client Get operations for all objects return resource versions
+// at least as new as their current ones, failing with the given message on timeout.
+func (s *Sawchain) waitForResourceVersions(ctx context.Context, objs []client.Object, opts *options.Options, message string) {
+	s.t.Helper()
+
+	resourceVersions := make([]string, len(objs))
+	for i := range objs {
+		resourceVersions[i] = objs[i].GetResourceVersion()
+	}
+	checkAll := func() error {
+		for i := range objs {
+			if err := s.checkResourceVersion(ctx, objs[i], resourceVersions[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	s.g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), message)
+}
+
+// unstructuredRefs returns pointers to the elements of unstructuredObjs as client.Objects, so that
+// operations on the returned objects update the slice in place.
+func unstructuredRefs(unstructuredObjs []unstructured.Unstructured) []client.Object {
+	objs := make([]client.Object, len(unstructuredObjs))
+	for i := range unstructuredObjs {
+		objs[i] = &unstructuredObjs[i]
+	}
+	return objs
+}
+
+// holdConsistently calls poll at the interval in opts until the timeout in opts has elapsed, failing
+// immediately with the given message, the elapsed time, and the first error observed if poll fails.
+func (s *Sawchain) holdConsistently(ctx context.Context, poll func() error, opts *options.Options, message string) {
+	s.t.Helper()
+
+	start := time.Now()
+	for {
+		if err := poll(); err != nil {
+			elapsed := time.Since(start).Round(time.Millisecond)
+			s.g.Expect(err).To(gomega.Succeed(), fmt.Sprintf("%s: invariant broke after %s", message, elapsed))
+			return
+		}
+		if time.Since(start) >= opts.Timeout {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			s.g.Expect(ctx.Err()).NotTo(gomega.HaveOccurred(), message)
+			return
+		case <-time.After(opts.Interval):
+		}
+	}
+}
+
 func (s *Sawchain) convertReturnObject(unstructuredObj unstructured.Unstructured) client.Object {
 	s.t.Helper()
 
@@ -1,6 +1,13 @@
 package sawchain_test
 
 import (
+	"strings"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/jmespath-community/go-jmespath/pkg/functions"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -63,6 +70,19 @@ var _ = Describe("New", func() {
 			client: testutil.NewStandardFakeClient(),
 			args:   []any{"10s", "2s", map[string]any{"namespace": "test"}, sawchain.VerbosityVerbose},
 		}),
+		Entry("should create Sawchain with typed options", testCase{
+			client: testutil.NewStandardFakeClient(),
+			args: []any{
+				sawchain.WithTimeout(10 * time.Second),
+				sawchain.WithInterval(2 * time.Second),
+				sawchain.WithBindings(map[string]any{"namespace": "test"}),
+				sawchain.WithVerbosity(sawchain.VerbosityVerbose),
+			},
+		}),
+		Entry("should create Sawchain with custom functions", testCase{
+			client: testutil.NewStandardFakeClient(),
+			args:   []any{shoutFunction, []sawchain.CELFunction{celShoutFunction}},
+		}),
 
 		// Failure cases
 		Entry("should fail when client is nil", testCase{
@@ -74,6 +94,46 @@ var _ = Describe("New", func() {
 			args:                []any{123}, // Invalid argument type
 			expectedFailureLogs: []string{"[SAWCHAIN][ERROR] invalid arguments"},
 		}),
+		Entry("should fail with unsupported typed option", testCase{
+			client: testutil.NewStandardFakeClient(),
+			args:   []any{sawchain.WithTemplate("path/to/template.yaml")},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unsupported option argument: WithTemplate",
+			},
+		}),
+		Entry("should fail with typed interval greater than typed timeout", testCase{
+			client: testutil.NewStandardFakeClient(),
+			args:   []any{sawchain.WithTimeout(time.Second), sawchain.WithInterval(2 * time.Second)},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"provided interval is greater than timeout",
+			},
+		}),
+		Entry("should fail with typed timeout shorter than default interval", testCase{
+			client: testutil.NewStandardFakeClient(),
+			args:   []any{sawchain.WithTimeout(500 * time.Millisecond)},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"interval 1s is greater than timeout 500ms",
+			},
+		}),
+		Entry("should fail with JMESPath function without handler", testCase{
+			client: testutil.NewStandardFakeClient(),
+			args:   []any{sawchain.JMESPathFunction{Name: "shout"}},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"provided JMESPath function shout has no handler",
+			},
+		}),
+		Entry("should fail with JMESPath function shadowing a built-in function", testCase{
+			client: testutil.NewStandardFakeClient(),
+			args:   []any{sawchain.JMESPathFunction{Name: "base64_decode", Handler: shoutFunction.Handler}},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"JMESPath function base64_decode is already defined",
+			},
+		}),
 	)
 })
 
@@ -224,3 +284,244 @@ var _ = Describe("logInfo verbosity", func() {
 		}),
 	)
 })
+
+var (
+	shoutFunction = sawchain.JMESPathFunction{
+		Name:      "shout",
+		Arguments: []functions.ArgSpec{{Types: []functions.JpType{functions.JpString}}},
+		Handler: func(args []any) (any, error) {
+			return strings.ToUpper(args[0].(string)), nil
+		},
+	}
+	celShoutFunction = cel.Function("cel_shout",
+		cel.Overload("cel_shout_string", []*cel.Type{cel.StringType}, cel.StringType,
+			cel.UnaryBinding(func(value ref.Val) ref.Val {
+				return types.String(strings.ToUpper(string(value.(types.String))))
+			}),
+		),
+	)
+)
+
+var _ = Describe("Custom functions", func() {
+	const template = `
+		apiVersion: v1
+		kind: ConfigMap
+		metadata:
+		  name: test-cm
+		  namespace: default
+		data:
+		  jmespath: (shout($value))
+		  cel: (cel;cel_shout('value'))
+		`
+
+	It("applies custom functions to rendering and matching", func() {
+		c := testutil.NewStandardFakeClient()
+		t := &MockT{TB: GinkgoTB()}
+		sc := sawchain.New(t, c, fastTimeout, fastInterval, map[string]any{"value": "value"},
+			shoutFunction, celShoutFunction)
+
+		// Render
+		rendered := sc.RenderToString(template)
+		Expect(rendered).To(ContainSubstring("jmespath: VALUE"))
+		Expect(rendered).To(ContainSubstring("cel: VALUE"))
+
+		// Create and check
+		sc.Create(ctx, template)
+		Expect(sc.Check(ctx, template)).To(Succeed())
+		Expect(sc.Check(ctx, `
+			apiVersion: v1
+			kind: ConfigMap
+			metadata:
+			  name: test-cm
+			  namespace: default
+			data:
+			  (jmespath == shout('value')): true
+			`)).To(Succeed())
+
+		// Match
+		obj := sc.FetchSingle(ctx, template)
+		Expect(obj).To(sc.MatchYAML(template))
+		Expect(obj).NotTo(sc.MatchYAML(`
+			apiVersion: v1
+			kind: ConfigMap
+			data:
+			  jmespath: (shout('other'))
+			`))
+		Expect(t.Failed()).To(BeFalse(), "expected no failure")
+	})
+
+	It("reports unknown functions with the template document", func() {
+		t := &MockT{TB: GinkgoTB()}
+		sc := sawchain.New(t, testutil.NewStandardFakeClient(), fastTimeout, fastInterval)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			sc.RenderToString(`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				---
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm-2
+				data:
+				  key: (shout('value'))
+				`)
+		}()
+		<-done
+
+		Expect(t.Failed()).To(BeTrue(), "expected failure")
+		Expect(t.ErrorLogs).To(ContainElement(ContainSubstring("[SAWCHAIN][ERROR] invalid template")))
+		Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(
+			"failed to render template document 2 of 2 (v1/ConfigMap/test-cm-2): data.key: Internal error: unknown function: shout")))
+	})
+})
+
+var _ = Describe("With", func() {
+	type testCase struct {
+		globalArgs          []any
+		args                []any
+		expectedBindings    map[string]any
+		expectedFailureLogs []string
+	}
+	DescribeTable("deriving Sawchain instances",
+		func(tc testCase) {
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, testutil.NewStandardFakeClient(), tc.globalArgs...)
+			parentBindings := sc.Bindings()
+
+			var derived *sawchain.Sawchain
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				derived = sc.With(tc.args...)
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+				return
+			}
+			Expect(t.Failed()).To(BeFalse(), "expected no failure")
+
+			// Verify bindings
+			Expect(derived.Bindings()).To(Equal(tc.expectedBindings))
+			Expect(sc.Bindings()).To(Equal(parentBindings), "parent bindings modified")
+		},
+
+		// Success cases
+		Entry("should derive instance with no arguments", testCase{
+			globalArgs:       []any{map[string]any{"namespace": "test"}},
+			expectedBindings: map[string]any{"namespace": "test"},
+		}),
+		Entry("should derive instance with additional and overriding bindings", testCase{
+			globalArgs:       []any{map[string]any{"namespace": "test", "env": "dev"}},
+			args:             []any{map[string]any{"env": "prod"}, sawchain.WithBindings(map[string]any{"region": "us"})},
+			expectedBindings: map[string]any{"namespace": "test", "env": "prod", "region": "us"},
+		}),
+		Entry("should derive instance with durations and verbosity", testCase{
+			globalArgs:       []any{fastTimeout, fastInterval},
+			args:             []any{"10s", "2s", sawchain.VerbosityVerbose},
+			expectedBindings: map[string]any{},
+		}),
+		Entry("should derive instance with typed options", testCase{
+			args: []any{
+				sawchain.WithInterval(2 * time.Second),
+				sawchain.WithTimeout(10 * time.Second),
+				sawchain.WithVerbosity(sawchain.VerbosityMinimal),
+			},
+			expectedBindings: map[string]any{},
+		}),
+
+		// Failure cases
+		Entry("should fail with unexpected argument type", testCase{
+			args: []any{sawchain.FieldManager("other")},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unexpected argument type: options.FieldManager",
+			},
+		}),
+		Entry("should fail with unsupported typed option", testCase{
+			args: []any{sawchain.WithTemplate("path/to/template.yaml")},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unsupported option argument: WithTemplate",
+			},
+		}),
+		Entry("should fail with timeout shorter than inherited interval", testCase{
+			globalArgs: []any{"10s", "2s"},
+			args:       []any{sawchain.WithTimeout(time.Second)},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"interval 2s is greater than timeout 1s",
+			},
+		}),
+		Entry("should fail with interval longer than inherited timeout", testCase{
+			globalArgs: []any{"10s", "2s"},
+			args:       []any{sawchain.WithInterval(time.Minute)},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"interval 1m0s is greater than timeout 10s",
+			},
+		}),
+		Entry("should fail with unsupported flag", testCase{
+			args: []any{sawchain.AutoCleanup},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"unsupported flag argument: AutoCleanup",
+			},
+		}),
+	)
+
+	It("shares the client and applies layered settings", func() {
+		c := testutil.NewStandardFakeClient()
+		t := &MockT{TB: GinkgoTB()}
+		sc := sawchain.New(t, c, time.Minute, fastInterval, map[string]any{"namespace": "default"})
+		derived := sc.With(fastTimeout, map[string]any{"name": "test-cm"}, sawchain.VerbosityVerbose)
+
+		// Resources created through the derived instance are visible through the parent instance
+		template := `
+			apiVersion: v1
+			kind: ConfigMap
+			metadata:
+			  name: ($name)
+			  namespace: ($namespace)
+			`
+		derived.Create(ctx, template)
+		Expect(sc.Check(ctx, template, derived.Bindings())).To(Succeed())
+
+		// The derived instance uses its own timeout
+		done := make(chan struct{})
+		start := time.Now()
+		go func() {
+			defer close(done)
+			derived.CheckAndWait(ctx, `
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: missing-cm
+				  namespace: ($namespace)
+				`)
+		}()
+		<-done
+		Expect(time.Since(start)).To(BeNumerically("<", 10*time.Second))
+		Expect(t.Failed()).To(BeTrue(), "expected failure")
+
+		// The derived instance uses its own verbosity
+		derived.RenderSingle(`
+			apiVersion: example.com/v1
+			kind: TestResource
+			metadata:
+			  name: test-cr
+			  namespace: ($namespace)
+			`)
+		Expect(t.InfoLogs).To(ContainElement(ContainSubstring("[SAWCHAIN][INFO]")))
+	})
+})
@@ -0,0 +1,521 @@
+package sawchain
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain/internal/options"
+	"github.com/guidewire-oss/sawchain/internal/util"
+)
+
+// UpdateStatus updates the status subresource of resources with objects, a manifest, or a Chainsaw
+// template, and returns an error if any client Status().Update operations fail.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order after the context:
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. If provided without a template, resource status will be read from the object for update.
+//     If provided with a template, resource state will be read from the template and written to the object.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
+//     multiple resources. If provided without a template, resource statuses will be read from the objects
+//     for update. If provided with a template, resource states will be read from the template and written
+//     to the objects.
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing resource
+//     definitions whose status fields are merged as patches for update. Template documents only need to
+//     contain type metadata, identifying metadata, and the status fields to be updated. If provided with
+//     an object, must contain exactly one resource definition matching the type of the object. If provided
+//     with a slice of objects, must contain resource definitions exactly matching the count, order, and
+//     types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+// A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
+// may not be provided together.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - When using a template, the status field of each document is used as a JSON merge patch (RFC 7386)
+//     on the status of the live resource. Status fields not specified in the template are preserved,
+//     explicit null values delete the corresponding fields, and fields outside of status are ignored.
+//
+//   - Resources must have a status subresource. When testing with a fake client, custom types must be
+//     registered with WithStatusSubresource.
+//
+//   - When running tests in parallel, ensure resource names or namespaces are unique per process to
+//     prevent collisions. See docs/parallel-tests.md for isolation strategies.
+//
+//   - Use UpdateStatusAndWait instead of UpdateStatus if you need to ensure updates are successful and
+//     the client cache is synced.
+//
+// # Examples
+//
+// Update the status of a single resource with an object:
+//
+//	obj.Status.Phase = "Ready"
+//	err := sc.UpdateStatus(ctx, obj)
+//
+// Update the status of a single resource with a Chainsaw template and bindings:
+//
+//	err := sc.UpdateStatus(ctx, `
+//	  apiVersion: example.com/v1
+//	  kind: MyResource
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  status:
+//	    phase: Ready
+//	    conditions:
+//	    - type: Ready
+//	      status: 'True'
+//	      reason: Testing
+//	      message: Set by test
+//	      lastTransitionTime: '2025-01-01T00:00:00Z'
+//	  `, map[string]any{"name": "test-resource", "namespace": "default"})
+//
+// Update the status of a single resource with a Chainsaw template and save the resource's state to an object:
+//
+//	obj := &examplev1.MyResource{}
+//	err := sc.UpdateStatus(ctx, obj, `
+//	  apiVersion: example.com/v1
+//	  kind: MyResource
+//	  metadata:
+//	    name: test-resource
+//	    namespace: default
+//	  status:
+//	    phase: Ready
+//	`)
+func (s *Sawchain) UpdateStatus(ctx context.Context, args ...any) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireTemplateObjectObjects(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	if len(opts.Template) > 0 {
+		// Render template
+		unstructuredObjs := s.renderForObjects(ctx, opts)
+
+		// Update resource statuses
+		for i, patch := range unstructuredObjs {
+			// Get original object
+			obj := patch.DeepCopy()
+			if err := s.get(ctx, obj); err != nil {
+				return err
+			}
+
+			// Merge status patch into original object
+			merged, err := util.MergePatch(obj.Object, statusPatch(&patch))
+			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedMergePatch)
+
+			// Update status and save to outer scope
+			unstructuredObjs[i].Object = merged
+			if err := s.c.Status().Update(ctx, &unstructuredObjs[i]); err != nil {
+				return err
+			}
+		}
+
+		// Save objects
+		s.saveToObjects(unstructuredObjs, opts)
+	} else if opts.Object != nil {
+		// Update resource status
+		if err := s.c.Status().Update(ctx, opts.Object); err != nil {
+			return err
+		}
+	} else {
+		// Update resource statuses
+		for _, obj := range opts.Objects {
+			if err := s.c.Status().Update(ctx, obj); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// UpdateStatusAndWait updates the status subresource of resources with objects, a manifest, or a
+// Chainsaw template, and ensures client Get operations for all resources reflect the updates within a
+// configurable duration before returning. If testing with a cached client, this ensures the client cache
+// is synced and it is safe to make assertions on the updated resources immediately after execution.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Object (client.Object): Typed or unstructured object for reading/writing the state of a single
+//     resource. If provided without a template, resource status will be read from the object for update.
+//     If provided with a template, resource state will be read from the template and written to the object.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for reading/writing the states of
+//     multiple resources. If provided without a template, resource statuses will be read from the objects
+//     for update. If provided with a template, resource states will be read from the template and written
+//     to the objects.
+//
+//   - Template (string): File path or content of a static manifest or Chainsaw template containing resource
+//     definitions whose status fields are merged as patches for update. Template documents only need to
+//     contain type metadata, identifying metadata, and the status fields to be updated. If provided with
+//     an object, must contain exactly one resource definition matching the type of the object. If provided
+//     with a slice of objects, must contain resource definitions exactly matching the count, order, and
+//     types of the objects.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template (if provided) in addition
+//     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Timeout (string or time.Duration): Duration within which client Get operations for all resources
+//     should reflect the updates. If provided, must be before interval. Defaults to Sawchain's
+//     global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for checking the resources after updating.
+//     If provided, must be after timeout. Defaults to Sawchain's global interval value.
+//
+// A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
+// may not be provided together. All other arguments are optional.
+//
+// # Notes
+//
+//   - Invalid input, client errors, and timeout errors will result in immediate test failure.
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - When using a template, the status field of each document is used as a JSON merge patch (RFC 7386)
+//     on the status of the live resource. Status fields not specified in the template are preserved,
+//     explicit null values delete the corresponding fields, and fields outside of status are ignored.
+//
+//   - Resources must have a status subresource. When testing with a fake client, custom types must be
+//     registered with WithStatusSubresource.
+//
+//   - When running tests in parallel, ensure resource names or namespaces are unique per process to
+//     prevent collisions. See docs/parallel-tests.md for isolation strategies.
+//
+//   - Use UpdateStatus instead of UpdateStatusAndWait if you need to update resources without ensuring
+//     success.
+//
+// # Examples
+//
+// Update the status of a single resource with an object:
+//
+//	obj.Status.Phase = "Ready"
+//	sc.UpdateStatusAndWait(ctx, obj)
+//
+// Update the status of a single resource with a Chainsaw template, bindings, and custom duration settings:
+//
+//	sc.UpdateStatusAndWait(ctx, `
+//	  apiVersion: example.com/v1
+//	  kind: MyResource
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  status:
+//	    phase: Ready
+//	  `, map[string]any{"name": "test-resource", "namespace": "default"}, "10s", "2s")
+//
+// Update the statuses of multiple resources with a Chainsaw template and save the resources' updated
+// states to objects:
+//
+//	first := &examplev1.MyResource{}
+//	second := &examplev1.MyResource{}
+//	sc.UpdateStatusAndWait(ctx, []client.Object{first, second}, `
+//	  apiVersion: example.com/v1
+//	  kind: MyResource
+//	  metadata:
+//	    name: first
+//	    namespace: default
+//	  status:
+//	    phase: Ready
+//	  ---
+//	  apiVersion: example.com/v1
+//	  kind: MyResource
+//	  metadata:
+//	    name: second
+//	    namespace: default
+//	  status:
+//	    phase: Failed
+//	`)
+func (s *Sawchain) UpdateStatusAndWait(ctx context.Context, args ...any) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(options.RequireTemplateObjectObjects(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	if len(opts.Template) > 0 {
+		// Render template
+		unstructuredObjs := s.renderForObjects(ctx, opts)
+
+		// Update resource statuses
+		for i, patch := range unstructuredObjs {
+			// Get original object
+			obj := patch.DeepCopy()
+			s.g.Expect(s.get(ctx, obj)).To(gomega.Succeed(), errFailedGetWithTemplate)
+
+			// Merge status patch into original object
+			merged, err := util.MergePatch(obj.Object, statusPatch(&patch))
+			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedMergePatch)
+
+			// Update status and save to outer scope
+			unstructuredObjs[i].Object = merged
+			s.g.Expect(s.c.Status().Update(ctx, &unstructuredObjs[i])).To(gomega.Succeed(), errFailedUpdateStatusWithTemplate)
+		}
+
+		// Wait for update to be reflected
+		s.waitForResourceVersions(ctx, unstructuredRefs(unstructuredObjs), opts, errStatusNotReflected)
+
+		// Save objects
+		s.saveToObjects(unstructuredObjs, opts)
+	} else if opts.Object != nil {
+		// Update resource status
+		s.g.Expect(s.c.Status().Update(ctx, opts.Object)).To(gomega.Succeed(), errFailedUpdateStatusWithObject)
+
+		// Wait for update to be reflected
+		s.waitForResourceVersions(ctx, []client.Object{opts.Object}, opts, errStatusNotReflected)
+	} else {
+		// Update resource statuses
+		for _, obj := range opts.Objects {
+			s.g.Expect(s.c.Status().Update(ctx, obj)).To(gomega.Succeed(), errFailedUpdateStatusWithObject)
+		}
+
+		// Wait for update to be reflected
+		s.waitForResourceVersions(ctx, opts.Objects, opts, errStatusNotReflected)
+	}
+}
+
+// PatchStatus patches the status subresource of resources with a manifest or a Chainsaw template, and
+// returns an error if any client Status().Patch operations fail.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template
+//     containing resource definitions whose status fields are sent as JSON merge patches (RFC 7386).
+//     Template documents only need to contain type metadata, identifying metadata, and the status fields
+//     to be patched. If provided with an object, must contain exactly one resource definition matching
+//     the type of the object. If provided with a slice of objects, must contain resource definitions
+//     exactly matching the count, order, and types of the objects.
+//
+//   - Object (client.Object): Typed or unstructured object for writing the patched state of a single
+//     resource.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for writing the patched states of
+//     multiple resources.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+// An object and a slice of objects may not be provided together.
+//
+// # Notes
+//
+//   - Invalid input will result in immediate test failure.
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Unlike UpdateStatus, PatchStatus does not read the live resource first, so it never fails with a
+//     conflict when a controller is updating the same resource concurrently.
+//
+//   - Resources must have a status subresource. When testing with a fake client, custom types must be
+//     registered with WithStatusSubresource.
+//
+//   - When running tests in parallel, ensure resource names or namespaces are unique per process to
+//     prevent collisions. See docs/parallel-tests.md for isolation strategies.
+//
+//   - Use PatchStatusAndWait instead of PatchStatus if you need to ensure patches are successful and
+//     the client cache is synced.
+//
+// # Examples
+//
+// Patch the status of a single resource with a Chainsaw template and bindings:
+//
+//	err := sc.PatchStatus(ctx, `
+//	  apiVersion: example.com/v1
+//	  kind: MyResource
+//	  metadata:
+//	    name: ($name)
+//	    namespace: ($namespace)
+//	  status:
+//	    phase: Ready
+//	    message: null
+//	  `, map[string]any{"name": "test-resource", "namespace": "default"})
+func (s *Sawchain) PatchStatus(ctx context.Context, args ...any) error {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Render template
+	unstructuredObjs := s.renderForObjects(ctx, opts)
+
+	// Patch resource statuses
+	for i := range unstructuredObjs {
+		// Use index to update object in outer scope
+		if err := s.mergePatchStatus(ctx, &unstructuredObjs[i]); err != nil {
+			return err
+		}
+	}
+
+	// Save objects
+	s.saveToObjects(unstructuredObjs, opts)
+
+	return nil
+}
+
+// PatchStatusAndWait patches the status subresource of resources with a manifest or a Chainsaw template,
+// and ensures client Get operations for all resources reflect the patches within a configurable duration
+// before returning. If testing with a cached client, this ensures the client cache is synced and it is
+// safe to make assertions on the patched resources immediately after execution.
+//
+// # Arguments
+//
+// The following arguments may be provided in any order (unless noted otherwise) after the context:
+//
+//   - Template (string): Required. File path or content of a static manifest or Chainsaw template
+//     containing resource definitions whose status fields are sent as JSON merge patches (RFC 7386).
+//     Template documents only need to contain type metadata, identifying metadata, and the status fields
+//     to be patched. If provided with an object, must contain exactly one resource definition matching
+//     the type of the object. If provided with a slice of objects, must contain resource definitions
+//     exactly matching the count, order, and types of the objects.
+//
+//   - Object (client.Object): Typed or unstructured object for writing the patched state of a single
+//     resource.
+//
+//   - Objects ([]client.Object): Slice of typed or unstructured objects for writing the patched states of
+//     multiple resources.
+//
+//   - Bindings (map[string]any): Bindings to be applied to the Chainsaw template in addition to (or
+//     overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
+//     natural order.
+//
+//   - Timeout (string or time.Duration): Duration within which client Get operations for all resources
+//     should reflect the patches. If provided, must be before interval. Defaults to Sawchain's
+//     global timeout value.
+//
+//   - Interval (string or time.Duration): Polling interval for checking the resources after patching.
+//     If provided, must be after timeout. Defaults to Sawchain's global interval value.
+//
+// An object and a slice of objects may not be provided together. All arguments except the template are
+// optional.
+//
+// # Notes
+//
+//   - Invalid input, client errors, and timeout errors will result in immediate test failure.
+//
+//   - When dealing with typed objects, the client scheme will be used for internal conversions.
+//
+//   - Templates will be sanitized before use, including de-indenting (removing any common leading
+//     whitespace prefix from non-empty lines) and pruning empty documents.
+//
+//   - Unlike UpdateStatusAndWait, PatchStatusAndWait does not read the live resource first, so it never
+//     fails with a conflict when a controller is updating the same resource concurrently.
+//
+//   - Resources must have a status subresource. When testing with a fake client, custom types must be
+//     registered with WithStatusSubresource.
+//
+//   - When running tests in parallel, ensure resource names or namespaces are unique per process to
+//     prevent collisions. See docs/parallel-tests.md for isolation strategies.
+//
+//   - Use PatchStatus instead of PatchStatusAndWait if you need to patch resources without ensuring
+//     success.
+//
+// # Examples
+//
+// Patch the status of a single resource with a Chainsaw template and save the patched state to an object:
+//
+//	obj := &examplev1.MyResource{}
+//	sc.PatchStatusAndWait(ctx, obj, `
+//	  apiVersion: example.com/v1
+//	  kind: MyResource
+//	  metadata:
+//	    name: test-resource
+//	    namespace: default
+//	  status:
+//	    phase: Ready
+//	`)
+func (s *Sawchain) PatchStatusAndWait(ctx context.Context, args ...any) {
+	s.t.Helper()
+
+	// Parse options
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true}, args...)
+	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
+	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
+
+	// Check required options
+	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(options.RequireTemplate(opts)).To(gomega.Succeed(), errInvalidArgs)
+
+	// Render template
+	unstructuredObjs := s.renderForObjects(ctx, opts)
+
+	// Patch resource statuses
+	for i := range unstructuredObjs {
+		// Use index to update object in outer scope
+		s.g.Expect(s.mergePatchStatus(ctx, &unstructuredObjs[i])).To(gomega.Succeed(), errFailedPatchStatusWithTemplate)
+	}
+
+	// Wait for patch to be reflected
+	s.waitForResourceVersions(ctx, unstructuredRefs(unstructuredObjs), opts, errStatusNotReflected)
+
+	// Save objects
+	s.saveToObjects(unstructuredObjs, opts)
+}
+
+// HELPERS
+
+// statusPatch returns a JSON merge patch containing only the status of obj. If obj has no status,
+// the patch is empty.
+func statusPatch(obj *unstructured.Unstructured) map[string]any {
+	patch := map[string]any{}
+	if status, ok := obj.Object["status"]; ok {
+		patch["status"] = status
+	}
+	return patch
+}
+
+// mergePatchStatus sends the status of obj as a JSON merge patch to the status subresource of the
+// resource it identifies, writing the patched state back to obj.
+func (s *Sawchain) mergePatchStatus(ctx context.Context, obj *unstructured.Unstructured) error {
+	patch, err := json.Marshal(statusPatch(obj))
+	if err != nil {
+		return err
+	}
+	return s.c.Status().Patch(ctx, obj, client.RawPatch(types.MergePatchType, patch))
+}
@@ -0,0 +1,933 @@
+package sawchain_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/guidewire-oss/sawchain"
+	"github.com/guidewire-oss/sawchain/internal/testutil"
+)
+
+// readyCondition returns a Ready condition with the given status and a fixed transition time
+// matching the one used in templates.
+func readyCondition(status metav1.ConditionStatus) metav1.Condition {
+	return metav1.Condition{
+		Type:               "Ready",
+		Status:             status,
+		Reason:             "Testing",
+		Message:            "Set by test",
+		LastTransitionTime: metav1.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// verifyStatus verifies that the cluster state of each expected object matches it (including its
+// status), and that any objects provided as method arguments have the expected statuses.
+func verifyStatus(c client.Client, methodArgs []any, expectedObjs ...client.Object) {
+	GinkgoT().Helper()
+	for _, expectedObj := range expectedObjs {
+		key := client.ObjectKeyFromObject(expectedObj)
+		actual := copy(expectedObj)
+		Expect(c.Get(ctx, key, actual)).To(Succeed(), "resource not found: %s", key)
+		Expect(intent(c, actual)).To(Equal(intent(c, expectedObj)), "unexpected resource state: %s", key)
+		Expect(statusOf(c, actual)).To(Equal(statusOf(c, expectedObj)), "resource status not updated: %s", key)
+	}
+	for _, arg := range methodArgs {
+		switch v := arg.(type) {
+		case client.Object:
+			Expect(statusOf(c, v)).To(Equal(statusOf(c, expectedObjs[0])), "resource status not saved to provided object")
+		case []client.Object:
+			Expect(v).To(HaveLen(len(expectedObjs)), "unexpected objects length")
+			for i, obj := range v {
+				Expect(statusOf(c, obj)).To(Equal(statusOf(c, expectedObjs[i])), "resource status not saved to provided object")
+			}
+		}
+	}
+}
+
+var _ = Describe("UpdateStatus", func() {
+	type testCase struct {
+		originalObjs        []client.Object
+		client              client.Client
+		globalBindings      map[string]any
+		methodArgs          []any
+		expectedReturnErrs  []string
+		expectedFailureLogs []string
+		expectedObjs        []client.Object
+	}
+	DescribeTable("updating test resource statuses",
+		func(tc testCase) {
+			// Create original objects
+			for _, obj := range tc.originalObjs {
+				Expect(tc.client.Create(ctx, obj)).To(Succeed(), "failed to create original object")
+			}
+
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval, tc.globalBindings)
+
+			// Test UpdateStatus
+			var err error
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				err = sc.UpdateStatus(ctx, tc.methodArgs...)
+			}()
+			<-done
+
+			// Verify error
+			if len(tc.expectedReturnErrs) > 0 {
+				Expect(err).To(HaveOccurred(), "expected error")
+				for _, expectedErr := range tc.expectedReturnErrs {
+					Expect(err.Error()).To(ContainSubstring(expectedErr))
+				}
+			} else {
+				Expect(err).NotTo(HaveOccurred(), "expected no error")
+			}
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+
+			// Verify status updates
+			if len(tc.expectedObjs) > 0 {
+				verifyStatus(tc.client, tc.methodArgs, tc.expectedObjs...)
+			}
+		},
+
+		// Success cases - objects
+		Entry("should update status with typed object and ignore other fields", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				func() client.Object {
+					obj := testutil.NewTestResource("test-cr", "default", "ignored", readyCondition(metav1.ConditionTrue))
+					obj.SetResourceVersion("1") // Client does not forgive incorrect resource version for custom types
+					return obj
+				}(),
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue)),
+			},
+		}),
+
+		Entry("should update statuses with typed objects", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr1", "default", "original1"),
+				testutil.NewTestResource("test-cr2", "default", "original2"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				func() []client.Object {
+					obj1 := testutil.NewTestResource("test-cr1", "default", "original1", readyCondition(metav1.ConditionTrue))
+					obj1.SetResourceVersion("1")
+					obj2 := testutil.NewTestResource("test-cr2", "default", "original2", readyCondition(metav1.ConditionFalse))
+					obj2.SetResourceVersion("1")
+					return []client.Object{obj1, obj2}
+				}(),
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr1", "default", "original1", readyCondition(metav1.ConditionTrue)),
+				testutil.NewTestResource("test-cr2", "default", "original2", readyCondition(metav1.ConditionFalse)),
+			},
+		}),
+
+		// Success cases - templates
+		Entry("should update status with template string and bindings and ignore other fields", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "test-ns", "original"),
+			},
+			client:         &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			globalBindings: map[string]any{"namespace": "test-ns"},
+			methodArgs: []any{
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: ($name)
+				  namespace: ($namespace)
+				data: ignored
+				status:
+				  conditions:
+				  - type: Ready
+				    status: ($status)
+				    reason: Testing
+				    message: Set by test
+				    lastTransitionTime: '2025-01-01T00:00:00Z'
+				`,
+				map[string]any{"name": "test-cr", "status": "True"},
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "test-ns", "original", readyCondition(metav1.ConditionTrue)),
+			},
+		}),
+
+		Entry("should remove status field with null in template string", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue)),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions: null
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+		}),
+
+		Entry("should update status with template string and save to typed object", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				&testutil.TestResource{},
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions:
+				  - type: Ready
+				    status: 'False'
+				    reason: Testing
+				    message: Set by test
+				    lastTransitionTime: '2025-01-01T00:00:00Z'
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionFalse)),
+			},
+		}),
+
+		Entry("should update status with template string and save to unstructured object", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				&unstructured.Unstructured{},
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions:
+				  - type: Ready
+				    status: 'True'
+				    reason: Testing
+				    message: Set by test
+				    lastTransitionTime: '2025-01-01T00:00:00Z'
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue)),
+			},
+		}),
+
+		Entry("should update statuses with template string and save to typed objects", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr1", "default", "original1"),
+				testutil.NewTestResource("test-cr2", "default", "original2"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				[]client.Object{&testutil.TestResource{}, &testutil.TestResource{}},
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr1
+				  namespace: default
+				status:
+				  conditions:
+				  - type: Ready
+				    status: 'True'
+				    reason: Testing
+				    message: Set by test
+				    lastTransitionTime: '2025-01-01T00:00:00Z'
+				---
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr2
+				  namespace: default
+				status:
+				  conditions:
+				  - type: Ready
+				    status: 'False'
+				    reason: Testing
+				    message: Set by test
+				    lastTransitionTime: '2025-01-01T00:00:00Z'
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr1", "default", "original1", readyCondition(metav1.ConditionTrue)),
+				testutil.NewTestResource("test-cr2", "default", "original2", readyCondition(metav1.ConditionFalse)),
+			},
+		}),
+
+		// Error cases
+		Entry("should return status update error for object", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{
+				Client:                 testutil.NewStandardFakeClientWithTestResourceStatus(),
+				statusUpdateFailFirstN: 1,
+			},
+			methodArgs: []any{
+				testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue)),
+			},
+			expectedReturnErrs: []string{"simulated status update failure"},
+		}),
+
+		Entry("should return status update error for template", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{
+				Client:                 testutil.NewStandardFakeClientWithTestResourceStatus(),
+				statusUpdateFailFirstN: 1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions: []
+				`,
+			},
+			expectedReturnErrs: []string{"simulated status update failure"},
+		}),
+
+		Entry("should return get error for template", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{
+				Client:        testutil.NewStandardFakeClientWithTestResourceStatus(),
+				getFailFirstN: 1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions: []
+				`,
+			},
+			expectedReturnErrs: []string{"simulated get failure"},
+		}),
+
+		Entry("should return not found error for resource without status subresource", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "bar"}),
+			},
+			expectedReturnErrs: []string{"not found"},
+		}),
+
+		// Failure cases
+		Entry("should fail with no arguments", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string), Object (client.Object), or Objects ([]client.Object)",
+			},
+		}),
+
+		Entry("should fail with missing binding", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: ($missing)
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid template",
+				"failed to render template",
+				"variable not defined: $missing",
+			},
+		}),
+
+		Entry("should fail with object length mismatch", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				[]client.Object{&testutil.TestResource{}},
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr1
+				  namespace: default
+				---
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr2
+				  namespace: default
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] objects slice length must match template resource count",
+			},
+		}),
+	)
+})
+
+var _ = Describe("UpdateStatusAndWait", func() {
+	type testCase struct {
+		originalObjs        []client.Object
+		client              client.Client
+		methodArgs          []any
+		expectedFailureLogs []string
+		expectedObjs        []client.Object
+		expectedDuration    time.Duration
+	}
+	DescribeTable("updating test resource statuses and waiting",
+		func(tc testCase) {
+			// Create original objects
+			for _, obj := range tc.originalObjs {
+				Expect(tc.client.Create(ctx, obj)).To(Succeed(), "failed to create original object")
+			}
+
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval)
+
+			// Test UpdateStatusAndWait
+			done := make(chan struct{})
+			start := time.Now()
+			go func() {
+				defer close(done)
+				sc.UpdateStatusAndWait(ctx, tc.methodArgs...)
+			}()
+			<-done
+			executionTime := time.Since(start)
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+
+			// Verify status updates
+			if len(tc.expectedObjs) > 0 {
+				verifyStatus(tc.client, tc.methodArgs, tc.expectedObjs...)
+			}
+
+			// Verify execution time
+			if tc.expectedDuration > 0 {
+				maxAllowedDuration := time.Duration(float64(tc.expectedDuration) * 1.2)
+				Expect(executionTime).To(BeNumerically("<", maxAllowedDuration),
+					"expected execution time %v to be less than %v",
+					executionTime, maxAllowedDuration)
+			}
+		},
+
+		// Success cases
+		Entry("should update status with typed object", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				func() client.Object {
+					obj := testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue))
+					obj.SetResourceVersion("1") // Client does not forgive incorrect resource version for custom types
+					return obj
+				}(),
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue)),
+			},
+		}),
+
+		Entry("should update status with template string and save to typed object", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				&testutil.TestResource{},
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions:
+				  - type: Ready
+				    status: 'True'
+				    reason: Testing
+				    message: Set by test
+				    lastTransitionTime: '2025-01-01T00:00:00Z'
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue)),
+			},
+		}),
+
+		Entry("should handle transient get failures", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{
+				Client:        testutil.NewStandardFakeClientWithTestResourceStatus(),
+				getFailFirstN: 2, // Fail the first 2 get attempts
+			},
+			methodArgs: []any{
+				func() client.Object {
+					obj := testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue))
+					obj.SetResourceVersion("1")
+					return obj
+				}(),
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue)),
+			},
+			expectedDuration: fastTimeout,
+		}),
+
+		// Failure cases
+		Entry("should fail when status update with object fails", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{
+				Client:                 testutil.NewStandardFakeClientWithTestResourceStatus(),
+				statusUpdateFailFirstN: -1,
+			},
+			methodArgs: []any{
+				testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue)),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to update status with object",
+				"simulated status update failure",
+			},
+		}),
+
+		Entry("should fail when status update with template fails", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{
+				Client:                 testutil.NewStandardFakeClientWithTestResourceStatus(),
+				statusUpdateFailFirstN: -1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions: []
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to update status with template",
+				"simulated status update failure",
+			},
+		}),
+
+		Entry("should fail when get with template fails", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{
+				Client:        testutil.NewStandardFakeClientWithTestResourceStatus(),
+				getFailFirstN: -1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions: []
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to get with template",
+				"simulated get failure",
+			},
+		}),
+
+		Entry("should fail when status update is not reflected", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{
+				Client:        testutil.NewStandardFakeClientWithTestResourceStatus(),
+				getFailFirstN: -1,
+			},
+			methodArgs: []any{
+				func() client.Object {
+					obj := testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue))
+					obj.SetResourceVersion("1")
+					return obj
+				}(),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] status update not reflected within timeout (client cache sync delay)",
+				"simulated get failure",
+			},
+		}),
+	)
+})
+
+var _ = Describe("PatchStatus", func() {
+	type testCase struct {
+		originalObjs        []client.Object
+		client              client.Client
+		methodArgs          []any
+		expectedReturnErrs  []string
+		expectedFailureLogs []string
+		expectedObjs        []client.Object
+	}
+	DescribeTable("patching test resource statuses",
+		func(tc testCase) {
+			// Create original objects
+			for _, obj := range tc.originalObjs {
+				Expect(tc.client.Create(ctx, obj)).To(Succeed(), "failed to create original object")
+			}
+
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval)
+
+			// Test PatchStatus
+			var err error
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				err = sc.PatchStatus(ctx, tc.methodArgs...)
+			}()
+			<-done
+
+			// Verify error
+			if len(tc.expectedReturnErrs) > 0 {
+				Expect(err).To(HaveOccurred(), "expected error")
+				for _, expectedErr := range tc.expectedReturnErrs {
+					Expect(err.Error()).To(ContainSubstring(expectedErr))
+				}
+			} else {
+				Expect(err).NotTo(HaveOccurred(), "expected no error")
+			}
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+
+			// Verify status patches
+			if len(tc.expectedObjs) > 0 {
+				verifyStatus(tc.client, tc.methodArgs, tc.expectedObjs...)
+			}
+		},
+
+		// Success cases
+		Entry("should patch status with template string and ignore other fields", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				data: ignored
+				status:
+				  conditions:
+				  - type: Ready
+				    status: 'True'
+				    reason: Testing
+				    message: Set by test
+				    lastTransitionTime: '2025-01-01T00:00:00Z'
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue)),
+			},
+		}),
+
+		Entry("should patch status of built-in resource and save to typed object", testCase{
+			originalObjs: []client.Object{
+				newPod("test-pod", "default", "app", "app:v1"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				&corev1.Pod{},
+				`
+				apiVersion: v1
+				kind: Pod
+				metadata:
+				  name: test-pod
+				  namespace: default
+				status:
+				  phase: Running
+				`,
+			},
+			expectedObjs: []client.Object{
+				func() client.Object {
+					pod := newPod("test-pod", "default", "app", "app:v1")
+					pod.Status.Phase = corev1.PodRunning
+					return pod
+				}(),
+			},
+		}),
+
+		Entry("should patch statuses with template string and save to unstructured objects", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr1", "default", "original1"),
+				testutil.NewTestResource("test-cr2", "default", "original2", readyCondition(metav1.ConditionTrue)),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				[]client.Object{&unstructured.Unstructured{}, &unstructured.Unstructured{}},
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr1
+				  namespace: default
+				status:
+				  conditions:
+				  - type: Ready
+				    status: 'False'
+				    reason: Testing
+				    message: Set by test
+				    lastTransitionTime: '2025-01-01T00:00:00Z'
+				---
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr2
+				  namespace: default
+				status:
+				  conditions: null
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr1", "default", "original1", readyCondition(metav1.ConditionFalse)),
+				testutil.NewTestResource("test-cr2", "default", "original2"),
+			},
+		}),
+
+		// Error cases
+		Entry("should return status patch error", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{
+				Client:                testutil.NewStandardFakeClientWithTestResourceStatus(),
+				statusPatchFailFirstN: 1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions: []
+				`,
+			},
+			expectedReturnErrs: []string{"simulated status patch failure"},
+		}),
+
+		// Failure cases
+		Entry("should fail with no template", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"required argument(s) not provided: Template (string)",
+			},
+		}),
+	)
+})
+
+var _ = Describe("PatchStatusAndWait", func() {
+	type testCase struct {
+		originalObjs        []client.Object
+		client              client.Client
+		methodArgs          []any
+		expectedFailureLogs []string
+		expectedObjs        []client.Object
+	}
+	DescribeTable("patching test resource statuses and waiting",
+		func(tc testCase) {
+			// Create original objects
+			for _, obj := range tc.originalObjs {
+				Expect(tc.client.Create(ctx, obj)).To(Succeed(), "failed to create original object")
+			}
+
+			// Initialize Sawchain
+			t := &MockT{TB: GinkgoTB()}
+			sc := sawchain.New(t, tc.client, fastTimeout, fastInterval)
+
+			// Test PatchStatusAndWait
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				sc.PatchStatusAndWait(ctx, tc.methodArgs...)
+			}()
+			<-done
+
+			// Verify failure
+			if len(tc.expectedFailureLogs) > 0 {
+				Expect(t.Failed()).To(BeTrue(), "expected failure")
+				for _, expectedLog := range tc.expectedFailureLogs {
+					Expect(t.ErrorLogs).To(ContainElement(ContainSubstring(expectedLog)))
+				}
+			} else {
+				Expect(t.Failed()).To(BeFalse(), "expected no failure")
+			}
+
+			// Verify status patches
+			if len(tc.expectedObjs) > 0 {
+				verifyStatus(tc.client, tc.methodArgs, tc.expectedObjs...)
+			}
+		},
+
+		// Success cases
+		Entry("should patch status with template string and save to typed object", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{Client: testutil.NewStandardFakeClientWithTestResourceStatus()},
+			methodArgs: []any{
+				&testutil.TestResource{},
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions:
+				  - type: Ready
+				    status: 'True'
+				    reason: Testing
+				    message: Set by test
+				    lastTransitionTime: '2025-01-01T00:00:00Z'
+				`,
+			},
+			expectedObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original", readyCondition(metav1.ConditionTrue)),
+			},
+		}),
+
+		// Failure cases
+		Entry("should fail when status patch fails", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{
+				Client:                testutil.NewStandardFakeClientWithTestResourceStatus(),
+				statusPatchFailFirstN: -1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions: []
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to patch status with template",
+				"simulated status patch failure",
+			},
+		}),
+
+		Entry("should fail when status patch is not reflected", testCase{
+			originalObjs: []client.Object{
+				testutil.NewTestResource("test-cr", "default", "original"),
+			},
+			client: &MockClient{
+				Client:        testutil.NewStandardFakeClientWithTestResourceStatus(),
+				getFailFirstN: -1,
+			},
+			methodArgs: []any{
+				`
+				apiVersion: example.com/v1
+				kind: TestResource
+				metadata:
+				  name: test-cr
+				  namespace: default
+				status:
+				  conditions: []
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] status update not reflected within timeout (client cache sync delay)",
+				"simulated get failure",
+			},
+		}),
+	)
+})
@@ -2,6 +2,7 @@ package sawchain_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime"
 	"testing"
@@ -9,10 +10,14 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/guidewire-oss/sawchain/internal/testutil"
+	"github.com/guidewire-oss/sawchain/internal/util"
 )
 
 const (
@@ -46,10 +51,19 @@ func intent(c client.Client, obj client.Object) unstructured.Unstructured {
 	return u
 }
 
+// statusOf returns the status field of an unstructured copy of the given object.
+func statusOf(c client.Client, obj client.Object) any {
+	GinkgoT().Helper()
+	u, err := util.UnstructuredFromObject(c, obj)
+	Expect(err).NotTo(HaveOccurred(), "failed to copy unstructured status from object")
+	return u.Object["status"]
+}
+
 // MockT allows capturing failures, error logs, and info logs.
 type MockT struct {
 	testing.TB
 	failed    bool
+	cleanups  []func()
 	ErrorLogs []string
 	InfoLogs  []string
 }
@@ -94,10 +108,31 @@ func (m *MockT) Log(args ...any) {
 	m.InfoLogs = append(m.InfoLogs, fmt.Sprint(args...))
 }
 
+func (m *MockT) Cleanup(f func()) {
+	m.cleanups = append(m.cleanups, f)
+}
+
+// RunCleanups runs registered cleanup functions in last-added, first-called order
+// (in a separate goroutine to allow FailNow to exit cleanly).
+func (m *MockT) RunCleanups() {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := len(m.cleanups) - 1; i >= 0; i-- {
+			m.cleanups[i]()
+		}
+	}()
+	<-done
+	m.cleanups = nil
+}
+
 // MockClient allows simulating K8s API failures.
 type MockClient struct {
 	client.Client
 
+	applyFailFirstN int
+	applyCallCount  int
+
 	createFailFirstN int
 	createCallCount  int
 
@@ -110,8 +145,27 @@ type MockClient struct {
 	listFailFirstN int
 	listCallCount  int
 
+	patchFailFirstN int
+	patchCallCount  int
+
+	statusUpdateFailFirstN int
+	statusUpdateCallCount  int
+
+	statusPatchFailFirstN int
+	statusPatchCallCount  int
+
 	updateFailFirstN int
 	updateCallCount  int
+
+	updateConflictFirstN int
+}
+
+func (m *MockClient) Apply(ctx context.Context, obj k8sruntime.ApplyConfiguration, opts ...client.ApplyOption) error {
+	m.applyCallCount++
+	if m.applyFailFirstN < 0 || m.applyCallCount <= m.applyFailFirstN {
+		return fmt.Errorf("simulated apply failure")
+	}
+	return m.Client.Apply(ctx, obj, opts...)
 }
 
 func (m *MockClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
@@ -146,10 +200,47 @@ func (m *MockClient) List(ctx context.Context, list client.ObjectList, opts ...c
 	return m.Client.List(ctx, list, opts...)
 }
 
+func (m *MockClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	m.patchCallCount++
+	if m.patchFailFirstN < 0 || m.patchCallCount <= m.patchFailFirstN {
+		return fmt.Errorf("simulated patch failure")
+	}
+	return m.Client.Patch(ctx, obj, patch, opts...)
+}
+
 func (m *MockClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
 	m.updateCallCount++
 	if m.updateFailFirstN < 0 || m.updateCallCount <= m.updateFailFirstN {
 		return fmt.Errorf("simulated update failure")
 	}
+	if m.updateConflictFirstN < 0 || m.updateCallCount <= m.updateConflictFirstN {
+		return apierrors.NewConflict(schema.GroupResource{}, obj.GetName(), errors.New("simulated update conflict"))
+	}
 	return m.Client.Update(ctx, obj, opts...)
 }
+
+func (m *MockClient) Status() client.SubResourceWriter {
+	return &mockStatusWriter{SubResourceWriter: m.Client.Status(), m: m}
+}
+
+// mockStatusWriter allows simulating K8s API failures for the status subresource.
+type mockStatusWriter struct {
+	client.SubResourceWriter
+	m *MockClient
+}
+
+func (w *mockStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	w.m.statusUpdateCallCount++
+	if w.m.statusUpdateFailFirstN < 0 || w.m.statusUpdateCallCount <= w.m.statusUpdateFailFirstN {
+		return fmt.Errorf("simulated status update failure")
+	}
+	return w.SubResourceWriter.Update(ctx, obj, opts...)
+}
+
+func (w *mockStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	w.m.statusPatchCallCount++
+	if w.m.statusPatchFailFirstN < 0 || w.m.statusPatchCallCount <= w.m.statusPatchFailFirstN {
+		return fmt.Errorf("simulated status patch failure")
+	}
+	return w.SubResourceWriter.Patch(ctx, obj, patch, opts...)
+}
@@ -2,8 +2,11 @@ package sawchain
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/guidewire-oss/sawchain/internal/chainsaw"
 	"github.com/guidewire-oss/sawchain/internal/options"
@@ -37,6 +40,10 @@ import (
 //     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
 //     natural order.
 //
+//   - RetryOnConflict (sawchain.Flag): If provided, template updates failing with a conflict are retried
+//     at Sawchain's interval until Sawchain's timeout elapses. Requires a template. Enabled by default for
+//     template updates if Sawchain was initialized with RetryOnConflict.
+//
 // A template, an object, or a slice of objects must be provided. However, an object and a slice of objects
 // may not be provided together.
 //
@@ -53,6 +60,10 @@ import (
 //     corresponding resource. This means fields not specified in the template are preserved, and
 //     explicit null values in the template will delete the corresponding fields in the resource.
 //
+//   - With RetryOnConflict, each retry re-reads the live resource and merges the template into the fresh
+//     state again. If conflicts persist, the returned error reports how many were hit. Updates with
+//     objects alone are never retried, since resending stale objects would overwrite concurrent changes.
+//
 //   - When running tests in parallel, ensure resource names or namespaces are unique per process to
 //     prevent collisions. See docs/parallel-tests.md for isolation strategies.
 //
@@ -152,22 +163,35 @@ import (
 //	  stringData:
 //	    password: updated-secret
 //	  `, map[string]any{"prefix": "test", "namespace": "default"})
+//
+// Update a single resource with a Chainsaw template, retrying if a controller updates it concurrently:
+//
+//	err := sc.Update(ctx, sawchain.RetryOnConflict, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: default
+//	  data:
+//	    key: value
+//	`)
 func (s *Sawchain) Update(ctx context.Context, args ...any) error {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, false, true, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Object: true, Objects: true, Template: true, Flags: options.FlagRetryOnConflict}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
 	// Check required options
 	s.g.Expect(options.RequireTemplateObjectObjects(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(options.RequireTemplateWithRetryOnConflict(opts, args...)).To(gomega.Succeed(), errInvalidArgs)
 
 	if len(opts.Template) > 0 {
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings)
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		// Validate objects length
@@ -179,19 +203,22 @@ func (s *Sawchain) Update(ctx context.Context, args ...any) error {
 
 		// Update resources
 		for i, patch := range unstructuredObjs {
-			// Get original object
-			obj := patch.DeepCopy()
-			if err := s.get(ctx, obj); err != nil {
-				return err
-			}
+			update := func() error {
+				// Get original object
+				obj := patch.DeepCopy()
+				if err := s.get(ctx, obj); err != nil {
+					return err
+				}
 
-			// Merge patch into original object
-			merged, err := util.MergePatch(obj.Object, patch.Object)
-			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedMergePatch)
+				// Merge patch into original object
+				merged, err := util.MergePatch(obj.Object, patch.Object)
+				s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedMergePatch)
 
-			// Update and save to outer scope
-			unstructuredObjs[i].Object = merged
-			if err := s.c.Update(ctx, &unstructuredObjs[i]); err != nil {
+				// Update and save to outer scope
+				unstructuredObjs[i].Object = merged
+				return s.c.Update(ctx, &unstructuredObjs[i])
+			}
+			if err := s.retryOnConflict(ctx, opts, update); err != nil {
 				return err
 			}
 		}
@@ -250,6 +277,10 @@ func (s *Sawchain) Update(ctx context.Context, args ...any) error {
 //     to (or overriding) Sawchain's global bindings. If multiple maps are provided, they will be merged in
 //     natural order.
 //
+//   - RetryOnConflict (sawchain.Flag): If provided, template updates failing with a conflict are retried
+//     at the polling interval until the timeout elapses. Requires a template. Enabled by default for
+//     template updates if Sawchain was initialized with RetryOnConflict.
+//
 //   - Timeout (string or time.Duration): Duration within which client Get operations for all resources
 //     should reflect the updates. If provided, must be before interval. Defaults to Sawchain's
 //     global timeout value.
@@ -273,6 +304,10 @@ func (s *Sawchain) Update(ctx context.Context, args ...any) error {
 //     corresponding resource. This means fields not specified in the template are preserved, and
 //     explicit null values in the template will delete the corresponding fields in the resource.
 //
+//   - With RetryOnConflict, each retry re-reads the live resource and merges the template into the fresh
+//     state again. If conflicts persist, the returned error reports how many were hit. Updates with
+//     objects alone are never retried, since resending stale objects would overwrite concurrent changes.
+//
 //   - When running tests in parallel, ensure resource names or namespaces are unique per process to
 //     prevent collisions. See docs/parallel-tests.md for isolation strategies.
 //
@@ -371,23 +406,36 @@ func (s *Sawchain) Update(ctx context.Context, args ...any) error {
 //	  stringData:
 //	    password: updated-secret
 //	  `, map[string]any{"prefix": "test", "namespace": "default"})
+//
+// Update a single resource with a Chainsaw template, retrying if a controller updates it concurrently:
+//
+//	sc.UpdateAndWait(ctx, sawchain.RetryOnConflict, `
+//	  apiVersion: v1
+//	  kind: ConfigMap
+//	  metadata:
+//	    name: test-cm
+//	    namespace: default
+//	  data:
+//	    key: value
+//	`)
 func (s *Sawchain) UpdateAndWait(ctx context.Context, args ...any) {
 	s.t.Helper()
 
 	// Parse options
-	opts, err := options.ParseAndApplyDefaults(&s.opts, false, true, true, true, true, args...)
+	opts, err := options.ParseAndApplyDefaults(&s.opts, options.Include{Durations: true, Object: true, Objects: true, Template: true, Flags: options.FlagRetryOnConflict}, args...)
 	s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidArgs)
 	s.g.Expect(opts).NotTo(gomega.BeNil(), errNilOpts)
 
 	// Check required options
 	s.g.Expect(options.RequireDurations(opts)).To(gomega.Succeed(), errInvalidArgs)
 	s.g.Expect(options.RequireTemplateObjectObjects(opts)).To(gomega.Succeed(), errInvalidArgs)
+	s.g.Expect(options.RequireTemplateWithRetryOnConflict(opts, args...)).To(gomega.Succeed(), errInvalidArgs)
 
 	if len(opts.Template) > 0 {
 		// Render template
 		bindings, err := chainsaw.BindingsFromMap(opts.Bindings)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidBindings)
-		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings)
+		unstructuredObjs, err := chainsaw.RenderTemplate(ctx, opts.Template, bindings, s.funcs)
 		s.g.Expect(err).NotTo(gomega.HaveOccurred(), errInvalidTemplate)
 
 		// Validate objects length
@@ -399,17 +447,20 @@ func (s *Sawchain) UpdateAndWait(ctx context.Context, args ...any) {
 
 		// Update resources
 		for i, patch := range unstructuredObjs {
-			// Get original object
-			obj := patch.DeepCopy()
-			s.g.Expect(s.get(ctx, obj)).To(gomega.Succeed(), errFailedGetWithTemplate)
+			update := func() error {
+				// Get original object
+				obj := patch.DeepCopy()
+				s.g.Expect(s.get(ctx, obj)).To(gomega.Succeed(), errFailedGetWithTemplate)
 
-			// Merge patch into original object
-			merged, err := util.MergePatch(obj.Object, patch.Object)
-			s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedMergePatch)
+				// Merge patch into original object
+				merged, err := util.MergePatch(obj.Object, patch.Object)
+				s.g.Expect(err).NotTo(gomega.HaveOccurred(), errFailedMergePatch)
 
-			// Update and save to outer scope
-			unstructuredObjs[i].Object = merged
-			s.g.Expect(s.c.Update(ctx, &unstructuredObjs[i])).To(gomega.Succeed(), errFailedUpdateWithTemplate)
+				// Update and save to outer scope
+				unstructuredObjs[i].Object = merged
+				return s.c.Update(ctx, &unstructuredObjs[i])
+			}
+			s.g.Expect(s.retryOnConflict(ctx, opts, update)).To(gomega.Succeed(), errFailedUpdateWithTemplate)
 		}
 
 		// Wait for update to be reflected
@@ -466,3 +517,32 @@ func (s *Sawchain) UpdateAndWait(ctx context.Context, args ...any) {
 		s.g.Eventually(checkAll, opts.Timeout, opts.Interval).Should(gomega.Succeed(), errUpdateNotReflected)
 	}
 }
+
+// HELPERS
+
+// retryOnConflict calls update once, or if RetryOnConflict is enabled, until it returns an error other
+// than a conflict or the timeout elapses, waiting for the interval between attempts. If the timeout
+// elapses, the last conflict error is returned along with the number of conflicts hit.
+func (s *Sawchain) retryOnConflict(ctx context.Context, opts *options.Options, update func() error) error {
+	if !opts.Flags.Has(options.FlagRetryOnConflict) {
+		return update()
+	}
+
+	deadline := time.Now().Add(opts.Timeout)
+	conflicts := 0
+	for {
+		err := update()
+		if !apierrors.IsConflict(err) {
+			return err
+		}
+		conflicts++
+		if time.Now().Add(opts.Interval).After(deadline) {
+			return fmt.Errorf("still conflicting after %d conflict(s) within %v: %w", conflicts, opts.Timeout, err)
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("canceled after %d conflict(s): %w", conflicts, err)
+		case <-time.After(opts.Interval):
+		}
+	}
+}
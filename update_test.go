@@ -6,6 +6,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -842,6 +843,30 @@ var _ = Describe("Update", func() {
 			},
 		}),
 
+		// Success cases - retry on conflict
+		Entry("should retry template update on conflict", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original", "bar": "preserved"}),
+			},
+			client: &MockClient{
+				Client:               testutil.NewStandardFakeClient(),
+				updateConflictFirstN: 2,
+			},
+			methodArgs: []any{
+				sawchain.RetryOnConflict,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: updated
+				`,
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "updated", "bar": "preserved"}),
+		}),
+
 		// Error cases
 		Entry("should return update error for object", testCase{
 			originalObjs: []client.Object{
@@ -897,6 +922,70 @@ var _ = Describe("Update", func() {
 			expectedReturnErrs: []string{"simulated update failure"},
 		}),
 
+		Entry("should return conflict error without retry", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{
+				Client:               testutil.NewStandardFakeClient(),
+				updateConflictFirstN: 1,
+			},
+			methodArgs: []any{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "updated"}),
+			},
+			expectedReturnErrs: []string{"simulated update conflict"},
+		}),
+
+		Entry("should return conflict count when conflicts persist", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{
+				Client:               testutil.NewStandardFakeClient(),
+				updateConflictFirstN: -1,
+			},
+			methodArgs: []any{
+				sawchain.RetryOnConflict,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: updated
+				`,
+			},
+			expectedReturnErrs: []string{
+				"still conflicting after",
+				"conflict(s) within 100ms",
+				"simulated update conflict",
+			},
+		}),
+
+		Entry("should return non-conflict error without retry", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{
+				Client:           testutil.NewStandardFakeClient(),
+				updateFailFirstN: 1,
+			},
+			methodArgs: []any{
+				sawchain.RetryOnConflict,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: updated
+				`,
+			},
+			expectedReturnErrs: []string{"simulated update failure"},
+		}),
+
 		// Failure cases
 		Entry("should fail with no arguments", testCase{
 			client: &MockClient{Client: testutil.NewStandardFakeClient()},
@@ -906,6 +995,18 @@ var _ = Describe("Update", func() {
 			},
 		}),
 
+		Entry("should fail with RetryOnConflict and object without template", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				sawchain.RetryOnConflict,
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "updated"}),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"RetryOnConflict requires a template",
+			},
+		}),
+
 		Entry("should fail with unexpected argument type", testCase{
 			client: &MockClient{Client: testutil.NewStandardFakeClient()},
 			methodArgs: []any{
@@ -1083,6 +1184,31 @@ var _ = Describe("Update", func() {
 			},
 		}),
 	)
+
+	It("should not retry stale object update with global RetryOnConflict", func() {
+		t := &MockT{TB: GinkgoTB()}
+		c := testutil.NewStandardFakeClient()
+		sc := sawchain.New(t, c, fastTimeout, fastInterval, sawchain.RetryOnConflict)
+
+		// Read resource, then update it concurrently to make the read state stale
+		Expect(c.Create(ctx, testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}))).To(Succeed())
+		stale := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, client.ObjectKey{Name: "test-cm", Namespace: "default"}, stale)).To(Succeed())
+		concurrent := stale.DeepCopy()
+		concurrent.Data["foo"] = "concurrent"
+		Expect(c.Update(ctx, concurrent)).To(Succeed())
+
+		// Update with stale object
+		stale.Data["foo"] = "updated"
+		err := sc.Update(ctx, stale)
+		Expect(apierrors.IsConflict(err)).To(BeTrue(), "expected conflict, got: %v", err)
+
+		// Verify concurrent change is preserved
+		actual := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(stale), actual)).To(Succeed())
+		Expect(actual.Data).To(Equal(map[string]string{"foo": "concurrent"}))
+		Expect(t.Failed()).To(BeFalse(), "expected no failure")
+	})
 })
 
 var _ = Describe("UpdateAndWait", func() {
@@ -1983,6 +2109,30 @@ var _ = Describe("UpdateAndWait", func() {
 			},
 		}),
 
+		// Success cases - retry on conflict
+		Entry("should retry template update on conflict", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{
+				Client:               testutil.NewStandardFakeClient(),
+				updateConflictFirstN: 2,
+			},
+			methodArgs: []any{
+				sawchain.RetryOnConflict,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: updated
+				`,
+			},
+			expectedObj: testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "updated"}),
+		}),
+
 		// Failure cases
 		Entry("should fail with no arguments", testCase{
 			client: &MockClient{Client: testutil.NewStandardFakeClient()},
@@ -1992,6 +2142,18 @@ var _ = Describe("UpdateAndWait", func() {
 			},
 		}),
 
+		Entry("should fail with RetryOnConflict and object without template", testCase{
+			client: &MockClient{Client: testutil.NewStandardFakeClient()},
+			methodArgs: []any{
+				sawchain.RetryOnConflict,
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "updated"}),
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] invalid arguments",
+				"RetryOnConflict requires a template",
+			},
+		}),
+
 		Entry("should fail with unexpected argument type", testCase{
 			client: &MockClient{Client: testutil.NewStandardFakeClient()},
 			methodArgs: []any{
@@ -2064,6 +2226,33 @@ var _ = Describe("UpdateAndWait", func() {
 			},
 		}),
 
+		Entry("should fail with conflict count when conflicts persist", testCase{
+			originalObjs: []client.Object{
+				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),
+			},
+			client: &MockClient{
+				Client:               testutil.NewStandardFakeClient(),
+				updateConflictFirstN: -1,
+			},
+			methodArgs: []any{
+				sawchain.RetryOnConflict,
+				`
+				apiVersion: v1
+				kind: ConfigMap
+				metadata:
+				  name: test-cm
+				  namespace: default
+				data:
+				  foo: updated
+				`,
+			},
+			expectedFailureLogs: []string{
+				"[SAWCHAIN][ERROR] failed to update with template",
+				"still conflicting after",
+				"simulated update conflict",
+			},
+		}),
+
 		Entry("should fail when update fails (single object)", testCase{
 			originalObjs: []client.Object{
 				testutil.NewConfigMap("test-cm", "default", map[string]string{"foo": "original"}),